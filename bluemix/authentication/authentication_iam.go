@@ -2,12 +2,14 @@ package authentication
 
 import (
 	"encoding/base64"
-	"encoding/json"
+	"errors"
 	"fmt"
+	"net/url"
 	"strings"
 
 	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/bluemix/configuration/core_config"
 	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/common/rest"
+	. "github.com/IBM-Cloud/ibm-cloud-cli-sdk/i18n"
 )
 
 const (
@@ -15,43 +17,104 @@ const (
 	iamClientSecret = "bx"
 )
 
-type IAMError struct {
-	ErrorCode    string `json:"errorCode"`
-	ErrorMessage string `json:"errorMessage"`
-	ErrorDetails string `json:"errorDetails"`
-}
-
-func (e IAMError) detailsOrMessage() string {
-	if e.ErrorDetails != "" {
-		return e.ErrorDetails
-	}
-	return e.ErrorMessage
-}
-
 type IAMAuthRepository interface {
 	AuthenticatePassword(username string, password string) (iamToken Token, err error)
 	AuthenticateSSO(passcode string) (iamToken Token, err error)
 	AuthenticateAPIKey(apiKey string) (iamToken Token, err error)
+	AuthenticateAuthorizationCode(code string, redirectURI string, codeVerifier string) (iamToken Token, err error)
 	GetUAAToken(iamAccessToken string) (uaaToken Token, err error)
 	RefreshToken(refreshToken string) (iamToken Token, err error)
 	RefreshTokenToLinkAccounts(refreshToken string, accounts core_config.AccountsInfo) (iamToken Token, err error)
 	RefreshTokenToLinkAccountsAndGetUAAToken(refreshToken string, accounts core_config.AccountsInfo) (iamToken, uaaToken Token, err error)
 }
 
+// IAMIntrospector introspects IAM tokens. It is implemented by the client
+// returned by NewIAMClient.
+type IAMIntrospector interface {
+	Introspect(token string) (result IntrospectResult, err error)
+}
+
+// IAMClient is an IAMAuthRepository that also provides the IAM APIs which
+// are not part of IAMAuthRepository, so that adding them doesn't break other
+// implementations of IAMAuthRepository.
+type IAMClient interface {
+	IAMAuthRepository
+	IAMIntrospector
+	IAMTokenExchanger
+}
+
+// IAMTokenExchanger exchanges IAM tokens for tokens of other services. It is
+// implemented by the client returned by NewIAMClient.
+type IAMTokenExchanger interface {
+	ExchangeTokenForService(refreshToken string, receiverClientID string) (serviceToken Token, err error)
+}
+
+// IntrospectResult is the result of IAM token introspection
+type IntrospectResult struct {
+	Active    bool   `json:"active"`
+	Expiry    int64  `json:"exp"` // expiry time in seconds since Unix epoch
+	IssuedAt  int64  `json:"iat"` // issue time in seconds since Unix epoch
+	Scope     string `json:"scope"`
+	ClientID  string `json:"client_id"`
+	IAMID     string `json:"iam_id"`
+	Subject   string `json:"sub"`
+	TokenType string `json:"token_type"`
+}
+
+// DefaultIAMTokenPath is the default path of IAM token API
+const DefaultIAMTokenPath = "/identity/token"
+
 type IAMConfig struct {
 	// the token endpoint. for example: https://iam.example.com/indentity/token
+	// if set, Endpoint and TokenPath are ignored
 	TokenEndpoint string
+	// the IAM endpoint. for example: https://iam.example.com
+	Endpoint string
+	// the path of token API, default is DefaultIAMTokenPath
+	TokenPath string
 	// client ID and secret may be configurable in future
 	// ClientID      string
 	// ClientSecret  string
 }
 
+func (c *IAMConfig) tokenEndpoint() string {
+	if c.TokenEndpoint != "" {
+		return c.TokenEndpoint
+	}
+
+	path := c.TokenPath
+	if path == "" {
+		path = DefaultIAMTokenPath
+	}
+	return strings.TrimRight(c.Endpoint, "/") + "/" + strings.TrimLeft(path, "/")
+}
+
+// introspectEndpoint returns the endpoint of the introspection API on the
+// IAM endpoint. If only the token endpoint is set, the IAM endpoint is its
+// scheme and host.
+func (c *IAMConfig) introspectEndpoint() string {
+	endpoint := c.Endpoint
+	if endpoint == "" {
+		if u, err := url.Parse(c.TokenEndpoint); err == nil && u.Host != "" {
+			endpoint = u.Scheme + "://" + u.Host
+		}
+	}
+	return strings.TrimRight(endpoint, "/") + "/identity/introspect"
+}
+
 type iamAuthRepository struct {
 	config *IAMConfig
 	client *rest.Client
 }
 
+// NewIAMAuthRepository creates an IAMAuthRepository. Use NewIAMClient for the
+// APIs of IAMClient, e.g. Introspect.
 func NewIAMAuthRepository(config *IAMConfig, client *rest.Client) IAMAuthRepository {
+	return NewIAMClient(config, client)
+}
+
+// NewIAMClient creates an IAMClient.
+func NewIAMClient(config *IAMConfig, client *rest.Client) IAMClient {
 	return &iamAuthRepository{
 		config: config,
 		client: client,
@@ -66,6 +129,17 @@ func (auth *iamAuthRepository) AuthenticateAPIKey(apiKey string) (Token, error)
 	return auth.getIAMToken("urn:ibm:params:oauth:grant-type:apikey", map[string]string{"apikey": apiKey})
 }
 
+// AuthenticateAuthorizationCode exchanges the authorization code received by
+// the redirect URI for a token, with the code verifier of the PKCE sent in
+// the authorization request. See AuthorizationURL and CallbackServer.
+func (auth *iamAuthRepository) AuthenticateAuthorizationCode(code string, redirectURI string, codeVerifier string) (Token, error) {
+	return auth.getIAMToken("authorization_code", map[string]string{
+		"code":          code,
+		"redirect_uri":  redirectURI,
+		"code_verifier": codeVerifier,
+	})
+}
+
 func (auth *iamAuthRepository) AuthenticateSSO(passcode string) (Token, error) {
 	return auth.getIAMToken("urn:ibm:params:oauth:grant-type:passcode", map[string]string{"passcode": passcode})
 }
@@ -125,6 +199,53 @@ func (auth *iamAuthRepository) RefreshTokenToLinkAccountsAndGetUAAToken(refreshT
 	return tokens.iamToken(), tokens.uaaToken(), nil
 }
 
+func (auth *iamAuthRepository) Introspect(token string) (IntrospectResult, error) {
+	req := rest.PostRequest(auth.config.introspectEndpoint()).
+		Set("Authorization", auth.basicAuthorization()).
+		Field("token", token)
+
+	var result IntrospectResult
+	err := auth.sendRequest(req, &result)
+	if err != nil {
+		return IntrospectResult{}, err
+	}
+	return result, nil
+}
+
+// ExchangeTokenForService exchanges the IAM refresh token for a delegated
+// refresh token that can only be consumed by the service identified by
+// receiverClientID, e.g. "registry" for Container Registry. The delegated
+// refresh token is returned in the RefreshToken field of the token.
+//
+// The request is the refresh token grant of the IAM token API with
+// response_type "delegated_refresh_token" and parameter
+// "receiver_client_ids", see
+// https://cloud.ibm.com/apidocs/iam-identity-token-api. The receiver client
+// ID is defined by the service. Services that accept the IAM access token
+// directly (most of the resource controller managed services) do not require
+// the exchange.
+func (auth *iamAuthRepository) ExchangeTokenForService(refreshToken string, receiverClientID string) (Token, error) {
+	req := rest.PostRequest(auth.config.tokenEndpoint()).
+		Set("Authorization", auth.basicAuthorization()).
+		Field("grant_type", "refresh_token").
+		Field("refresh_token", refreshToken).
+		Field("response_type", "delegated_refresh_token").
+		Field("receiver_client_ids", receiverClientID)
+
+	var res struct {
+		DelegatedRefreshToken string `json:"delegated_refresh_token"`
+		TokenType             string `json:"token_type"`
+	}
+	err := auth.sendRequest(req, &res)
+	if err != nil {
+		return Token{}, err
+	}
+	if res.DelegatedRefreshToken == "" {
+		return Token{}, errors.New(T("No delegated refresh token in the response of IAM"))
+	}
+	return Token{RefreshToken: res.DelegatedRefreshToken, TokenType: res.TokenType}, nil
+}
+
 type tokenRequest struct {
 	iamTokenRequired bool
 	uaaTokenRequired bool
@@ -138,13 +259,18 @@ type tokenResponse struct {
 	UAAAccessToken  string `json:"uaa_token"`
 	UAARefreshToken string `json:"uaa_refresh_token"`
 	TokenType       string `json:"token_type"`
+
+	Expiration             int64 `json:"expiration"`
+	RefreshTokenExpiration int64 `json:"refresh_token_expiration"`
 }
 
 func (res tokenResponse) iamToken() Token {
 	return Token{
-		AccessToken:  res.AccessToken,
-		RefreshToken: res.RefreshToken,
-		TokenType:    res.TokenType,
+		AccessToken:            res.AccessToken,
+		RefreshToken:           res.RefreshToken,
+		TokenType:              res.TokenType,
+		Expiration:             res.Expiration,
+		RefreshTokenExpiration: res.RefreshTokenExpiration,
 	}
 }
 
@@ -157,8 +283,8 @@ func (res tokenResponse) uaaToken() Token {
 }
 
 func (auth *iamAuthRepository) getToken(r tokenRequest) (tokenResponse, error) {
-	req := rest.PostRequest(auth.config.TokenEndpoint)
-	req.Set("Authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%s:%s", iamClientID, iamClientSecret))))
+	req := rest.PostRequest(auth.config.tokenEndpoint())
+	req.Set("Authorization", auth.basicAuthorization())
 
 	var grantTypes []string
 	if r.iamTokenRequired {
@@ -188,18 +314,34 @@ func (auth *iamAuthRepository) getToken(r tokenRequest) (tokenResponse, error) {
 	return tokens, nil
 }
 
+func (auth *iamAuthRepository) basicAuthorization() string {
+	return "Basic " + base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%s:%s", iamClientID, iamClientSecret)))
+}
+
 func (auth *iamAuthRepository) sendRequest(req *rest.Request, respV interface{}) error {
-	_, err := auth.client.Do(req, respV, nil)
+	resp, err := auth.client.Do(req, respV, nil)
+	return checkServiceUnavailable(resp, checkClockSkew(resp, convertIAMError(err)))
+}
+
+// convertIAMError converts the error response of IAM to the error types of
+// the package.
+func convertIAMError(err error) error {
 	switch err := err.(type) {
 	case *rest.ErrorResponse:
-		var apiErr IAMError
-		if e := json.Unmarshal([]byte(err.Message), &apiErr); e == nil {
-			switch apiErr.ErrorCode {
-			case "":
-			case "BXNIM0407E":
-				return NewInvalidTokenError(apiErr.detailsOrMessage())
-			default:
-				return NewServerError(err.StatusCode, apiErr.ErrorCode, apiErr.detailsOrMessage())
+		if scopeErr, ok := ParseInsufficientScopeError(err); ok {
+			return scopeErr
+		}
+
+		if apiErr := parseIAMError([]byte(err.Message)); apiErr != nil {
+			if apiErr.ErrorCode == "BXNIM0407E" {
+				return NewInvalidTokenError(apiErr.Description())
+			}
+			apiErr.StatusCode = err.StatusCode
+			return &ServerError{
+				StatusCode:  err.StatusCode,
+				ErrorCode:   apiErr.ErrorCode,
+				Description: apiErr.Description(),
+				Err:         apiErr,
 			}
 		}
 	}
@@ -0,0 +1,176 @@
+package authentication
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/common/rest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIAMConfigTokenEndpoint(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		config   IAMConfig
+		expected string
+	}{
+		{IAMConfig{Endpoint: "https://iam.example.com"}, "https://iam.example.com/identity/token"},
+		{IAMConfig{Endpoint: "https://iam.example.com/"}, "https://iam.example.com/identity/token"},
+		{IAMConfig{Endpoint: "https://iam.example.com", TokenPath: "/oidc/token"}, "https://iam.example.com/oidc/token"},
+		{IAMConfig{Endpoint: "https://iam.example.com", TokenPath: "oidc/token"}, "https://iam.example.com/oidc/token"},
+		{IAMConfig{TokenEndpoint: "https://iam.example.com/custom/token", TokenPath: "/oidc/token"}, "https://iam.example.com/custom/token"},
+	}
+
+	for _, test := range tests {
+		assert.Equal(test.expected, test.config.tokenEndpoint())
+	}
+}
+
+func TestIAMConfigIntrospectEndpoint(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		config   IAMConfig
+		expected string
+	}{
+		{IAMConfig{Endpoint: "https://iam.example.com/"}, "https://iam.example.com/identity/introspect"},
+		{IAMConfig{Endpoint: "https://iam.example.com", TokenPath: "/oidc/token"}, "https://iam.example.com/identity/introspect"},
+		{IAMConfig{TokenEndpoint: "https://iam.example.com/custom/token"}, "https://iam.example.com/identity/introspect"},
+		{IAMConfig{Endpoint: "https://iam.example.com", TokenEndpoint: "https://token.example.com/identity/token"}, "https://iam.example.com/identity/introspect"},
+	}
+
+	for _, test := range tests {
+		assert.Equal(test.expected, test.config.introspectEndpoint())
+	}
+}
+
+func TestIntrospect(t *testing.T) {
+	assert := assert.New(t)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal("/identity/introspect", r.URL.Path)
+		assert.Equal("Basic Yng6Yng=", r.Header.Get("Authorization"))
+		assert.NoError(r.ParseForm())
+		assert.Equal("my-token", r.PostForm.Get("token"))
+		fmt.Fprint(w, `{"active": true, "exp": 1700003600, "iat": 1700000000, "iam_id": "IBMid-123", "client_id": "bx", "token_type": "Bearer"}`)
+	}))
+	defer ts.Close()
+
+	auth := NewIAMClient(&IAMConfig{TokenEndpoint: ts.URL + "/oidc/token"}, rest.NewClient())
+	result, err := auth.Introspect("my-token")
+	assert.NoError(err)
+	assert.Equal(IntrospectResult{
+		Active:    true,
+		Expiry:    1700003600,
+		IssuedAt:  1700000000,
+		ClientID:  "bx",
+		IAMID:     "IBMid-123",
+		TokenType: "Bearer",
+	}, result)
+}
+
+func TestExchangeTokenForService(t *testing.T) {
+	assert := assert.New(t)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal("/identity/token", r.URL.Path)
+		assert.Equal("Basic Yng6Yng=", r.Header.Get("Authorization"))
+		assert.NoError(r.ParseForm())
+		assert.Equal("refresh_token", r.PostForm.Get("grant_type"))
+		assert.Equal("delegated_refresh_token", r.PostForm.Get("response_type"))
+		assert.Empty(r.PostForm.Get("access_token"))
+
+		switch r.PostForm.Get("refresh_token") {
+		case "expired":
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"errorCode": "BXNIM0408E", "errorMessage": "Refresh token is expired"}`)
+		case "valid":
+			if r.PostForm.Get("receiver_client_ids") != "registry" {
+				// IAM returns only the access token for unknown clients
+				fmt.Fprint(w, `{"access_token": "access", "token_type": "Bearer"}`)
+				return
+			}
+			fmt.Fprint(w, `{"delegated_refresh_token": "delegated", "token_type": "Bearer"}`)
+		}
+	}))
+	defer ts.Close()
+
+	auth := NewIAMClient(&IAMConfig{Endpoint: ts.URL}, rest.NewClient())
+	token, err := auth.ExchangeTokenForService("valid", "registry")
+	assert.NoError(err)
+	assert.Equal(Token{RefreshToken: "delegated", TokenType: "Bearer"}, token)
+
+	_, err = auth.ExchangeTokenForService("valid", "unknown")
+	assert.EqualError(err, "No delegated refresh token in the response of IAM")
+
+	_, err = auth.ExchangeTokenForService("expired", "registry")
+	var serverErr *ServerError
+	if assert.True(errors.As(err, &serverErr)) {
+		assert.Equal("BXNIM0408E", serverErr.ErrorCode)
+	}
+}
+
+func TestAuthenticateAPIKey_TokenPath(t *testing.T) {
+	assert := assert.New(t)
+
+	var requestPath string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestPath = r.URL.Path
+		fmt.Fprint(w, `{"access_token": "access", "refresh_token": "refresh", "token_type": "Bearer", "expiration": 1700003600, "refresh_token_expiration": 1702592000}`)
+	}))
+	defer ts.Close()
+
+	auth := NewIAMAuthRepository(&IAMConfig{Endpoint: ts.URL}, rest.NewClient())
+	token, err := auth.AuthenticateAPIKey("my-api-key")
+	assert.NoError(err)
+	assert.Equal("/identity/token", requestPath)
+	assert.Equal("access", token.AccessToken)
+	assert.Equal(int64(1700003600), token.ExpiresAt().Unix())
+	assert.Equal(int64(1702592000), token.RefreshTokenExpiresAt().Unix())
+	assert.True(Token{}.RefreshTokenExpiresAt().IsZero())
+
+	auth = NewIAMAuthRepository(&IAMConfig{Endpoint: ts.URL, TokenPath: "/oidc/token"}, rest.NewClient())
+	_, err = auth.AuthenticateAPIKey("my-api-key")
+	assert.NoError(err)
+	assert.Equal("/oidc/token", requestPath)
+}
+
+func TestAuthenticateAPIKey_IAMError(t *testing.T) {
+	assert := assert.New(t)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"errorCode": "BXNIM0408E", "errorMessage": "Provided API key could not be found"}`)
+	}))
+	defer ts.Close()
+
+	auth := NewIAMAuthRepository(&IAMConfig{Endpoint: ts.URL}, rest.NewClient())
+	_, err := auth.AuthenticateAPIKey("my-api-key")
+
+	serverErr, ok := err.(*ServerError)
+	assert.True(ok)
+	assert.Equal(http.StatusBadRequest, serverErr.StatusCode)
+	assert.Equal("BXNIM0408E", serverErr.ErrorCode)
+	assert.Equal("Provided API key could not be found", serverErr.Description)
+
+	var iamErr *IAMError
+	assert.True(errors.As(err, &iamErr))
+	assert.Equal(http.StatusBadRequest, iamErr.StatusCode)
+	assert.Equal("BXNIM0408E", iamErr.ErrorCode)
+	assert.Equal("Provided API key could not be found", iamErr.Description())
+}
+
+func TestParseIAMError(t *testing.T) {
+	assert := assert.New(t)
+
+	e := parseIAMError([]byte(`{"errorCode": "BXNIM0415E", "errorMessage": "msg", "errorDetails": "details"}`))
+	assert.Equal(&IAMError{ErrorCode: "BXNIM0415E", ErrorMessage: "msg", ErrorDetails: "details"}, e)
+	assert.Equal("details", e.Description())
+
+	assert.Nil(parseIAMError([]byte(`not json`)))
+	assert.Nil(parseIAMError([]byte(`{"error": "invalid_grant"}`)))
+}
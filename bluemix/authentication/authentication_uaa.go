@@ -146,7 +146,13 @@ func (auth *uaaRepository) DisconnectIAM(uaaToken string) error {
 }
 
 func (auth *uaaRepository) sendRequest(req *rest.Request, respV interface{}) error {
-	_, err := auth.client.Do(req, respV, nil)
+	resp, err := auth.client.Do(req, respV, nil)
+	return checkClockSkew(resp, convertUAAError(err))
+}
+
+// convertUAAError converts the error response of UAA to the error types of
+// the package.
+func convertUAAError(err error) error {
 	switch err := err.(type) {
 	case *rest.ErrorResponse:
 		var apiErr UAAError
@@ -0,0 +1,146 @@
+package authentication
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	. "github.com/IBM-Cloud/ibm-cloud-cli-sdk/i18n"
+)
+
+// CallbackPath is the path of the redirect URI served by CallbackServer
+const CallbackPath = "/callback"
+
+// ErrCallbackTimeout means the redirect was not received in time, e.g. the
+// user didn't complete the login in the browser.
+var ErrCallbackTimeout = errors.New(T("Timed out waiting for the authorization in the browser"))
+
+// AuthorizationError is the error returned to the redirect URI by the
+// authorization server, e.g. the user denied the access.
+type AuthorizationError struct {
+	Code        string // e.g. "access_denied"
+	Description string
+}
+
+func (e *AuthorizationError) Error() string {
+	if e.Description == "" {
+		return T("Authorization failed: {{.Code}}", map[string]interface{}{"Code": e.Code})
+	}
+	return T("Authorization failed: {{.Code}}: {{.Description}}", map[string]interface{}{"Code": e.Code, "Description": e.Description})
+}
+
+type callbackResult struct {
+	code string
+	err  error
+}
+
+// CallbackServer is a short-lived HTTP server on localhost that receives the
+// redirect of the OAuth authorization code flow:
+//
+//	server, err := NewCallbackServer(state)
+//	defer server.Close()
+//	authURL, err := AuthorizationURL(AuthorizationRequest{RedirectURI: server.RedirectURI(), State: state, ...})
+//	// open authURL in the browser
+//	code, err := server.WaitForCode(5 * time.Minute)
+//	token, err := auth.AuthenticateAuthorizationCode(code, server.RedirectURI(), pkce.Verifier)
+type CallbackServer struct {
+	state    string
+	listener net.Listener
+	server   *http.Server
+	result   chan callbackResult
+	once     sync.Once
+}
+
+// NewCallbackServer starts a callback server on a free port of the loopback
+// interface. The state parameter of the redirect must match state, which
+// must not be empty. Requests with another state are answered with
+// 400 Bad Request and otherwise ignored.
+func NewCallbackServer(state string) (*CallbackServer, error) {
+	if state == "" {
+		return nil, errors.New(T("The state of the authorization request must not be empty"))
+	}
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+
+	s := &CallbackServer{
+		state:    state,
+		listener: listener,
+		result:   make(chan callbackResult, 1),
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(CallbackPath, s.handleCallback)
+	s.server = &http.Server{Handler: mux}
+
+	go s.server.Serve(listener)
+	return s, nil
+}
+
+// RedirectURI returns the redirect URI to be used in the authorization
+// request, e.g. http://127.0.0.1:53682/callback.
+func (s *CallbackServer) RedirectURI() string {
+	return "http://" + s.listener.Addr().String() + CallbackPath
+}
+
+// WaitForCode waits for the redirect and returns the authorization code. An
+// AuthorizationError is returned if the authorization server reports an
+// error, ErrCallbackTimeout if no redirect is received within timeout.
+func (s *CallbackServer) WaitForCode(timeout time.Duration) (string, error) {
+	select {
+	case r := <-s.result:
+		return r.code, r.err
+	case <-time.After(timeout):
+		return "", ErrCallbackTimeout
+	}
+}
+
+// Close shuts down the server, waiting for the response to the browser to
+// complete.
+func (s *CallbackServer) Close() error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	return s.server.Shutdown(ctx)
+}
+
+func (s *CallbackServer) handleCallback(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+
+	// a request with another state is not the redirect of this login, so
+	// ignore it and keep waiting
+	if q.Get("state") != s.state {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintln(w, T("Invalid state in the authorization response"))
+		return
+	}
+
+	var result callbackResult
+	switch {
+	case q.Get("error") != "":
+		result.err = &AuthorizationError{Code: q.Get("error"), Description: q.Get("error_description")}
+	case q.Get("code") == "":
+		result.err = errors.New(T("No authorization code in the authorization response"))
+	default:
+		result.code = q.Get("code")
+	}
+
+	if result.err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintln(w, result.err.Error())
+	} else {
+		fmt.Fprintln(w, T("Authorization succeeded. You can close this window and return to the terminal."))
+	}
+
+	// only the first redirect is accepted
+	s.once.Do(func() {
+		s.result <- result
+	})
+}
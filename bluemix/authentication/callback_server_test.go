@@ -0,0 +1,107 @@
+package authentication
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/common/rest"
+)
+
+func TestCallbackServer(t *testing.T) {
+	assert := assert.New(t)
+
+	server, err := NewCallbackServer("xyz")
+	assert.NoError(err)
+	defer server.Close()
+
+	assert.True(strings.HasPrefix(server.RedirectURI(), "http://127.0.0.1:"))
+	assert.True(strings.HasSuffix(server.RedirectURI(), "/callback"))
+
+	resp, err := http.Get(server.RedirectURI() + "?state=xyz&code=auth-code")
+	assert.NoError(err)
+	resp.Body.Close()
+	assert.Equal(http.StatusOK, resp.StatusCode)
+
+	code, err := server.WaitForCode(time.Second)
+	assert.NoError(err)
+	assert.Equal("auth-code", code)
+}
+
+func TestCallbackServer_Error(t *testing.T) {
+	assert := assert.New(t)
+
+	server, err := NewCallbackServer("xyz")
+	assert.NoError(err)
+	defer server.Close()
+
+	resp, err := http.Get(server.RedirectURI() + "?state=xyz&error=access_denied&error_description=denied")
+	assert.NoError(err)
+	resp.Body.Close()
+	assert.Equal(http.StatusBadRequest, resp.StatusCode)
+
+	_, err = server.WaitForCode(time.Second)
+	assert.Equal(&AuthorizationError{Code: "access_denied", Description: "denied"}, err)
+}
+
+func TestCallbackServer_InvalidState(t *testing.T) {
+	server, err := NewCallbackServer("xyz")
+	assert.NoError(t, err)
+	defer server.Close()
+
+	for _, query := range []string{"?state=abc&code=bad-code", "?code=bad-code"} {
+		resp, err := http.Get(server.RedirectURI() + query)
+		assert.NoError(t, err)
+		resp.Body.Close()
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	}
+
+	_, err = server.WaitForCode(10 * time.Millisecond)
+	assert.Equal(t, ErrCallbackTimeout, err)
+
+	resp, err := http.Get(server.RedirectURI() + "?state=xyz&code=auth-code")
+	assert.NoError(t, err)
+	resp.Body.Close()
+
+	code, err := server.WaitForCode(time.Second)
+	assert.NoError(t, err)
+	assert.Equal(t, "auth-code", code)
+}
+
+func TestCallbackServer_EmptyState(t *testing.T) {
+	_, err := NewCallbackServer("")
+	assert.Error(t, err)
+}
+
+func TestCallbackServer_Timeout(t *testing.T) {
+	server, err := NewCallbackServer("xyz")
+	assert.NoError(t, err)
+	defer server.Close()
+
+	_, err = server.WaitForCode(10 * time.Millisecond)
+	assert.Equal(t, ErrCallbackTimeout, err)
+}
+
+func TestAuthenticateAuthorizationCode(t *testing.T) {
+	assert := assert.New(t)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.NoError(r.ParseForm())
+		assert.Equal("authorization_code", r.PostForm.Get("grant_type"))
+		assert.Equal("auth-code", r.PostForm.Get("code"))
+		assert.Equal("http://127.0.0.1:8080/callback", r.PostForm.Get("redirect_uri"))
+		assert.Equal("verifier", r.PostForm.Get("code_verifier"))
+		fmt.Fprint(w, `{"access_token": "access", "refresh_token": "refresh", "token_type": "Bearer"}`)
+	}))
+	defer ts.Close()
+
+	auth := NewIAMAuthRepository(&IAMConfig{Endpoint: ts.URL}, rest.NewClient())
+	token, err := auth.AuthenticateAuthorizationCode("auth-code", "http://127.0.0.1:8080/callback", "verifier")
+	assert.NoError(err)
+	assert.Equal("Bearer access", token.Token())
+}
@@ -0,0 +1,66 @@
+package authentication
+
+import (
+	"net/http"
+	"time"
+
+	. "github.com/IBM-Cloud/ibm-cloud-cli-sdk/i18n"
+)
+
+// MaxClockSkew is the maximum tolerated difference between the local clock
+// and the server clock. Beyond it, tokens may be considered expired or not
+// yet valid.
+var MaxClockSkew = 5 * time.Minute
+
+// ClockSkewError means the local clock is off from the server clock by more
+// than MaxClockSkew, which is likely the cause of the authentication failure
+// Err.
+type ClockSkewError struct {
+	Offset time.Duration // offset of the local clock, positive if ahead of the server
+	Err    error         // the error returned by the server
+}
+
+func (e *ClockSkewError) Error() string {
+	return T("The local clock is off by {{.Offset}} from the server clock, which invalidates tokens. Synchronize the system clock and try again.",
+		map[string]interface{}{"Offset": e.Offset.String()}) + "\n" + e.Err.Error()
+}
+
+func (e *ClockSkewError) Unwrap() error {
+	return e.Err
+}
+
+// ClockSkew returns the offset of the local clock from the server clock
+// measured from the Date header of the response, positive if the local clock
+// is ahead. It returns false if the response has no valid Date header.
+//
+// The Date header has a precision of one second, so the offset is only
+// accurate to a second.
+func ClockSkew(resp *http.Response) (time.Duration, bool) {
+	if resp == nil {
+		return 0, false
+	}
+
+	date, err := http.ParseTime(resp.Header.Get("Date"))
+	if err != nil {
+		return 0, false
+	}
+	return time.Since(date).Truncate(time.Second), true
+}
+
+// checkClockSkew returns a ClockSkewError wrapping err if the server rejects
+// the request as bad or unauthorized and the clock skew measured from the
+// response exceeds MaxClockSkew. Otherwise it returns err.
+func checkClockSkew(resp *http.Response, err error) error {
+	if err == nil || resp == nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusBadRequest && resp.StatusCode != http.StatusUnauthorized {
+		return err
+	}
+
+	offset, ok := ClockSkew(resp)
+	if !ok || (offset <= MaxClockSkew && offset >= -MaxClockSkew) {
+		return err
+	}
+	return &ClockSkewError{Offset: offset, Err: err}
+}
@@ -0,0 +1,54 @@
+package authentication
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/common/rest"
+)
+
+func TestClockSkew(t *testing.T) {
+	assert := assert.New(t)
+
+	resp := &http.Response{Header: http.Header{}}
+	_, ok := ClockSkew(resp)
+	assert.False(ok)
+
+	resp.Header.Set("Date", time.Now().Add(-time.Hour).UTC().Format(http.TimeFormat))
+	offset, ok := ClockSkew(resp)
+	assert.True(ok)
+	assert.InDelta(time.Hour.Seconds(), offset.Seconds(), 2)
+}
+
+func TestAuthenticateAPIKey_ClockSkew(t *testing.T) {
+	assert := assert.New(t)
+
+	var date time.Time
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Date", date.UTC().Format(http.TimeFormat))
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"errorCode": "BXNIM0418E", "errorMessage": "Token is expired"}`)
+	}))
+	defer ts.Close()
+
+	auth := NewIAMAuthRepository(&IAMConfig{Endpoint: ts.URL}, rest.NewClient())
+
+	date = time.Now().Add(-10 * time.Minute)
+	_, err := auth.AuthenticateAPIKey("my-api-key")
+	assert.IsType(&ClockSkewError{}, err)
+	assert.True(err.(*ClockSkewError).Offset > MaxClockSkew)
+	assert.IsType(&ServerError{}, err.(*ClockSkewError).Err)
+	var iamErr *IAMError
+	assert.True(errors.As(err, &iamErr))
+	assert.Equal("BXNIM0418E", iamErr.ErrorCode)
+
+	date = time.Now()
+	_, err = auth.AuthenticateAPIKey("my-api-key")
+	assert.IsType(&ServerError{}, err)
+}
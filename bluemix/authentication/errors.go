@@ -1,6 +1,10 @@
 package authentication
 
 import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/common/rest"
 	. "github.com/IBM-Cloud/ibm-cloud-cli-sdk/i18n"
 )
 
@@ -20,6 +24,7 @@ type ServerError struct {
 	StatusCode  int
 	ErrorCode   string
 	Description string
+	Err         error // the parsed error response, e.g. an *IAMError, if any
 }
 
 func (s *ServerError) Error() string {
@@ -27,6 +32,12 @@ func (s *ServerError) Error() string {
 		map[string]interface{}{"StatusCode": s.StatusCode, "ErrorCode": s.ErrorCode, "Message": s.Description})
 }
 
+// Unwrap returns the parsed error response, so that errors.As can retrieve
+// e.g. the *IAMError of an IAM request.
+func (s *ServerError) Unwrap() error {
+	return s.Err
+}
+
 func NewServerError(statusCode int, errorCode string, description string) *ServerError {
 	return &ServerError{
 		StatusCode:  statusCode,
@@ -34,3 +45,138 @@ func NewServerError(statusCode int, errorCode string, description string) *Serve
 		Description: description,
 	}
 }
+
+type AccountAccessError struct {
+	AccountID   string
+	Description string
+}
+
+func (e *AccountAccessError) Error() string {
+	return T("No access to account {{.AccountID}}: {{.Message}}",
+		map[string]interface{}{"AccountID": e.AccountID, "Message": e.Description})
+}
+
+func NewAccountAccessError(accountID string, description string) *AccountAccessError {
+	return &AccountAccessError{
+		AccountID:   accountID,
+		Description: description,
+	}
+}
+
+// EnterpriseAccessError means the user is not permitted to operate on a child
+// account of the enterprise.
+type EnterpriseAccessError struct {
+	AccountID   string
+	Description string
+}
+
+func (e *EnterpriseAccessError) Error() string {
+	return T("Not permitted to access child account {{.AccountID}} of the enterprise: {{.Message}}",
+		map[string]interface{}{"AccountID": e.AccountID, "Message": e.Description})
+}
+
+func NewEnterpriseAccessError(accountID string, description string) *EnterpriseAccessError {
+	return &EnterpriseAccessError{
+		AccountID:   accountID,
+		Description: description,
+	}
+}
+
+// IAMError is an error response returned by IAM, e.g.
+//
+//	{"errorCode": "BXNIM0408E", "errorMessage": "Provided API key could not be found"}
+//
+// IAM requests return it wrapped in a *ServerError, use errors.As to
+// retrieve it.
+type IAMError struct {
+	StatusCode   int    `json:"-"`
+	ErrorCode    string `json:"errorCode"`
+	ErrorMessage string `json:"errorMessage"`
+	ErrorDetails string `json:"errorDetails"`
+}
+
+func (e *IAMError) Error() string {
+	return T("Remote server error. Status code: {{.StatusCode}}, error code: {{.ErrorCode}}, message: {{.Message}}",
+		map[string]interface{}{"StatusCode": e.StatusCode, "ErrorCode": e.ErrorCode, "Message": e.Description()})
+}
+
+// Description returns the error details, or the error message if there are
+// no details.
+func (e IAMError) Description() string {
+	if e.ErrorDetails != "" {
+		return e.ErrorDetails
+	}
+	return e.ErrorMessage
+}
+
+// parseIAMError parses the IAM error response. It returns nil if the
+// response is not an IAM error.
+func parseIAMError(body []byte) *IAMError {
+	var e IAMError
+	if json.Unmarshal(body, &e) != nil || e.ErrorCode == "" {
+		return nil
+	}
+	return &e
+}
+
+// InsufficientScopeError is returned when a request is rejected with 403
+// because the token lacks the scope or role required by the operation.
+type InsufficientScopeError struct {
+	ErrorCode     string
+	RequiredScope string
+	RequiredRole  string
+	Description   string
+}
+
+func (e *InsufficientScopeError) Error() string {
+	required := e.RequiredScope
+	if e.RequiredRole != "" {
+		required = e.RequiredRole
+	}
+	if required == "" {
+		return T("Your token does not have the permission required for this operation: {{.Message}}",
+			map[string]interface{}{"Message": e.Description})
+	}
+	return T("Your token lacks '{{.Required}}' required for this operation: {{.Message}}",
+		map[string]interface{}{"Required": required, "Message": e.Description})
+}
+
+type scopeErrorBody struct {
+	IAMError
+	RequiredScope string `json:"requiredScope"`
+	RequiredRole  string `json:"requiredRole"`
+}
+
+// ParseInsufficientScopeError returns the InsufficientScopeError carried by
+// err if err is a 403 rest.ErrorResponse whose body is an IAM error stating
+// the missing scope or role, e.g.
+//
+//	{"errorCode": "insufficient_scope", "errorMessage": "...", "requiredRole": "Editor"}
+//
+// The second return value is false if err is not a scope-related error.
+func ParseInsufficientScopeError(err error) (*InsufficientScopeError, bool) {
+	switch e := err.(type) {
+	case *InsufficientScopeError:
+		return e, true
+	case *rest.ErrorResponse:
+		if e.StatusCode != http.StatusForbidden {
+			return nil, false
+		}
+
+		var body scopeErrorBody
+		if json.Unmarshal([]byte(e.Message), &body) != nil {
+			return nil, false
+		}
+		if body.ErrorCode != "insufficient_scope" && body.RequiredScope == "" && body.RequiredRole == "" {
+			return nil, false
+		}
+
+		return &InsufficientScopeError{
+			ErrorCode:     body.ErrorCode,
+			RequiredScope: body.RequiredScope,
+			RequiredRole:  body.RequiredRole,
+			Description:   body.Description(),
+		}, true
+	}
+	return nil, false
+}
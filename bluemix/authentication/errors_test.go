@@ -0,0 +1,32 @@
+package authentication
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/common/rest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseInsufficientScopeError(t *testing.T) {
+	assert := assert.New(t)
+
+	err := &rest.ErrorResponse{
+		StatusCode: 403,
+		Message:    `{"errorCode":"insufficient_scope","errorMessage":"Operation not allowed","requiredRole":"Editor"}`,
+	}
+	scopeErr, ok := ParseInsufficientScopeError(err)
+	assert.True(ok)
+	assert.Equal("Editor", scopeErr.RequiredRole)
+	assert.Equal("Operation not allowed", scopeErr.Description)
+	assert.Equal("Your token lacks 'Editor' required for this operation: Operation not allowed", scopeErr.Error())
+
+	_, ok = ParseInsufficientScopeError(&rest.ErrorResponse{StatusCode: 403, Message: `{"errorCode":"BXNIM0400E"}`})
+	assert.False(ok)
+
+	_, ok = ParseInsufficientScopeError(&rest.ErrorResponse{StatusCode: 401, Message: `{"errorCode":"insufficient_scope"}`})
+	assert.False(ok)
+
+	_, ok = ParseInsufficientScopeError(errors.New("forbidden"))
+	assert.False(ok)
+}
@@ -0,0 +1,127 @@
+package authentication
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"net/url"
+	"strings"
+
+	. "github.com/IBM-Cloud/ibm-cloud-cli-sdk/i18n"
+)
+
+// Paths of the authorization endpoints of IAM and UAA
+const (
+	DefaultIAMAuthorizePath = "/identity/authorize"
+	DefaultUAAAuthorizePath = "/oauth/authorize"
+)
+
+// PKCEMethodS256 is the PKCE code challenge method, the only one supported
+const PKCEMethodS256 = "S256"
+
+// PKCE is a code verifier and its code challenge for Proof Key for Code
+// Exchange (RFC 7636) in the OAuth authorization code flow. The challenge is
+// sent in the authorization request, see AuthorizationURL, and the verifier
+// in the token request exchanging the authorization code.
+type PKCE struct {
+	Verifier  string
+	Challenge string
+	Method    string // always PKCEMethodS256
+}
+
+// NewPKCE generates a random code verifier of 43 characters and its S256 code
+// challenge.
+func NewPKCE() (PKCE, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return PKCE{}, err
+	}
+
+	verifier := base64.RawURLEncoding.EncodeToString(b)
+	return PKCE{
+		Verifier:  verifier,
+		Challenge: PKCEChallenge(verifier),
+		Method:    PKCEMethodS256,
+	}, nil
+}
+
+// PKCEChallenge returns the S256 code challenge of the code verifier, i.e.
+// the unpadded base64url encoding of the SHA-256 hash of the verifier.
+func PKCEChallenge(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// ValidatePKCEVerifier returns an error if the code verifier is not 43 to 128
+// characters of [A-Z] / [a-z] / [0-9] / "-" / "." / "_" / "~" as required by
+// RFC 7636.
+func ValidatePKCEVerifier(verifier string) error {
+	if len(verifier) < 43 || len(verifier) > 128 {
+		return errors.New(T("PKCE code verifier must be 43 to 128 characters long"))
+	}
+	for _, c := range verifier {
+		if !isUnreserved(c) {
+			return errors.New(T("PKCE code verifier contains invalid character '{{.Char}}'", map[string]interface{}{"Char": string(c)}))
+		}
+	}
+	return nil
+}
+
+// VerifyPKCE returns whether the code challenge matches the code verifier.
+func VerifyPKCE(verifier string, challenge string) bool {
+	return subtle.ConstantTimeCompare([]byte(PKCEChallenge(verifier)), []byte(challenge)) == 1
+}
+
+func isUnreserved(c rune) bool {
+	return (c >= 'A' && c <= 'Z') || (c >= 'a' && c <= 'z') || (c >= '0' && c <= '9') ||
+		c == '-' || c == '.' || c == '_' || c == '~'
+}
+
+// AuthorizationRequest is the authorization request of the OAuth
+// authorization code flow with PKCE.
+type AuthorizationRequest struct {
+	// the authorization endpoint, e.g.
+	// https://iam.cloud.ibm.com/identity/authorize
+	Endpoint    string
+	ClientID    string
+	RedirectURI string
+	State       string   // opaque value to be returned in the redirect, to prevent CSRF
+	Scopes      []string // optional
+	PKCE        PKCE
+}
+
+// AuthorizationURL returns the URL to open in the browser for the user to
+// authorize the request. The code challenge of the PKCE is included while
+// the code verifier is kept to exchange the authorization code for a token.
+func AuthorizationURL(r AuthorizationRequest) (string, error) {
+	u, err := url.Parse(r.Endpoint)
+	if err != nil || u.Host == "" {
+		return "", errors.New(T("Invalid authorization endpoint '{{.Endpoint}}'", map[string]interface{}{"Endpoint": r.Endpoint}))
+	}
+	if err := ValidatePKCEVerifier(r.PKCE.Verifier); err != nil {
+		return "", err
+	}
+
+	method := r.PKCE.Method
+	if method == "" {
+		method = PKCEMethodS256
+	}
+
+	q := u.Query()
+	q.Set("response_type", "code")
+	q.Set("client_id", r.ClientID)
+	q.Set("redirect_uri", r.RedirectURI)
+	if r.State != "" {
+		q.Set("state", r.State)
+	}
+	if len(r.Scopes) > 0 {
+		q.Set("scope", strings.Join(r.Scopes, " "))
+	}
+	q.Set("code_challenge", r.PKCE.Challenge)
+	q.Set("code_challenge_method", method)
+	u.RawQuery = q.Encode()
+
+	return u.String(), nil
+}
@@ -0,0 +1,71 @@
+package authentication
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewPKCE(t *testing.T) {
+	assert := assert.New(t)
+
+	p, err := NewPKCE()
+	assert.NoError(err)
+	assert.Len(p.Verifier, 43)
+	assert.Equal(PKCEMethodS256, p.Method)
+	assert.NoError(ValidatePKCEVerifier(p.Verifier))
+	assert.True(VerifyPKCE(p.Verifier, p.Challenge))
+
+	other, _ := NewPKCE()
+	assert.NotEqual(p.Verifier, other.Verifier)
+	assert.False(VerifyPKCE(other.Verifier, p.Challenge))
+}
+
+func TestPKCEChallenge(t *testing.T) {
+	// example from RFC 7636 appendix B
+	assert.Equal(t, "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM", PKCEChallenge("dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk"))
+}
+
+func TestValidatePKCEVerifier(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Error(ValidatePKCEVerifier("short"))
+	assert.Error(ValidatePKCEVerifier(strings.Repeat("a", 129)))
+	assert.Error(ValidatePKCEVerifier(strings.Repeat("a", 42) + "+"))
+	assert.NoError(ValidatePKCEVerifier(strings.Repeat("a", 40) + "-._~"))
+}
+
+func TestAuthorizationURL(t *testing.T) {
+	assert := assert.New(t)
+
+	p, _ := NewPKCE()
+	authURL, err := AuthorizationURL(AuthorizationRequest{
+		Endpoint:    "https://iam.cloud.ibm.com" + DefaultIAMAuthorizePath,
+		ClientID:    "my-client",
+		RedirectURI: "http://localhost:8080/callback",
+		State:       "xyz",
+		Scopes:      []string{"openid", "profile"},
+		PKCE:        p,
+	})
+	assert.NoError(err)
+
+	u, err := url.Parse(authURL)
+	assert.NoError(err)
+	assert.Equal("iam.cloud.ibm.com", u.Host)
+	assert.Equal("/identity/authorize", u.Path)
+
+	q := u.Query()
+	assert.Equal("code", q.Get("response_type"))
+	assert.Equal("my-client", q.Get("client_id"))
+	assert.Equal("http://localhost:8080/callback", q.Get("redirect_uri"))
+	assert.Equal("xyz", q.Get("state"))
+	assert.Equal("openid profile", q.Get("scope"))
+	assert.Equal(p.Challenge, q.Get("code_challenge"))
+	assert.Equal("S256", q.Get("code_challenge_method"))
+	assert.Empty(q.Get("code_verifier"))
+
+	_, err = AuthorizationURL(AuthorizationRequest{Endpoint: "not a url", PKCE: p})
+	assert.Error(err)
+}
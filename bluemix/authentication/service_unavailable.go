@@ -0,0 +1,78 @@
+package authentication
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	. "github.com/IBM-Cloud/ibm-cloud-cli-sdk/i18n"
+)
+
+// ServiceUnavailableError means the server responds 503 Service Unavailable,
+// e.g. IAM is under maintenance. The request should be retried later; it
+// does not mean the credentials are invalid.
+type ServiceUnavailableError struct {
+	RetryAfter  time.Duration // delay before retrying from Retry-After header, 0 if not given
+	Description string        // error message of the server, if any
+	Err         error         // the error parsed from the response
+}
+
+func (e *ServiceUnavailableError) Error() string {
+	msg := T("The service is temporarily unavailable.")
+	if e.Description != "" {
+		msg += " " + strings.TrimSuffix(e.Description, ".") + "."
+	}
+	if e.RetryAfter > 0 {
+		return msg + " " + T("Try again in {{.RetryAfter}}.", map[string]interface{}{"RetryAfter": e.RetryAfter.String()})
+	}
+	return msg + " " + T("Try again later.")
+}
+
+func (e *ServiceUnavailableError) Unwrap() error {
+	return e.Err
+}
+
+// checkServiceUnavailable returns a ServiceUnavailableError wrapping err if
+// server responds 503. Otherwise it returns err.
+func checkServiceUnavailable(resp *http.Response, err error) error {
+	if err == nil || resp == nil || resp.StatusCode != http.StatusServiceUnavailable {
+		return err
+	}
+
+	var description string
+	var serverErr *ServerError
+	if errors.As(err, &serverErr) {
+		description = serverErr.Description
+	}
+
+	return &ServiceUnavailableError{
+		RetryAfter:  parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()),
+		Description: description,
+		Err:         err,
+	}
+}
+
+// parseRetryAfter parses the Retry-After header, which is either a number of
+// seconds or an HTTP date. It returns 0 if the header is absent, invalid or
+// in the past.
+func parseRetryAfter(header string, now time.Time) time.Duration {
+	header = strings.TrimSpace(header)
+	if header == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(header); err == nil {
+		if seconds < 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+
+	date, err := http.ParseTime(header)
+	if err != nil || !date.After(now) {
+		return 0
+	}
+	return date.Sub(now).Truncate(time.Second)
+}
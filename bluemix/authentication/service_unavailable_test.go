@@ -0,0 +1,64 @@
+package authentication
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/common/rest"
+)
+
+func TestParseRetryAfter(t *testing.T) {
+	assert := assert.New(t)
+
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	assert.Equal(time.Duration(0), parseRetryAfter("", now))
+	assert.Equal(120*time.Second, parseRetryAfter("120", now))
+	assert.Equal(time.Duration(0), parseRetryAfter("-1", now))
+	assert.Equal(time.Duration(0), parseRetryAfter("soon", now))
+	assert.Equal(5*time.Minute, parseRetryAfter(now.Add(5*time.Minute).Format(http.TimeFormat), now))
+	assert.Equal(time.Duration(0), parseRetryAfter(now.Add(-time.Minute).Format(http.TimeFormat), now))
+}
+
+func TestRefreshToken_ServiceUnavailable(t *testing.T) {
+	assert := assert.New(t)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "300")
+		w.WriteHeader(http.StatusServiceUnavailable)
+		fmt.Fprint(w, `{"errorCode": "BXNIM0513E", "errorMessage": "IAM is under maintenance"}`)
+	}))
+	defer ts.Close()
+
+	auth := NewIAMAuthRepository(&IAMConfig{Endpoint: ts.URL}, rest.NewClient())
+	_, err := auth.RefreshToken("refresh-token")
+	if assert.IsType(&ServiceUnavailableError{}, err) {
+		e := err.(*ServiceUnavailableError)
+		assert.Equal(5*time.Minute, e.RetryAfter)
+		assert.Equal("IAM is under maintenance", e.Description)
+		assert.IsType(&ServerError{}, e.Err)
+		assert.Equal("The service is temporarily unavailable. IAM is under maintenance. Try again in 5m0s.", e.Error())
+	}
+}
+
+func TestRefreshToken_ServiceUnavailableWithoutBody(t *testing.T) {
+	assert := assert.New(t)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer ts.Close()
+
+	auth := NewIAMAuthRepository(&IAMConfig{Endpoint: ts.URL}, rest.NewClient())
+	_, err := auth.RefreshToken("refresh-token")
+	if assert.IsType(&ServiceUnavailableError{}, err) {
+		e := err.(*ServiceUnavailableError)
+		assert.Equal(time.Duration(0), e.RetryAfter)
+		assert.IsType(&rest.ErrorResponse{}, e.Err)
+		assert.Equal("The service is temporarily unavailable. Try again later.", e.Error())
+	}
+}
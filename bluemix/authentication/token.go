@@ -3,12 +3,35 @@ package authentication
 import (
 	"fmt"
 	"strings"
+	"time"
 )
 
 type Token struct {
 	AccessToken  string
 	RefreshToken string
 	TokenType    string
+
+	Expiration             int64 // expiry of the access token in seconds since epoch, 0 if unknown
+	RefreshTokenExpiration int64 // expiry of the refresh token in seconds since epoch, 0 if unknown
+}
+
+// ExpiresAt returns the expiry time of the access token, or zero time if
+// unknown.
+func (t Token) ExpiresAt() time.Time {
+	return unixTime(t.Expiration)
+}
+
+// RefreshTokenExpiresAt returns the expiry time of the refresh token, after
+// which the user has to log in again, or zero time if unknown.
+func (t Token) RefreshTokenExpiresAt() time.Time {
+	return unixTime(t.RefreshTokenExpiration)
+}
+
+func unixTime(sec int64) time.Time {
+	if sec == 0 {
+		return time.Time{}
+	}
+	return time.Unix(sec, 0)
 }
 
 func (t Token) Token() string {
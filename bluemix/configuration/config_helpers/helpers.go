@@ -27,6 +27,12 @@ func ConfigFilePath() string {
 	return filepath.Join(ConfigDir(), "config.json")
 }
 
+// EndpointsFilePath returns the path of the service endpoints catalog
+// downloaded by the CLI.
+func EndpointsFilePath() string {
+	return filepath.Join(ConfigDir(), "endpoints.json")
+}
+
 func PluginRepoDir() string {
 	return filepath.Join(ConfigDir(), "plugins")
 }
@@ -7,6 +7,7 @@ import (
 
 	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/bluemix"
 	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/bluemix/configuration"
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/bluemix/keyring"
 	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/bluemix/models"
 	"github.com/fatih/structs"
 )
@@ -27,11 +28,14 @@ type BXConfigData struct {
 	Region                  string
 	RegionID                string
 	RegionType              string
+	DefaultRegion           models.Region
 	IAMEndpoint             string
 	IAMToken                string
 	IAMRefreshToken         string
+	IAMRefreshTokenExpiry   int64
 	Account                 models.Account
 	ResourceGroup           models.ResourceGroup
+	DefaultResourceGroup    models.ResourceGroup
 	PluginRepos             []models.PluginRepo
 	SSLDisabled             bool
 	Locale                  string
@@ -97,6 +101,47 @@ func (c *bxConfig) init() {
 	})
 }
 
+// reloadLocked re-reads the config from disk, discarding the data in memory.
+// The lock must be held.
+func (c *bxConfig) reloadLocked() error {
+	data, err := c.loadData()
+	if err != nil {
+		return err
+	}
+	c.setDataLocked(data)
+	return nil
+}
+
+// loadData reads the config from disk without replacing the data in memory.
+// It returns nil if the config file doesn't exist.
+func (c *bxConfig) loadData() (*BXConfigData, error) {
+	if !c.persistor.Exists() {
+		return nil, nil
+	}
+
+	data := NewBXConfigData()
+	if err := c.persistor.Load(data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// setData replaces the data in memory with the data returned by loadData.
+func (c *bxConfig) setData(data *BXConfigData) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.setDataLocked(data)
+}
+
+func (c *bxConfig) setDataLocked(data *BXConfigData) {
+	if data == nil {
+		return
+	}
+	c.data = data
+	c.initOnce.Do(func() {})
+}
+
 func (c *bxConfig) read(cb func()) {
 	c.lock.RLock()
 	defer c.lock.RUnlock()
@@ -110,6 +155,13 @@ func (c *bxConfig) write(cb func()) {
 	c.lock.Lock()
 	defer c.lock.Unlock()
 
+	unlock, err := c.lockPersistor()
+	if err != nil {
+		c.onError(err)
+		return
+	}
+	defer unlock()
+
 	c.init()
 
 	cb()
@@ -117,7 +169,7 @@ func (c *bxConfig) write(cb func()) {
 	c.data.SDKVersion = bluemix.Version.String()
 	c.data.raw = structs.Map(c.data)
 
-	err := c.persistor.Save(c.data)
+	err = c.persistor.Save(c.data)
 	if err != nil {
 		c.onError(err)
 	}
@@ -127,16 +179,44 @@ func (c *bxConfig) writeRaw(cb func()) {
 	c.lock.Lock()
 	defer c.lock.Unlock()
 
+	unlock, err := c.lockPersistor()
+	if err != nil {
+		c.onError(err)
+		return
+	}
+	defer unlock()
+
 	c.init()
 
 	cb()
 
-	err := c.persistor.Save(c.data.raw)
+	err = c.persistor.Save(c.data.raw)
 	if err != nil {
 		c.onError(err)
 	}
 }
 
+// lockPersistor takes the file lock of the persistor if it supports locking,
+// and reloads the config from disk, so that the changes made by other
+// processes since the config was loaded are not lost by the write. The
+// returned function releases the lock. The lock of c must be held.
+func (c *bxConfig) lockPersistor() (unlock func(), err error) {
+	locker, ok := c.persistor.(configuration.Locker)
+	if !ok {
+		return func() {}, nil
+	}
+
+	unlock, err = locker.Lock()
+	if err != nil {
+		return nil, err
+	}
+	if err := c.reloadLocked(); err != nil {
+		unlock()
+		return nil, err
+	}
+	return unlock, nil
+}
+
 func (c *bxConfig) APIEndpoint() (endpoint string) {
 	c.read(func() {
 		endpoint = c.data.APIEndpoint
@@ -173,6 +253,13 @@ func (c *bxConfig) CurrentRegion() (region models.Region) {
 	return
 }
 
+func (c *bxConfig) DefaultRegion() (region models.Region) {
+	c.read(func() {
+		region = c.data.DefaultRegion
+	})
+	return
+}
+
 func (c *bxConfig) CloudName() string {
 	regionID := c.CurrentRegion().ID
 	if regionID == "" {
@@ -218,6 +305,13 @@ func (c *bxConfig) IAMToken() (token string) {
 	return
 }
 
+func (c *bxConfig) IAMRefreshTokenExpiry() (expiry int64) {
+	c.read(func() {
+		expiry = c.data.IAMRefreshTokenExpiry
+	})
+	return
+}
+
 func (c *bxConfig) IAMRefreshToken() (token string) {
 	c.read(func() {
 		token = c.data.IAMRefreshToken
@@ -261,6 +355,39 @@ func (c *bxConfig) IMSAccountID() string {
 	return NewIAMTokenInfo(c.IAMToken()).Accounts.IMSAccountID
 }
 
+// IMSKeyringService is the service under which the CLI stores the classic
+// infrastructure (IMS) credentials in the OS keyring. The user of the
+// username and API key entries is the IMS account ID followed by
+// IMSKeyringUsername and IMSKeyringAPIKey respectively, e.g.
+// "123456/username". The credentials are never stored in the config file.
+const (
+	IMSKeyringService  = "ibmcloud-cli-ims"
+	IMSKeyringUsername = "/username"
+	IMSKeyringAPIKey   = "/apikey"
+)
+
+func (c *bxConfig) IMSUsername() string {
+	return c.imsCredential(IMSKeyringUsername)
+}
+
+func (c *bxConfig) IMSAPIKey() string {
+	return c.imsCredential(IMSKeyringAPIKey)
+}
+
+// imsCredential returns the IMS credential of the linked IMS account from the
+// keyring, or empty string if not available.
+func (c *bxConfig) imsCredential(suffix string) string {
+	accountID := c.IMSAccountID()
+	if accountID == "" {
+		return ""
+	}
+	secret, err := keyring.Get(IMSKeyringService, accountID+suffix)
+	if err != nil {
+		return ""
+	}
+	return secret
+}
+
 func (c *bxConfig) CurrentResourceGroup() (group models.ResourceGroup) {
 	c.read(func() {
 		group = c.data.ResourceGroup
@@ -268,6 +395,13 @@ func (c *bxConfig) CurrentResourceGroup() (group models.ResourceGroup) {
 	return
 }
 
+func (c *bxConfig) DefaultResourceGroup() (group models.ResourceGroup) {
+	c.read(func() {
+		group = c.data.DefaultResourceGroup
+	})
+	return
+}
+
 func (c *bxConfig) HasTargetedResourceGroup() (hasGroup bool) {
 	c.read(func() {
 		hasGroup = c.data.ResourceGroup.GUID != "" && c.data.ResourceGroup.Name != ""
@@ -368,6 +502,12 @@ func (c *bxConfig) SetRegion(region models.Region) {
 	})
 }
 
+func (c *bxConfig) SetDefaultRegion(region models.Region) {
+	c.write(func() {
+		c.data.DefaultRegion = region
+	})
+}
+
 func (c *bxConfig) SetIAMEndpoint(endpoint string) {
 	c.write(func() {
 		c.data.IAMEndpoint = endpoint
@@ -382,9 +522,15 @@ func (c *bxConfig) SetIAMToken(token string) {
 }
 
 func (c *bxConfig) SetIAMRefreshToken(token string) {
+	c.SetIAMRefreshTokenWithExpiry(token, 0)
+}
+
+func (c *bxConfig) SetIAMRefreshTokenWithExpiry(token string, expiry int64) {
 	c.writeRaw(func() {
 		c.data.IAMRefreshToken = token
+		c.data.IAMRefreshTokenExpiry = expiry
 		c.data.raw["IAMRefreshToken"] = token
+		c.data.raw["IAMRefreshTokenExpiry"] = expiry
 	})
 }
 
@@ -400,6 +546,12 @@ func (c *bxConfig) SetResourceGroup(group models.ResourceGroup) {
 	})
 }
 
+func (c *bxConfig) SetDefaultResourceGroup(group models.ResourceGroup) {
+	c.write(func() {
+		c.data.DefaultResourceGroup = group
+	})
+}
+
 func (c *bxConfig) SetPluginRepo(pluginRepo models.PluginRepo) {
 	c.write(func() {
 		c.data.PluginRepos = append(c.data.PluginRepos, pluginRepo)
@@ -472,7 +624,9 @@ func (c *bxConfig) ClearSession() {
 	c.write(func() {
 		c.data.IAMToken = ""
 		c.data.IAMRefreshToken = ""
+		c.data.IAMRefreshTokenExpiry = 0
 		c.data.Account = models.Account{}
+		c.data.DefaultRegion = models.Region{}
 		c.data.ResourceGroup = models.ResourceGroup{}
 	})
 }
@@ -0,0 +1,178 @@
+package core_config
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/bluemix/keyring"
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/bluemix/models"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeKeyring map[string]string
+
+func (k fakeKeyring) Get(service string, user string) (string, error) {
+	secret, ok := k[service+":"+user]
+	if !ok {
+		return "", keyring.ErrNotFound
+	}
+	return secret, nil
+}
+
+func (k fakeKeyring) Set(service string, user string, secret string) error {
+	k[service+":"+user] = secret
+	return nil
+}
+
+func (k fakeKeyring) Delete(service string, user string) error {
+	delete(k, service+":"+user)
+	return nil
+}
+
+func newTestConfig(t *testing.T) (ReadWriter, string) {
+	dir, err := ioutil.TempDir("", "core_config")
+	assert.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(dir) })
+
+	bxConfigPath := filepath.Join(dir, "config.json")
+	return NewCoreConfigFromPath(filepath.Join(dir, "cf_config.json"), bxConfigPath, func(err error) {
+		t.Fatal(err)
+	}), bxConfigPath
+}
+
+func TestIMSCredentials(t *testing.T) {
+	assert := assert.New(t)
+
+	orig := keyring.Default
+	defer func() { keyring.Default = orig }()
+	keyring.Default = fakeKeyring{
+		IMSKeyringService + ":123456" + IMSKeyringUsername: "ims-user",
+		IMSKeyringService + ":123456" + IMSKeyringAPIKey:   "ims-apikey",
+	}
+
+	config, bxConfigPath := newTestConfig(t)
+	assert.Empty(config.IMSUsername())
+	assert.Empty(config.IMSAPIKey())
+
+	claims := base64.RawURLEncoding.EncodeToString([]byte(`{"account":{"bss":"abc","ims":"123456"}}`))
+	config.SetIAMToken("Bearer header." + claims + ".signature")
+	assert.Equal("ims-user", config.IMSUsername())
+	assert.Equal("ims-apikey", config.IMSAPIKey())
+
+	raw, err := ioutil.ReadFile(bxConfigPath)
+	assert.NoError(err)
+	assert.NotContains(string(raw), "ims-apikey")
+
+	keyring.Default = fakeKeyring{}
+	assert.Empty(config.IMSAPIKey())
+}
+
+func TestSetDefaultResourceGroup(t *testing.T) {
+	assert := assert.New(t)
+
+	config, path := newTestConfig(t)
+	config.SetRegion(models.Region{ID: "ibm:yp:us-south", Name: "us-south"})
+
+	// another process changes the config in between
+	other := NewCoreConfigFromPath(filepath.Join(filepath.Dir(path), "cf_config.json"), path, func(err error) {
+		t.Fatal(err)
+	})
+	other.SetAccount(models.Account{GUID: "account-id"})
+
+	config.SetDefaultResourceGroup(models.ResourceGroup{GUID: "rg1", Name: "default"})
+	assert.Equal("account-id", config.CurrentAccount().GUID)
+	_, err := os.Stat(path + ".lock")
+	assert.True(os.IsNotExist(err))
+
+	assert.NoError(other.Reload())
+	assert.Equal("rg1", other.DefaultResourceGroup().GUID)
+	assert.Equal("us-south", other.CurrentRegion().Name)
+
+	config.ClearSession()
+	assert.Empty(config.CurrentAccount().GUID)
+	assert.Equal("rg1", config.DefaultResourceGroup().GUID)
+}
+
+func TestWrite_ChangesOfOtherProcesses(t *testing.T) {
+	assert := assert.New(t)
+
+	config, path := newTestConfig(t)
+	config.SetRegion(models.Region{ID: "ibm:yp:us-south", Name: "us-south"})
+
+	other := NewCoreConfigFromPath(filepath.Join(filepath.Dir(path), "cf_config.json"), path, func(err error) {
+		t.Fatal(err)
+	})
+	other.SetAccount(models.Account{GUID: "account-id"})
+
+	// both a struct write and a raw write keep the change of the other process
+	config.SetIAMToken("token")
+	assert.Equal("account-id", config.CurrentAccount().GUID)
+
+	other.SetAccount(models.Account{GUID: "other-account-id"})
+	config.SetAPIEndpoint("https://cloud.ibm.com")
+	assert.Equal("other-account-id", config.CurrentAccount().GUID)
+
+	assert.NoError(other.Reload())
+	assert.Equal("token", other.IAMToken())
+	assert.Equal("https://cloud.ibm.com", other.APIEndpoint())
+	assert.Equal("us-south", other.CurrentRegion().Name)
+}
+
+// editConfigFile sets the top-level key of the JSON config file as another
+// process would
+func editConfigFile(t *testing.T, path string, key string, value interface{}) {
+	bytes, err := ioutil.ReadFile(path)
+	assert.NoError(t, err)
+
+	data := make(map[string]interface{})
+	assert.NoError(t, json.Unmarshal(bytes, &data))
+	data[key] = value
+
+	bytes, err = json.Marshal(data)
+	assert.NoError(t, err)
+	assert.NoError(t, ioutil.WriteFile(path, bytes, 0600))
+}
+
+func TestReload(t *testing.T) {
+	assert := assert.New(t)
+
+	config, path := newTestConfig(t)
+	config.SetIAMEndpoint("https://iam.cloud.ibm.com")
+	config.SetRegion(models.Region{ID: "ibm:yp:us-south", Name: "us-south"})
+	config.CFConfig().SetAPIEndpoint("https://api.us-south.cf.cloud.ibm.com")
+
+	editConfigFile(t, path, "Region", "eu-de")
+	editConfigFile(t, path, "Account", map[string]string{"GUID": "account-id"})
+	editConfigFile(t, filepath.Join(filepath.Dir(path), "cf_config.json"), "Target", "https://api.eu-de.cf.cloud.ibm.com")
+
+	assert.Equal("us-south", config.CurrentRegion().Name)
+
+	assert.NoError(config.Reload())
+	assert.Equal("eu-de", config.CurrentRegion().Name)
+	assert.Equal("account-id", config.CurrentAccount().GUID)
+	assert.Equal("https://api.eu-de.cf.cloud.ibm.com", config.CFConfig().APIEndpoint())
+	assert.Equal("https://iam.cloud.ibm.com", config.IAMEndpoint())
+}
+
+func TestReload_Error(t *testing.T) {
+	assert := assert.New(t)
+
+	config, path := newTestConfig(t)
+	config.SetRegion(models.Region{ID: "ibm:yp:us-south", Name: "us-south"})
+	config.CFConfig().SetAPIEndpoint("https://api.us-south.cf.cloud.ibm.com")
+
+	editConfigFile(t, path, "Region", "eu-de")
+	// the CF config can't be read nor rewritten
+	cfPath := filepath.Join(filepath.Dir(path), "cf_config.json")
+	assert.NoError(os.Remove(cfPath))
+	assert.NoError(os.Mkdir(cfPath, 0700))
+
+	// neither config is replaced
+	assert.Error(config.Reload())
+	assert.Equal("us-south", config.CurrentRegion().Name)
+	assert.Equal("https://api.us-south.cf.cloud.ibm.com", config.CFConfig().APIEndpoint())
+}
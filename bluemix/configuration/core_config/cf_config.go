@@ -108,6 +108,34 @@ func (c *cfConfig) init() {
 	})
 }
 
+// reload re-reads the config from disk, discarding the data in memory.
+// loadData reads the config from disk without replacing the data in memory.
+// It returns nil if the config file doesn't exist.
+func (c *cfConfig) loadData() (*CFConfigData, error) {
+	if !c.persistor.Exists() {
+		return nil, nil
+	}
+
+	data := NewCFConfigData()
+	if err := c.persistor.Load(data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// setData replaces the data in memory with the data returned by loadData.
+func (c *cfConfig) setData(data *CFConfigData) {
+	if data == nil {
+		return
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.data = data
+	c.initOnce.Do(func() {})
+}
+
 func (c *cfConfig) read(cb func()) {
 	c.lock.RLock()
 	defer c.lock.RUnlock()
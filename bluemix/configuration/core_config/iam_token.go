@@ -4,6 +4,7 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"strings"
+	"time"
 )
 
 type IAMTokenInfo struct {
@@ -12,6 +13,16 @@ type IAMTokenInfo struct {
 	Accounts    AccountsInfo `json:"account"`
 	Subject     string       `json:"sub"`
 	SubjectType string       `json:"sub_type"`
+	IssuedAt    int64        `json:"iat"` // seconds since epoch
+	Expiry      int64        `json:"exp"` // seconds since epoch
+}
+
+// ExpiresAt returns the expiry time of the token, or zero time if unknown.
+func (info IAMTokenInfo) ExpiresAt() time.Time {
+	if info.Expiry == 0 {
+		return time.Time{}
+	}
+	return time.Unix(info.Expiry, 0)
 }
 
 type AccountsInfo struct {
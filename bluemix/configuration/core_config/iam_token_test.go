@@ -25,6 +25,8 @@ func TestNewIAMTokenInfo(t *testing.T) {
 		assert.Equal(t, tokenInfo.UserEmail, "rtsysusr@cn.ibm.com")
 		assert.Equal(t, tokenInfo.IAMID, "IBMid-270006V8HM")
 		assert.Equal(t, tokenInfo.Accounts.AccountID, "8d63fb1cc5e99e86dd7229dddffc05a5")
+		assert.Equal(t, tokenInfo.Expiry-tokenInfo.IssuedAt, int64(3600))
+		assert.Equal(t, tokenInfo.ExpiresAt().Unix(), tokenInfo.Expiry)
 	}
 }
 
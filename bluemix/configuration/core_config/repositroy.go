@@ -16,15 +16,22 @@ type Repository interface {
 	CloudName() string
 	CloudType() string
 	CurrentRegion() models.Region
+	DefaultRegion() models.Region
 	IAMToken() string
 	IAMRefreshToken() string
+	// IAMRefreshTokenExpiry returns the expiry of the IAM refresh token in
+	// seconds since epoch, 0 if unknown.
+	IAMRefreshTokenExpiry() int64
 	IsLoggedIn() bool
 	UserEmail() string
 	IAMID() string
 	CurrentAccount() models.Account
 	HasTargetedAccount() bool
 	IMSAccountID() string
+	IMSUsername() string
+	IMSAPIKey() string
 	CurrentResourceGroup() models.ResourceGroup
+	DefaultResourceGroup() models.ResourceGroup
 	HasTargetedResourceGroup() bool
 	PluginRepos() []models.PluginRepo
 	PluginRepo(string) (models.PluginRepo, bool)
@@ -43,11 +50,17 @@ type Repository interface {
 	SetConsoleEndpoint(string)
 	SetIAMEndpoint(string)
 	SetRegion(models.Region)
+	SetDefaultRegion(models.Region)
 	SetIAMToken(string)
+	// SetIAMRefreshToken sets the IAM refresh token, whose expiry is unknown.
 	SetIAMRefreshToken(string)
+	// SetIAMRefreshTokenWithExpiry sets the IAM refresh token and its expiry
+	// in seconds since epoch.
+	SetIAMRefreshTokenWithExpiry(token string, expiry int64)
 	ClearSession()
 	SetAccount(models.Account)
 	SetResourceGroup(models.ResourceGroup)
+	SetDefaultResourceGroup(models.ResourceGroup)
 	SetCheckCLIVersionDisabled(bool)
 	SetCLIInfoEndpoint(string)
 	SetPluginRepo(models.PluginRepo)
@@ -61,6 +74,12 @@ type Repository interface {
 
 	CFConfig() CFConfig
 	HasTargetedCF() bool
+
+	// Reload re-reads the configuration from disk, e.g. to pick up changes
+	// made by another process. Both the Bluemix and the CloudFoundry configs
+	// are read before either is replaced, so the configuration in memory is
+	// left unchanged if either fails to load.
+	Reload() error
 }
 
 // Deprecated
@@ -152,6 +171,21 @@ func (c repository) ClearSession() {
 	c.cfConfig.ClearSession()
 }
 
+func (c repository) Reload() error {
+	bxData, err := c.bxConfig.loadData()
+	if err != nil {
+		return err
+	}
+	cfData, err := c.cfConfig.loadData()
+	if err != nil {
+		return err
+	}
+
+	c.bxConfig.setData(bxData)
+	c.cfConfig.setData(cfData)
+	return nil
+}
+
 func NewCoreConfig(errHandler func(error)) ReadWriter {
 	return NewCoreConfigFromPath(config_helpers.CFConfigFilePath(), config_helpers.ConfigFilePath(), errHandler)
 }
@@ -0,0 +1,95 @@
+package configuration
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	lockRetryInterval = 10 * time.Millisecond
+	lockTimeout       = 10 * time.Second
+
+	// lockStaleAge is the age after which a lock file is considered left
+	// over by a crashed process and removed
+	lockStaleAge = 30 * time.Second
+)
+
+// Locker is implemented by a Persistor that can lock the persisted data
+// against concurrent updates by other processes.
+type Locker interface {
+	// Lock blocks until the lock is acquired and returns the function to
+	// release it.
+	Lock() (unlock func(), err error)
+}
+
+// Lock acquires the lock of the file by creating a lock file next to it.
+func (dp DiskPersistor) Lock() (func(), error) {
+	return lockFile(dp.filePath + ".lock")
+}
+
+// lockSeq makes the owner token and the names of renamed lock files unique
+// within the process
+var lockSeq int64
+
+func lockFile(path string) (func(), error) {
+	token := fmt.Sprintf("%d-%d-%d", os.Getpid(), time.Now().UnixNano(), atomic.AddInt64(&lockSeq, 1))
+
+	deadline := time.Now().Add(lockTimeout)
+	for {
+		f, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, filePermissions)
+		if err == nil {
+			_, err = f.WriteString(token)
+			f.Close()
+			if err != nil {
+				os.Remove(path)
+				return nil, err
+			}
+			return func() { releaseLock(path, token) }, nil
+		}
+		if !os.IsExist(err) {
+			return nil, err
+		}
+
+		if info, statErr := os.Stat(path); statErr == nil && time.Since(info.ModTime()) > lockStaleAge {
+			removeLock(path, func(renamed string) bool {
+				info, err := os.Stat(renamed)
+				return err == nil && time.Since(info.ModTime()) > lockStaleAge
+			})
+			continue
+		}
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("timed out waiting for lock file '%s'", path)
+		}
+		time.Sleep(lockRetryInterval)
+	}
+}
+
+// releaseLock removes the lock file if it is still owned by token, i.e. it
+// was not removed as stale and re-created by another process.
+func releaseLock(path string, token string) {
+	removeLock(path, func(renamed string) bool {
+		b, err := ioutil.ReadFile(renamed)
+		return err == nil && string(b) == token
+	})
+}
+
+// removeLock removes the lock file if remove returns true for it. The lock
+// file is renamed before it is checked, so that a lock file created by
+// another process in between is never removed: if the renamed file fails the
+// check, it is restored unless a new lock file exists.
+func removeLock(path string, remove func(renamed string) bool) {
+	renamed := path + "." + strconv.FormatInt(atomic.AddInt64(&lockSeq, 1), 10) + "." + strconv.Itoa(os.Getpid())
+	if err := os.Rename(path, renamed); err != nil {
+		// removed by another process
+		return
+	}
+	if !remove(renamed) {
+		// fails if a new lock file exists
+		os.Link(renamed, path)
+	}
+	os.Remove(renamed)
+}
@@ -0,0 +1,101 @@
+package configuration
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiskPersistorLock(t *testing.T) {
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "lock")
+	assert.NoError(err)
+	defer os.RemoveAll(dir)
+
+	p := NewDiskPersistor(filepath.Join(dir, "config.json"))
+
+	var wg sync.WaitGroup
+	var lock sync.Mutex
+	var holders, maxHolders int
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			unlock, err := p.Lock()
+			assert.NoError(err)
+
+			lock.Lock()
+			holders++
+			if holders > maxHolders {
+				maxHolders = holders
+			}
+			lock.Unlock()
+
+			lock.Lock()
+			holders--
+			lock.Unlock()
+			unlock()
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(1, maxHolders)
+	_, err = os.Stat(filepath.Join(dir, "config.json.lock"))
+	assert.True(os.IsNotExist(err))
+}
+
+func TestDiskPersistorLock_Stale(t *testing.T) {
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "lock")
+	assert.NoError(err)
+	defer os.RemoveAll(dir)
+
+	lockPath := filepath.Join(dir, "config.json.lock")
+	assert.NoError(ioutil.WriteFile(lockPath, []byte("crashed"), 0600))
+	old := time.Now().Add(-2 * lockStaleAge)
+	assert.NoError(os.Chtimes(lockPath, old, old))
+
+	unlock, err := NewDiskPersistor(filepath.Join(dir, "config.json")).Lock()
+	assert.NoError(err)
+	unlock()
+
+	_, err = os.Stat(lockPath)
+	assert.True(os.IsNotExist(err))
+}
+
+func TestRemoveLock_Restore(t *testing.T) {
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "lock")
+	assert.NoError(err)
+	defer os.RemoveAll(dir)
+
+	// a fresh lock of another process fails the check and is restored
+	lockPath := filepath.Join(dir, "config.json.lock")
+	assert.NoError(ioutil.WriteFile(lockPath, []byte("other"), 0600))
+	removeLock(lockPath, func(string) bool { return false })
+
+	b, err := ioutil.ReadFile(lockPath)
+	assert.NoError(err)
+	assert.Equal("other", string(b))
+
+	// the lock of another process is not released
+	releaseLock(lockPath, "mine")
+	_, err = os.Stat(lockPath)
+	assert.NoError(err)
+
+	releaseLock(lockPath, "other")
+	_, err = os.Stat(lockPath)
+	assert.True(os.IsNotExist(err))
+
+	files, err := ioutil.ReadDir(dir)
+	assert.NoError(err)
+	assert.Empty(files)
+}
@@ -4,7 +4,25 @@ const (
 	ENV_BLUEMIX_TRACE = "BLUEMIX_TRACE"
 	ENV_BLUEMIX_COLOR = "BLUEMIX_COLOR"
 
+	// https://no-color.org
+	ENV_NO_COLOR = "NO_COLOR"
+
+	ENV_IBMCLOUD_API_KEY = "IBMCLOUD_API_KEY"
+
+	ENV_BLUEMIX_SKIP_CLI_VERSION_CHECK = "BLUEMIX_SKIP_CLI_VERSION_CHECK"
+
+	// opt in to storing tokens in the OS keyring
+	ENV_IBMCLOUD_USE_KEYRING = "IBMCLOUD_USE_KEYRING"
+
 	// for internal use
 	ENV_BLUEMIX_CLI              = "BLUEMIX_CLI"
 	ENV_BLUEMIX_PLUGIN_NAMESPACE = "BLUEMIX_PLUGIN_NAMESPACE"
+	ENV_BLUEMIX_CLI_VERSION      = "BLUEMIX_CLI_VERSION"
+	ENV_BLUEMIX_COMPLETION       = "BLUEMIX_COMPLETION"
+
+	// correlation ID of the CLI invocation, propagated to HTTP requests
+	ENV_IBMCLOUD_CORRELATION_ID = "IBMCLOUD_CORRELATION_ID"
+
+	// minimal SDK version recommended for plugins, set by the CLI
+	ENV_BLUEMIX_MIN_PLUGIN_SDK_VERSION = "BLUEMIX_MIN_PLUGIN_SDK_VERSION"
 )
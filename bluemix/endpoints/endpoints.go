@@ -0,0 +1,156 @@
+// Package endpoints reads the service endpoints catalog downloaded by the CLI
+// to the config directory, so that plugins resolve the public and private
+// endpoints of services in each region the same way as the CLI instead of
+// hardcoding URLs.
+//
+// The catalog is a JSON file like:
+//
+//	{
+//	  "services": {
+//	    "resource-controller": {
+//	      "us-south": {
+//	        "public": "https://resource-controller.cloud.ibm.com",
+//	        "private": "https://private.resource-controller.cloud.ibm.com"
+//	      }
+//	    }
+//	  }
+//	}
+package endpoints
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/bluemix/configuration/config_helpers"
+	. "github.com/IBM-Cloud/ibm-cloud-cli-sdk/i18n"
+)
+
+// MaxAge is the age after which the catalog is considered stale. The CLI
+// refreshes the catalog more often than that.
+var MaxAge = 7 * 24 * time.Hour
+
+// Endpoints are the public and private endpoints of a service in a region
+type Endpoints struct {
+	Public  string `json:"public"`
+	Private string `json:"private"`
+}
+
+// Catalog is the service endpoints catalog
+type Catalog struct {
+	// Services maps service name to region to endpoints
+	Services map[string]map[string]Endpoints `json:"services"`
+}
+
+// FileNotFoundError means the catalog has not been downloaded by the CLI
+type FileNotFoundError struct {
+	Path string
+}
+
+func (e *FileNotFoundError) Error() string {
+	return T("Endpoints file '{{.Path}}' was not found", map[string]interface{}{"Path": e.Path})
+}
+
+// StaleFileError means the catalog is older than MaxAge
+type StaleFileError struct {
+	Path      string
+	UpdatedAt time.Time
+}
+
+func (e *StaleFileError) Error() string {
+	return T("Endpoints file '{{.Path}}' is out of date, it was last updated at {{.UpdatedAt}}",
+		map[string]interface{}{"Path": e.Path, "UpdatedAt": e.UpdatedAt.Format(time.RFC3339)})
+}
+
+// EndpointNotFoundError means the catalog has no endpoint of the service in
+// the region
+type EndpointNotFoundError struct {
+	Service string
+	Region  string
+	Private bool
+}
+
+func (e *EndpointNotFoundError) Error() string {
+	if e.Private {
+		return T("Private endpoint of service '{{.Service}}' in region '{{.Region}}' was not found",
+			map[string]interface{}{"Service": e.Service, "Region": e.Region})
+	}
+	return T("Public endpoint of service '{{.Service}}' in region '{{.Region}}' was not found",
+		map[string]interface{}{"Service": e.Service, "Region": e.Region})
+}
+
+// Endpoint returns the public or private endpoint of the service in the
+// region. An EndpointNotFoundError is returned if the catalog doesn't have
+// it.
+func (c *Catalog) Endpoint(service string, region string, private bool) (string, error) {
+	e := c.Services[service][region]
+
+	endpoint := e.Public
+	if private {
+		endpoint = e.Private
+	}
+	if endpoint == "" {
+		return "", &EndpointNotFoundError{Service: service, Region: region, Private: private}
+	}
+	return endpoint, nil
+}
+
+type cacheEntry struct {
+	modTime time.Time
+	catalog *Catalog
+}
+
+var (
+	cacheLock sync.Mutex
+	cache     = make(map[string]cacheEntry)
+)
+
+// Load reads the catalog at path. A FileNotFoundError is returned if the
+// file doesn't exist, a StaleFileError if it is older than MaxAge. The
+// parsed catalog is cached until the file is modified.
+func Load(path string) (*Catalog, error) {
+	info, err := os.Stat(path)
+	if os.IsNotExist(err) {
+		return nil, &FileNotFoundError{Path: path}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	modTime := info.ModTime()
+	if time.Since(modTime) > MaxAge {
+		return nil, &StaleFileError{Path: path, UpdatedAt: modTime}
+	}
+
+	cacheLock.Lock()
+	defer cacheLock.Unlock()
+
+	if entry, ok := cache[path]; ok && entry.modTime.Equal(modTime) {
+		return entry.catalog, nil
+	}
+
+	bytes, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	catalog := new(Catalog)
+	if err := json.Unmarshal(bytes, catalog); err != nil {
+		return nil, err
+	}
+
+	cache[path] = cacheEntry{modTime: modTime, catalog: catalog}
+	return catalog, nil
+}
+
+// Endpoint returns the public or private endpoint of the service in the
+// region from the catalog in the CLI's config directory.
+func Endpoint(service string, region string, private bool) (string, error) {
+	catalog, err := Load(config_helpers.EndpointsFilePath())
+	if err != nil {
+		return "", err
+	}
+	return catalog.Endpoint(service, region, private)
+}
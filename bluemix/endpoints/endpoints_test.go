@@ -0,0 +1,80 @@
+package endpoints
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testCatalog = `{
+	"services": {
+		"resource-controller": {
+			"us-south": {
+				"public": "https://resource-controller.cloud.ibm.com",
+				"private": "https://private.resource-controller.cloud.ibm.com"
+			},
+			"eu-de": {
+				"public": "https://resource-controller.cloud.ibm.com"
+			}
+		}
+	}
+}`
+
+func writeCatalog(t *testing.T, content string) string {
+	dir, err := ioutil.TempDir("", "endpoints")
+	assert.NoError(t, err)
+
+	path := filepath.Join(dir, "endpoints.json")
+	assert.NoError(t, ioutil.WriteFile(path, []byte(content), 0600))
+	return path
+}
+
+func TestLoad(t *testing.T) {
+	assert := assert.New(t)
+
+	path := writeCatalog(t, testCatalog)
+	defer os.RemoveAll(filepath.Dir(path))
+
+	catalog, err := Load(path)
+	assert.NoError(err)
+
+	endpoint, err := catalog.Endpoint("resource-controller", "us-south", false)
+	assert.NoError(err)
+	assert.Equal("https://resource-controller.cloud.ibm.com", endpoint)
+
+	endpoint, err = catalog.Endpoint("resource-controller", "us-south", true)
+	assert.NoError(err)
+	assert.Equal("https://private.resource-controller.cloud.ibm.com", endpoint)
+
+	_, err = catalog.Endpoint("resource-controller", "eu-de", true)
+	assert.IsType(&EndpointNotFoundError{}, err)
+
+	_, err = catalog.Endpoint("unknown", "us-south", false)
+	assert.IsType(&EndpointNotFoundError{}, err)
+
+	cached, err := Load(path)
+	assert.NoError(err)
+	assert.True(catalog == cached)
+}
+
+func TestLoad_NotFound(t *testing.T) {
+	_, err := Load(filepath.Join(os.TempDir(), "not-exist", "endpoints.json"))
+	assert.IsType(t, &FileNotFoundError{}, err)
+}
+
+func TestLoad_Stale(t *testing.T) {
+	assert := assert.New(t)
+
+	path := writeCatalog(t, testCatalog)
+	defer os.RemoveAll(filepath.Dir(path))
+
+	old := time.Now().Add(-MaxAge - time.Hour)
+	assert.NoError(os.Chtimes(path, old, old))
+
+	_, err := Load(path)
+	assert.IsType(&StaleFileError{}, err)
+}
@@ -51,7 +51,11 @@ func (r *TraceLoggingTransport) RoundTrip(req *http.Request) (resp *http.Respons
 }
 
 func (r *TraceLoggingTransport) dumpRequest(req *http.Request, start time.Time) {
-	shouldDisplayBody := !strings.Contains(req.Header.Get("Content-Type"), "multipart/form-data")
+	isMultipart := strings.Contains(req.Header.Get("Content-Type"), "multipart/form-data")
+	// a chunked body is streamed, e.g. from a pipe, and must not be read in
+	// memory for dumping
+	isChunked := len(req.TransferEncoding) > 0 && req.TransferEncoding[0] == "chunked"
+	shouldDisplayBody := !isMultipart && !isChunked
 
 	dumpedRequest, err := httputil.DumpRequest(req, shouldDisplayBody)
 	if err != nil {
@@ -59,13 +63,27 @@ func (r *TraceLoggingTransport) dumpRequest(req *http.Request, start time.Time)
 		return
 	}
 
+	sanitized := trace.Sanitize(string(dumpedRequest))
 	trace.Logger.Printf("\n%s [%s]\n%s\n",
 		terminal.HeaderColor(T("REQUEST:")),
 		start.Format(time.RFC3339),
-		trace.Sanitize(string(dumpedRequest)))
+		sanitized)
 
-	if !shouldDisplayBody {
+	if trace.EventSink != nil {
+		trace.EventSink.Trace(trace.Event{
+			Type:   trace.EventRequest,
+			Time:   start,
+			Method: req.Method,
+			URL:    trace.Sanitize(req.URL.String()),
+			Header: trace.SanitizeHeader(req.Header),
+			Dump:   sanitized,
+		})
+	}
+
+	if isMultipart {
 		trace.Logger.Println("[MULTIPART/FORM-DATA CONTENT HIDDEN]")
+	} else if isChunked {
+		trace.Logger.Println("[CHUNKED CONTENT HIDDEN]")
 	}
 }
 
@@ -78,10 +96,27 @@ func (r *TraceLoggingTransport) dumpResponse(res *http.Response, start time.Time
 		return
 	}
 
+	sanitized := trace.Sanitize(string(dumpedResponse))
 	trace.Logger.Printf("\n%s [%s] %s %.0fms\n%s\n",
 		terminal.HeaderColor(T("RESPONSE:")),
 		end.Format(time.RFC3339),
 		terminal.HeaderColor(T("Elapsed:")),
 		end.Sub(start).Seconds()*1000,
-		trace.Sanitize(string(dumpedResponse)))
+		sanitized)
+
+	if trace.EventSink != nil {
+		event := trace.Event{
+			Type:       trace.EventResponse,
+			Time:       end,
+			StatusCode: res.StatusCode,
+			Header:     trace.SanitizeHeader(res.Header),
+			Elapsed:    end.Sub(start),
+			Dump:       sanitized,
+		}
+		if res.Request != nil {
+			event.Method = res.Request.Method
+			event.URL = trace.Sanitize(res.Request.URL.String())
+		}
+		trace.EventSink.Trace(event)
+	}
 }
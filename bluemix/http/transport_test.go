@@ -141,3 +141,44 @@ func (suite *TransportTestSuite) TestTraceRedirect() {
 		suite.Contains(string(suite.logger.Dump()), e)
 	}
 }
+
+func (suite *TransportTestSuite) TestTraceEvents() {
+	ts := httptest.NewServer(http.HandlerFunc(helloHandler))
+	defer ts.Close()
+
+	recorder := new(trace.Recorder)
+	trace.EventSink = recorder
+	defer func() { trace.EventSink = nil }()
+
+	req, _ := http.NewRequest("GET", ts.URL, nil)
+	req.Header.Set("Authorization", "Bearer my-token")
+	_, err := suite.client.Do(req)
+	suite.NoError(err)
+
+	events := recorder.Events()
+	suite.Len(events, 2)
+
+	suite.Equal(trace.EventRequest, events[0].Type)
+	suite.Equal("GET", events[0].Method)
+	suite.Equal(ts.URL, events[0].URL)
+	suite.Equal("[PRIVATE DATA HIDDEN]", events[0].Header.Get("Authorization"))
+
+	suite.Equal(trace.EventResponse, events[1].Type)
+	suite.Equal(http.StatusOK, events[1].StatusCode)
+	suite.Contains(events[1].Dump, "Hello, Client")
+}
+
+func (suite *TransportTestSuite) TestTraceChunkedBody() {
+	ts := httptest.NewServer(http.HandlerFunc(helloHandler))
+	defer ts.Close()
+
+	req, _ := http.NewRequest("PUT", ts.URL, strings.NewReader("secret-stream"))
+	req.ContentLength = -1
+	req.TransferEncoding = []string{"chunked"}
+
+	resp, err := suite.client.Do(req)
+	suite.NoError(err)
+	suite.Equal(http.StatusOK, resp.StatusCode)
+	suite.Contains(string(suite.logger.Dump()), "[CHUNKED CONTENT HIDDEN]")
+	suite.NotContains(string(suite.logger.Dump()), "secret-stream")
+}
@@ -0,0 +1,164 @@
+// Package keyring stores secrets in the secret store of the operating
+// system: Keychain on macOS, the Secret Service (libsecret) on Linux and
+// Credential Manager on Windows.
+//
+// It uses the "security" and "secret-tool" command line tools on macOS and
+// Linux respectively and the Credential Manager API on Windows, so no cgo is
+// required. ErrUnsupported is returned if the secret store is not available,
+// e.g. if secret-tool is not installed.
+package keyring
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+// ErrNotFound means no secret is stored for the service and user
+var ErrNotFound = errors.New("secret not found in keyring")
+
+// ErrUnsupported means the OS secret store is not available
+var ErrUnsupported = errors.New("keyring is not supported on this system")
+
+// Keyring is a secret store
+type Keyring interface {
+	Get(service string, user string) (string, error)
+	Set(service string, user string, secret string) error
+	Delete(service string, user string) error
+}
+
+// Default is the keyring of the current operating system
+var Default Keyring = defaultKeyring()
+
+// Get returns the secret stored for the service and user in the default
+// keyring.
+func Get(service string, user string) (string, error) {
+	return Default.Get(service, user)
+}
+
+// Set stores the secret for the service and user in the default keyring,
+// replacing the existing one.
+func Set(service string, user string, secret string) error {
+	return Default.Set(service, user, secret)
+}
+
+// Delete removes the secret stored for the service and user from the default
+// keyring.
+func Delete(service string, user string) error {
+	return Default.Delete(service, user)
+}
+
+// run is the function to run a command, replaced in tests
+var run = func(stdin string, name string, args ...string) (string, error) {
+	cmd := exec.Command(name, args...)
+	cmd.Stdin = strings.NewReader(stdin)
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		if _, ok := err.(*exec.ExitError); ok {
+			return "", &commandError{exitErr: err, stderr: strings.TrimSpace(stderr.String())}
+		}
+		return "", ErrUnsupported
+	}
+	return stdout.String(), nil
+}
+
+type commandError struct {
+	exitErr error
+	stderr  string
+}
+
+func (e *commandError) Error() string {
+	if e.stderr == "" {
+		return e.exitErr.Error()
+	}
+	return fmt.Sprintf("%v: %s", e.exitErr, e.stderr)
+}
+
+func defaultKeyring() Keyring {
+	switch runtime.GOOS {
+	case "darwin":
+		return macOSKeychain{}
+	case "linux":
+		return secretService{}
+	case "windows":
+		return credentialManagerKeyring()
+	default:
+		return unsupported{}
+	}
+}
+
+// macOSKeychain stores generic passwords in the login keychain
+type macOSKeychain struct{}
+
+func (macOSKeychain) Get(service string, user string) (string, error) {
+	out, err := run("", "security", "find-generic-password", "-s", service, "-a", user, "-w")
+	if _, ok := err.(*commandError); ok {
+		return "", ErrNotFound
+	}
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(out, "\n"), nil
+}
+
+func (macOSKeychain) Set(service string, user string, secret string) error {
+	// -w without value as the last option reads the secret from stdin, so
+	// that it doesn't show in process list. It is written twice since
+	// security asks to retype it.
+	_, err := run(secret+"\n"+secret+"\n", "security", "add-generic-password", "-U", "-s", service, "-a", user, "-w")
+	return err
+}
+
+func (macOSKeychain) Delete(service string, user string) error {
+	_, err := run("", "security", "delete-generic-password", "-s", service, "-a", user)
+	if _, ok := err.(*commandError); ok {
+		return ErrNotFound
+	}
+	return err
+}
+
+// secretService stores secrets via the freedesktop Secret Service API
+type secretService struct{}
+
+func (secretService) Get(service string, user string) (string, error) {
+	out, err := run("", "secret-tool", "lookup", "service", service, "user", user)
+	if _, ok := err.(*commandError); ok {
+		return "", ErrNotFound
+	}
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(out, "\n"), nil
+}
+
+func (secretService) Set(service string, user string, secret string) error {
+	// the secret is passed in stdin so that it doesn't show in process list
+	_, err := run(secret, "secret-tool", "store", "--label", service+" "+user, "service", service, "user", user)
+	return err
+}
+
+func (secretService) Delete(service string, user string) error {
+	_, err := run("", "secret-tool", "clear", "service", service, "user", user)
+	return err
+}
+
+type unsupported struct{}
+
+func (unsupported) Get(service string, user string) (string, error) {
+	return "", ErrUnsupported
+}
+
+func (unsupported) Set(service string, user string, secret string) error {
+	return ErrUnsupported
+}
+
+func (unsupported) Delete(service string, user string) error {
+	return ErrUnsupported
+}
@@ -0,0 +1,8 @@
+//go:build !windows
+// +build !windows
+
+package keyring
+
+func credentialManagerKeyring() Keyring {
+	return unsupported{}
+}
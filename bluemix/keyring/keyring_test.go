@@ -0,0 +1,67 @@
+package keyring
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func fakeRun(t *testing.T, out string, err error) *[]string {
+	var calls []string
+	orig := run
+	run = func(stdin string, name string, args ...string) (string, error) {
+		calls = append(calls, stdin)
+		calls = append(calls, name)
+		calls = append(calls, args...)
+		return out, err
+	}
+	t.Cleanup(func() { run = orig })
+	return &calls
+}
+
+func TestSecretService(t *testing.T) {
+	assert := assert.New(t)
+
+	calls := fakeRun(t, "s3cret\n", nil)
+	secret, err := secretService{}.Get("ibmcloud", "token")
+	assert.NoError(err)
+	assert.Equal("s3cret", secret)
+	assert.Equal([]string{"", "secret-tool", "lookup", "service", "ibmcloud", "user", "token"}, *calls)
+
+	calls = fakeRun(t, "", nil)
+	assert.NoError(secretService{}.Set("ibmcloud", "token", "s3cret"))
+	assert.Equal("s3cret", (*calls)[0])
+	assert.NotContains((*calls)[1:], "s3cret")
+
+	fakeRun(t, "", &commandError{exitErr: errors.New("exit status 1")})
+	_, err = secretService{}.Get("ibmcloud", "token")
+	assert.Equal(ErrNotFound, err)
+
+	fakeRun(t, "", ErrUnsupported)
+	_, err = secretService{}.Get("ibmcloud", "token")
+	assert.Equal(ErrUnsupported, err)
+}
+
+func TestMacOSKeychain(t *testing.T) {
+	assert := assert.New(t)
+
+	calls := fakeRun(t, "s3cret\n", nil)
+	secret, err := macOSKeychain{}.Get("ibmcloud", "token")
+	assert.NoError(err)
+	assert.Equal("s3cret", secret)
+	assert.Equal([]string{"", "security", "find-generic-password", "-s", "ibmcloud", "-a", "token", "-w"}, *calls)
+
+	calls = fakeRun(t, "", nil)
+	assert.NoError(macOSKeychain{}.Set("ibmcloud", "token", "s3cret"))
+	assert.Equal("s3cret\ns3cret\n", (*calls)[0])
+	assert.Equal([]string{"security", "add-generic-password", "-U", "-s", "ibmcloud", "-a", "token", "-w"}, (*calls)[1:])
+
+	fakeRun(t, "", &commandError{exitErr: errors.New("exit status 44")})
+	assert.Equal(ErrNotFound, macOSKeychain{}.Delete("ibmcloud", "token"))
+}
+
+func TestUnsupported(t *testing.T) {
+	_, err := unsupported{}.Get("ibmcloud", "token")
+	assert.Equal(t, ErrUnsupported, err)
+}
@@ -0,0 +1,116 @@
+//go:build windows
+// +build windows
+
+package keyring
+
+import (
+	"syscall"
+	"unsafe"
+)
+
+var (
+	advapi32       = syscall.NewLazyDLL("advapi32.dll")
+	procCredRead   = advapi32.NewProc("CredReadW")
+	procCredWrite  = advapi32.NewProc("CredWriteW")
+	procCredDelete = advapi32.NewProc("CredDeleteW")
+	procCredFree   = advapi32.NewProc("CredFree")
+)
+
+const (
+	credTypeGeneric         = 1
+	credPersistLocalMachine = 2
+
+	errorNotFound syscall.Errno = 1168
+)
+
+// credential is the CREDENTIALW structure of the Credential Manager API
+type credential struct {
+	Flags              uint32
+	Type               uint32
+	TargetName         *uint16
+	Comment            *uint16
+	LastWritten        syscall.Filetime
+	CredentialBlobSize uint32
+	CredentialBlob     *byte
+	Persist            uint32
+	AttributeCount     uint32
+	Attributes         uintptr
+	TargetAlias        *uint16
+	UserName           *uint16
+}
+
+func credentialManagerKeyring() Keyring {
+	return credentialManager{}
+}
+
+// credentialManager stores generic credentials in the Windows Credential
+// Manager. The target name of a credential is "service:user".
+type credentialManager struct{}
+
+func (credentialManager) Get(service string, user string) (string, error) {
+	target, err := syscall.UTF16PtrFromString(service + ":" + user)
+	if err != nil {
+		return "", err
+	}
+
+	var cred *credential
+	r, _, err := procCredRead.Call(uintptr(unsafe.Pointer(target)), credTypeGeneric, 0, uintptr(unsafe.Pointer(&cred)))
+	if r == 0 {
+		return "", credentialError(err)
+	}
+	defer procCredFree.Call(uintptr(unsafe.Pointer(cred)))
+
+	if cred.CredentialBlobSize == 0 {
+		return "", nil
+	}
+	blob := (*[1 << 20]byte)(unsafe.Pointer(cred.CredentialBlob))[:cred.CredentialBlobSize:cred.CredentialBlobSize]
+	return string(blob), nil
+}
+
+func (credentialManager) Set(service string, user string, secret string) error {
+	target, err := syscall.UTF16PtrFromString(service + ":" + user)
+	if err != nil {
+		return err
+	}
+	userName, err := syscall.UTF16PtrFromString(user)
+	if err != nil {
+		return err
+	}
+
+	cred := credential{
+		Type:       credTypeGeneric,
+		TargetName: target,
+		Persist:    credPersistLocalMachine,
+		UserName:   userName,
+	}
+	if blob := []byte(secret); len(blob) > 0 {
+		cred.CredentialBlobSize = uint32(len(blob))
+		cred.CredentialBlob = &blob[0]
+	}
+
+	r, _, err := procCredWrite.Call(uintptr(unsafe.Pointer(&cred)), 0)
+	if r == 0 {
+		return credentialError(err)
+	}
+	return nil
+}
+
+func (credentialManager) Delete(service string, user string) error {
+	target, err := syscall.UTF16PtrFromString(service + ":" + user)
+	if err != nil {
+		return err
+	}
+
+	r, _, err := procCredDelete.Call(uintptr(unsafe.Pointer(target)), credTypeGeneric, 0)
+	if r == 0 {
+		return credentialError(err)
+	}
+	return nil
+}
+
+func credentialError(err error) error {
+	if err == errorNotFound {
+		return ErrNotFound
+	}
+	return err
+}
@@ -0,0 +1,43 @@
+package models
+
+type APIKey struct {
+	ID          string       `json:"id"`
+	Name        string       `json:"name"`
+	Description string       `json:"description"`
+	IAMID       string       `json:"iam_id"`
+	AccountID   string       `json:"account_id"`
+	CRN         string       `json:"crn"`
+	CreatedAt   FlexibleTime `json:"created_at"` // e.g. "2019-10-23T20:35+0000"
+
+	// Value is the API key secret, only returned when the API key is created
+	Value string `json:"apikey,omitempty"`
+}
+
+type ServiceID struct {
+	ID          string       `json:"id"`
+	Name        string       `json:"name"`
+	Description string       `json:"description"`
+	IAMID       string       `json:"iam_id"`
+	AccountID   string       `json:"account_id"`
+	CRN         string       `json:"crn"`
+	CreatedAt   FlexibleTime `json:"created_at"` // e.g. "2019-10-23T20:35+0000"
+}
+
+type AccessGroup struct {
+	ID          string       `json:"id"`
+	Name        string       `json:"name"`
+	Description string       `json:"description"`
+	AccountID   string       `json:"account_id"`
+	CreatedAt   FlexibleTime `json:"created_at"` // e.g. "2019-10-23T20:35+0000"
+}
+
+// Types of access group members
+const (
+	AccessGroupMemberUser    = "user"
+	AccessGroupMemberService = "service"
+)
+
+type AccessGroupMember struct {
+	IAMID string `json:"iam_id"`
+	Type  string `json:"type"` // AccessGroupMemberUser or AccessGroupMemberService
+}
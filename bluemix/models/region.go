@@ -1,7 +1,79 @@
 package models
 
+import (
+	"sort"
+	"strings"
+)
+
+const (
+	GeographyNorthAmerica = "North America"
+	GeographySouthAmerica = "South America"
+	GeographyEurope       = "Europe"
+	GeographyAsiaPacific  = "Asia Pacific"
+)
+
+// RegionGeographies maps the known IBM Cloud regions to their geographies.
+// It can be updated when new regions are available.
+var RegionGeographies = map[string]string{
+	"us-south": GeographyNorthAmerica,
+	"us-east":  GeographyNorthAmerica,
+	"ca-tor":   GeographyNorthAmerica,
+	"br-sao":   GeographySouthAmerica,
+	"eu-gb":    GeographyEurope,
+	"eu-de":    GeographyEurope,
+	"eu-es":    GeographyEurope,
+	"eu-fr2":   GeographyEurope,
+	"jp-tok":   GeographyAsiaPacific,
+	"jp-osa":   GeographyAsiaPacific,
+	"au-syd":   GeographyAsiaPacific,
+	"in-che":   GeographyAsiaPacific,
+}
+
+// KnownRegions returns the names of the known IBM Cloud regions in
+// alphabetical order.
+func KnownRegions() []string {
+	names := make([]string, 0, len(RegionGeographies))
+	for name := range RegionGeographies {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// RegionByName returns the public region with the given name, e.g.
+// "us-south". It returns false if the region is unknown.
+func RegionByName(name string) (Region, bool) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if _, ok := RegionGeographies[name]; !ok {
+		return Region{}, false
+	}
+	return Region{ID: "ibm:yp:" + name, Name: name, Type: "public"}, true
+}
+
 type Region struct {
 	ID   string
 	Name string
 	Type string
 }
+
+// Geography returns the geography of the region, or empty if the region is
+// unknown.
+func (r Region) Geography() string {
+	return RegionGeographies[r.shortName()]
+}
+
+// IsEU returns whether the region is located in the European Union.
+func (r Region) IsEU() bool {
+	return r.Geography() == GeographyEurope && r.shortName() != "eu-gb"
+}
+
+// shortName returns the region name, e.g. "us-south". If the name is not set,
+// it is parsed from the region ID, e.g. "ibm:yp:us-south".
+func (r Region) shortName() string {
+	name := r.Name
+	if name == "" {
+		parts := strings.Split(r.ID, ":")
+		name = parts[len(parts)-1]
+	}
+	return strings.ToLower(name)
+}
@@ -0,0 +1,44 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegionGeography(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		region    Region
+		geography string
+		isEU      bool
+	}{
+		{Region{Name: "us-south"}, GeographyNorthAmerica, false},
+		{Region{Name: "br-sao"}, GeographySouthAmerica, false},
+		{Region{Name: "eu-de"}, GeographyEurope, true},
+		{Region{Name: "eu-gb"}, GeographyEurope, false},
+		{Region{ID: "ibm:yp:eu-es"}, GeographyEurope, true},
+		{Region{Name: "jp-tok"}, GeographyAsiaPacific, false},
+		{Region{Name: "unknown"}, "", false},
+	}
+
+	for _, test := range tests {
+		assert.Equal(test.geography, test.region.Geography())
+		assert.Equal(test.isEU, test.region.IsEU())
+	}
+}
+
+func TestRegionByName(t *testing.T) {
+	assert := assert.New(t)
+
+	region, ok := RegionByName("EU-DE")
+	assert.True(ok)
+	assert.Equal(Region{ID: "ibm:yp:eu-de", Name: "eu-de", Type: "public"}, region)
+
+	_, ok = RegionByName("mars-1")
+	assert.False(ok)
+
+	assert.Contains(KnownRegions(), "us-south")
+	assert.Len(KnownRegions(), len(RegionGeographies))
+}
@@ -0,0 +1,38 @@
+package models
+
+import "time"
+
+// ServiceInstance is a resource instance managed by resource controller
+type ServiceInstance struct {
+	ID              string                 `json:"id"`
+	GUID            string                 `json:"guid"`
+	CRN             string                 `json:"crn"`
+	Name            string                 `json:"name"`
+	State           string                 `json:"state"`
+	Type            string                 `json:"type"`
+	AccountID       string                 `json:"account_id"`
+	RegionID        string                 `json:"region_id"`
+	ResourceGroupID string                 `json:"resource_group_id"`
+	ResourceID      string                 `json:"resource_id"`
+	ResourcePlanID  string                 `json:"resource_plan_id"`
+	DashboardURL    string                 `json:"dashboard_url"`
+	Parameters      map[string]interface{} `json:"parameters,omitempty"`
+	CreatedAt       time.Time              `json:"created_at"`
+	UpdatedAt       time.Time              `json:"updated_at"`
+}
+
+// ServiceKey is a resource key, i.e. credentials, of a service instance
+type ServiceKey struct {
+	ID              string                 `json:"id"`
+	GUID            string                 `json:"guid"`
+	CRN             string                 `json:"crn"`
+	Name            string                 `json:"name"`
+	State           string                 `json:"state"`
+	AccountID       string                 `json:"account_id"`
+	ResourceGroupID string                 `json:"resource_group_id"`
+	SourceCRN       string                 `json:"source_crn"` // CRN of the service instance
+	Role            string                 `json:"role"`
+	Credentials     map[string]interface{} `json:"credentials"`
+	CreatedAt       time.Time              `json:"created_at"`
+	UpdatedAt       time.Time              `json:"updated_at"`
+}
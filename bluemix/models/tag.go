@@ -0,0 +1,84 @@
+package models
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// MaxTagLength is the maximum length of a tag
+const MaxTagLength = 128
+
+// tag can contain letters, numbers, spaces, underscores, hyphens, periods
+// and colons
+var tagPattern = regexp.MustCompile(`^[A-Za-z0-9 _\-.:]+$`)
+
+// colonSpacePattern matches a colon with the whitespace around it
+var colonSpacePattern = regexp.MustCompile(`\s*:\s*`)
+
+// Tag is a global tag attached to a resource
+type Tag struct {
+	Name string `json:"name"`
+}
+
+// Key returns the key of a tag in "key:value" format, or the name if the tag
+// is not in that format.
+func (t Tag) Key() string {
+	if i := strings.Index(t.Name, ":"); i >= 0 {
+		return t.Name[:i]
+	}
+	return t.Name
+}
+
+// Value returns the value of a tag in "key:value" format, or empty string if
+// the tag is not in that format.
+func (t Tag) Value() string {
+	if i := strings.Index(t.Name, ":"); i >= 0 {
+		return t.Name[i+1:]
+	}
+	return ""
+}
+
+// ParseTags parses a list of tags separated by commas or whitespace, e.g.
+// "env:prod, team:db project". Whitespace around the colon of "key:value"
+// tags is removed. Empty and duplicate tags are dropped; tags are compared
+// case-insensitively and the first occurrence is kept.
+func ParseTags(s string) []string {
+	var tags []string
+	seen := make(map[string]bool)
+
+	s = colonSpacePattern.ReplaceAllString(s, ":")
+	fields := strings.FieldsFunc(s, func(r rune) bool {
+		return r == ',' || unicode.IsSpace(r)
+	})
+	for _, t := range fields {
+		if seen[strings.ToLower(t)] {
+			continue
+		}
+		seen[strings.ToLower(t)] = true
+		tags = append(tags, t)
+	}
+	return tags
+}
+
+// FormatTags returns the tags as a comma separated list
+func FormatTags(tags []string) string {
+	return strings.Join(tags, ",")
+}
+
+// ValidateTag returns an error if the tag is empty, longer than MaxTagLength
+// or contains characters other than letters, numbers, spaces, underscores,
+// hyphens, periods and colons.
+func ValidateTag(tag string) error {
+	if tag == "" {
+		return fmt.Errorf("tag can not be empty")
+	}
+	if len(tag) > MaxTagLength {
+		return fmt.Errorf("tag '%s' exceeds the maximum length of %d characters", tag, MaxTagLength)
+	}
+	if !tagPattern.MatchString(tag) {
+		return fmt.Errorf("tag '%s' contains invalid characters, only letters, numbers, spaces, '_', '-', '.' and ':' are allowed", tag)
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+package models
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseTags(t *testing.T) {
+	assert := assert.New(t)
+
+	cases := map[string][]string{
+		"":                           nil,
+		" , ,":                       nil,
+		"dev":                        {"dev"},
+		"dev,prod":                   {"dev", "prod"},
+		"dev prod":                   {"dev", "prod"},
+		" dev,\tprod \n test ":       {"dev", "prod", "test"},
+		"env:prod, team : db":        {"env:prod", "team:db"},
+		"Dev,dev, DEV,prod":          {"Dev", "prod"},
+		"env:prod,,env:Prod project": {"env:prod", "project"},
+	}
+	for s, expected := range cases {
+		assert.Equal(expected, ParseTags(s), s)
+	}
+}
+
+func TestFormatTags(t *testing.T) {
+	assert.Equal(t, "env:prod,team:db", FormatTags([]string{"env:prod", "team:db"}))
+	assert.Equal(t, "", FormatTags(nil))
+}
+
+func TestTagKeyValue(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal("env", Tag{Name: "env:prod"}.Key())
+	assert.Equal("prod", Tag{Name: "env:prod"}.Value())
+	assert.Equal("dev", Tag{Name: "dev"}.Key())
+	assert.Equal("", Tag{Name: "dev"}.Value())
+}
+
+func TestValidateTag(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.NoError(ValidateTag("env:prod"))
+	assert.NoError(ValidateTag("my_tag-1.0"))
+	assert.Error(ValidateTag(""))
+	assert.Error(ValidateTag("bad/tag"))
+
+	assert.NoError(ValidateTag(strings.Repeat("a", MaxTagLength)))
+	assert.Error(ValidateTag(strings.Repeat("a", MaxTagLength+1)))
+}
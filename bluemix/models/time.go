@@ -0,0 +1,73 @@
+package models
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// epochMillisThreshold is the smallest numeric timestamp treated as
+// milliseconds since epoch. Smaller values are treated as seconds.
+const epochMillisThreshold = 1e11
+
+// flexibleTimeLayouts are the layouts of time strings accepted by
+// FlexibleTime, e.g. IAM returns "2019-10-23T20:35+0000".
+var flexibleTimeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.999999999Z0700",
+	"2006-01-02T15:04Z07:00",
+	"2006-01-02T15:04Z0700",
+}
+
+// FlexibleTime is a time.Time that can be decoded from a JSON string in
+// RFC3339 format, with or without seconds and with or without a colon in the
+// zone offset, or a JSON number of seconds or milliseconds since epoch. JSON
+// null decodes to the zero time.
+type FlexibleTime struct {
+	time.Time
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (t *FlexibleTime) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if bytes.Equal(data, []byte("null")) {
+		t.Time = time.Time{}
+		return nil
+	}
+
+	if len(data) > 0 && data[0] == '"' {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		for _, layout := range flexibleTimeLayouts {
+			if parsed, err := time.Parse(layout, s); err == nil {
+				t.Time = parsed
+				return nil
+			}
+		}
+		return fmt.Errorf("invalid time %q", s)
+	}
+
+	n, err := strconv.ParseFloat(string(data), 64)
+	if err != nil {
+		return fmt.Errorf("invalid time %s", data)
+	}
+	if n >= epochMillisThreshold || n <= -epochMillisThreshold {
+		t.Time = time.Unix(0, int64(n)*int64(time.Millisecond))
+	} else {
+		t.Time = time.Unix(0, int64(n*float64(time.Second)))
+	}
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler. The time is encoded in RFC3339
+// format, or null if it is the zero time.
+func (t FlexibleTime) MarshalJSON() ([]byte, error) {
+	if t.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(t.Time.Format(time.RFC3339Nano))
+}
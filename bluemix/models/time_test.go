@@ -0,0 +1,60 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlexibleTimeUnmarshalJSON(t *testing.T) {
+	assert := assert.New(t)
+
+	expected := time.Date(2018, 3, 1, 10, 20, 30, 0, time.UTC)
+
+	cases := map[string]time.Time{
+		`"2018-03-01T10:20:30Z"`:           expected,
+		`"2018-03-01T10:20:30.5Z"`:         expected.Add(500 * time.Millisecond),
+		`"2018-03-01T11:20:30+01:00"`:      expected,
+		`1519899630`:                       expected,
+		`1519899630000`:                    expected,
+		`1519899630500`:                    expected.Add(500 * time.Millisecond),
+		`null`:                             {},
+		`"2018-03-01T10:20:30.123456789Z"`: expected.Add(123456789),
+		`"2018-03-01T10:20:30+0000"`:       expected,
+		`"2018-03-01T11:20:30.5+0100"`:     expected.Add(500 * time.Millisecond),
+		`"2018-03-01T10:20Z"`:              expected.Add(-30 * time.Second),
+		`"2018-03-01T11:20+01:00"`:         expected.Add(-30 * time.Second),
+		`"2018-03-01T10:20+0000"`:          expected.Add(-30 * time.Second),
+	}
+
+	for input, want := range cases {
+		var ft FlexibleTime
+		err := json.Unmarshal([]byte(input), &ft)
+		assert.NoError(err, input)
+		assert.True(want.Equal(ft.Time), "%s: expected %v, got %v", input, want, ft.Time)
+	}
+}
+
+func TestFlexibleTimeUnmarshalJSON_Invalid(t *testing.T) {
+	for _, input := range []string{`"yesterday"`, `"2018-03-01"`, `"2018-03-01T10:20"`, `"2018-03-01T10:20+00"`, `true`, `{}`} {
+		var ft FlexibleTime
+		assert.Error(t, json.Unmarshal([]byte(input), &ft), input)
+	}
+}
+
+func TestFlexibleTimeMarshalJSON(t *testing.T) {
+	assert := assert.New(t)
+
+	v := struct {
+		Created FlexibleTime `json:"created"`
+		Deleted FlexibleTime `json:"deleted"`
+	}{
+		Created: FlexibleTime{time.Date(2018, 3, 1, 10, 20, 30, 0, time.UTC)},
+	}
+
+	bytes, err := json.Marshal(v)
+	assert.NoError(err)
+	assert.Equal(`{"created":"2018-03-01T10:20:30Z","deleted":null}`, string(bytes))
+}
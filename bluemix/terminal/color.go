@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"regexp"
+	"strings"
 
 	"golang.org/x/crypto/ssh/terminal"
 
@@ -28,6 +29,11 @@ var (
 	colorize               func(message string, color Color, bold int) string
 	TerminalSupportsColors = isTerminal()
 	UserAskedForColors     = ""
+
+	// NoColorFlag is set if the command line has flag --no-color. It takes
+	// precedence over environment variable BLUEMIX_COLOR and the color
+	// setting in config. Call InitColorSupport after changing it.
+	NoColorFlag = false
 )
 
 func init() {
@@ -46,9 +52,49 @@ func InitColorSupport() {
 	}
 }
 
+// ColorsEnabled returns whether output is colorized. In order of
+// precedence, it is decided by:
+//   - flag --no-color, see NoColorFlag
+//   - environment variable BLUEMIX_COLOR
+//   - color setting in config, see UserAskedForColors
+//   - environment variable NO_COLOR, which disables color if not empty
+//   - whether stdout is a terminal
 func ColorsEnabled() bool {
-	return userDidNotDisableColor() &&
-		(userEnabledColors() || TerminalSupportsColors)
+	return !NoColorFlag && userDidNotDisableColor() &&
+		(userEnabledColors() || (TerminalSupportsColors && os.Getenv(consts.ENV_NO_COLOR) == ""))
+}
+
+// Color depths returned by ColorDepth
+const (
+	ColorDepthNone      = 1       // no color
+	ColorDepthBasic     = 8       // the 8 basic ANSI colors
+	ColorDepth256       = 256     // the xterm 256-color palette
+	ColorDepthTrueColor = 1 << 24 // 24-bit RGB colors
+)
+
+// ColorDepth returns the number of colors the terminal supports. It returns
+// ColorDepthNone if colors are disabled, see ColorsEnabled. Otherwise it is
+// detected from environment variables:
+//   - COLORTERM "truecolor" or "24bit", or TERM ending with "-direct", means
+//     ColorDepthTrueColor
+//   - TERM containing "256color" means ColorDepth256
+//
+// ColorDepthBasic is returned if the depth can't be detected.
+func ColorDepth() int {
+	if !ColorsEnabled() {
+		return ColorDepthNone
+	}
+
+	colorTerm := strings.ToLower(os.Getenv("COLORTERM"))
+	term := strings.ToLower(os.Getenv("TERM"))
+	switch {
+	case colorTerm == "truecolor" || colorTerm == "24bit" || strings.HasSuffix(term, "-direct"):
+		return ColorDepthTrueColor
+	case strings.Contains(term, "256color"):
+		return ColorDepth256
+	default:
+		return ColorDepthBasic
+	}
 }
 
 func userEnabledColors() bool {
@@ -67,7 +113,60 @@ func ColorizeBold(message string, color Color) string {
 	return colorize(message, color, 1)
 }
 
-var decolorizerRegex = regexp.MustCompile(`\x1B\[([0-9]{1,2}(;[0-9]{1,2})?)?[m|K]`)
+// ColorizeRGB colors the message with the RGB color. The color is downgraded
+// to the nearest color supported by the terminal, see ColorDepth.
+func ColorizeRGB(message string, r, g, b uint8) string {
+	switch depth := ColorDepth(); {
+	case depth >= ColorDepthTrueColor:
+		return fmt.Sprintf("\033[38;2;%d;%d;%dm%s\033[0m", r, g, b, message)
+	case depth >= ColorDepth256:
+		return fmt.Sprintf("\033[38;5;%dm%s\033[0m", rgbTo256(r, g, b), message)
+	case depth >= ColorDepthBasic:
+		return Colorize(message, rgbToBasic(r, g, b))
+	default:
+		return message
+	}
+}
+
+// rgbTo256 returns the nearest color in the xterm 256-color palette: the
+// grayscale ramp 232-255 for grays, otherwise the 6x6x6 color cube 16-231.
+func rgbTo256(r, g, b uint8) int {
+	if r == g && g == b {
+		switch {
+		case r < 8:
+			return 16
+		case r > 248:
+			return 231
+		default:
+			// gray levels of the ramp are 8, 18, ..., 238
+			n := (int(r) - 8 + 5) / 10
+			if n > 23 {
+				n = 23
+			}
+			return 232 + n
+		}
+	}
+
+	scale := func(c uint8) int { return (int(c)*5 + 127) / 255 }
+	return 16 + 36*scale(r) + 6*scale(g) + scale(b)
+}
+
+// rgbToBasic returns the nearest of the 8 basic ANSI foreground colors
+func rgbToBasic(r, g, b uint8) Color {
+	c := Color(30)
+	if r > 127 {
+		c += 1
+	}
+	if g > 127 {
+		c += 2
+	}
+	if b > 127 {
+		c += 4
+	}
+	return c
+}
+
+var decolorizerRegex = regexp.MustCompile(`\x1B\[[0-9;]*[mK]`)
 
 func Decolorize(message string) string {
 	return string(decolorizerRegex.ReplaceAll([]byte(message), []byte("")))
@@ -0,0 +1,88 @@
+package terminal
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestColorsEnabled_NoColorFlag(t *testing.T) {
+	os.Setenv("BLUEMIX_COLOR", "true")
+	defer os.Unsetenv("BLUEMIX_COLOR")
+	assert.True(t, ColorsEnabled())
+
+	NoColorFlag = true
+	defer func() { NoColorFlag = false }()
+	assert.False(t, ColorsEnabled())
+}
+
+func TestColorsEnabled_NoColorEnv(t *testing.T) {
+	defer func(v bool) { TerminalSupportsColors = v }(TerminalSupportsColors)
+	TerminalSupportsColors = true
+
+	os.Setenv("NO_COLOR", "1")
+	defer os.Unsetenv("NO_COLOR")
+	assert.False(t, ColorsEnabled())
+
+	os.Setenv("BLUEMIX_COLOR", "true")
+	defer os.Unsetenv("BLUEMIX_COLOR")
+	assert.True(t, ColorsEnabled())
+}
+
+func TestColorDepth(t *testing.T) {
+	os.Setenv("BLUEMIX_COLOR", "true")
+	defer os.Unsetenv("BLUEMIX_COLOR")
+	defer os.Setenv("TERM", os.Getenv("TERM"))
+	defer os.Setenv("COLORTERM", os.Getenv("COLORTERM"))
+
+	cases := []struct {
+		term, colorTerm string
+		depth           int
+	}{
+		{"xterm", "", ColorDepthBasic},
+		{"", "", ColorDepthBasic},
+		{"xterm-256color", "", ColorDepth256},
+		{"screen-256color", "", ColorDepth256},
+		{"xterm-256color", "truecolor", ColorDepthTrueColor},
+		{"xterm", "24bit", ColorDepthTrueColor},
+		{"xterm-direct", "", ColorDepthTrueColor},
+	}
+	for _, c := range cases {
+		os.Setenv("TERM", c.term)
+		os.Setenv("COLORTERM", c.colorTerm)
+		assert.Equal(t, c.depth, ColorDepth(), c.term+" "+c.colorTerm)
+	}
+
+	os.Setenv("BLUEMIX_COLOR", "false")
+	assert.Equal(t, ColorDepthNone, ColorDepth())
+}
+
+func TestColorizeRGB(t *testing.T) {
+	os.Setenv("BLUEMIX_COLOR", "true")
+	defer os.Unsetenv("BLUEMIX_COLOR")
+	defer os.Setenv("TERM", os.Getenv("TERM"))
+	defer os.Setenv("COLORTERM", os.Getenv("COLORTERM"))
+	InitColorSupport()
+	defer InitColorSupport()
+
+	os.Setenv("TERM", "xterm")
+	os.Setenv("COLORTERM", "truecolor")
+	assert.Equal(t, "\033[38;2;255;135;0mfoo\033[0m", ColorizeRGB("foo", 255, 135, 0))
+
+	os.Setenv("COLORTERM", "")
+	os.Setenv("TERM", "xterm-256color")
+	assert.Equal(t, "\033[38;5;214mfoo\033[0m", ColorizeRGB("foo", 255, 135, 0))
+	assert.Equal(t, "\033[38;5;244mfoo\033[0m", ColorizeRGB("foo", 128, 128, 128))
+
+	os.Setenv("TERM", "xterm")
+	assert.Equal(t, "\033[0;33mfoo\033[0m", ColorizeRGB("foo", 255, 135, 0))
+
+	os.Setenv("BLUEMIX_COLOR", "false")
+	assert.Equal(t, "foo", ColorizeRGB("foo", 255, 135, 0))
+}
+
+func TestDecolorize(t *testing.T) {
+	assert.Equal(t, "foo bar", Decolorize("\033[1;36mfoo\033[0m \033[38;2;255;135;0mbar\033[0m"))
+	assert.Equal(t, "foo", Decolorize("\033[38;5;214mfoo\033[0m"))
+}
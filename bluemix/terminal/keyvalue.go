@@ -0,0 +1,56 @@
+package terminal
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/mattn/go-runewidth"
+)
+
+// KeyValueSection is a group of key/value pairs with an optional header
+type KeyValueSection struct {
+	Header string
+	Pairs  [][2]string
+}
+
+// PrintKeyValue writes the key/value pairs to w with the values aligned,
+// e.g.
+//
+//	Name:       my-db
+//	Location:   us-south
+//
+// Multi-line values are indented to the same column. Plugins should use
+// plugin.PrintKeyValue, which honours quiet mode.
+func PrintKeyValue(w io.Writer, pairs [][2]string) {
+	PrintKeyValueSections(w, []KeyValueSection{{Pairs: pairs}})
+}
+
+// PrintKeyValueSections writes the sections to w separated by a blank line.
+// Each section header is printed before its pairs. Values are aligned
+// across all sections.
+func PrintKeyValueSections(w io.Writer, sections []KeyValueSection) {
+	var width int
+	for _, s := range sections {
+		for _, p := range s.Pairs {
+			if l := runewidth.StringWidth(Decolorize(p[0])) + 1; l > width {
+				width = l
+			}
+		}
+	}
+
+	for i, s := range sections {
+		if i > 0 {
+			fmt.Fprintln(w)
+		}
+		if s.Header != "" {
+			fmt.Fprintln(w, HeaderColor(s.Header))
+		}
+		for _, p := range s.Pairs {
+			key := p[0] + ":"
+			padding := strings.Repeat(" ", width-runewidth.StringWidth(Decolorize(key))+3)
+			value := strings.Replace(p[1], "\n", "\n"+strings.Repeat(" ", width+3), -1)
+			fmt.Fprintln(w, TableContentHeaderColor(key)+padding+value)
+		}
+	}
+}
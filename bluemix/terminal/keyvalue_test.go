@@ -0,0 +1,37 @@
+package terminal
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrintKeyValue(t *testing.T) {
+	buf := new(bytes.Buffer)
+	PrintKeyValue(buf, [][2]string{
+		{"Name", "my-db"},
+		{"Location", "us-south"},
+		{"Tags", "env:dev\nteam:a"},
+	})
+
+	assert.Equal(t, ""+
+		"Name:       my-db\n"+
+		"Location:   us-south\n"+
+		"Tags:       env:dev\n"+
+		"            team:a\n", Decolorize(buf.String()))
+}
+
+func TestPrintKeyValueSections(t *testing.T) {
+	buf := new(bytes.Buffer)
+	PrintKeyValueSections(buf, []KeyValueSection{
+		{Pairs: [][2]string{{"Name", "my-db"}}},
+		{Header: "Last Operation", Pairs: [][2]string{{"Status", "succeeded"}}},
+	})
+
+	assert.Equal(t, ""+
+		"Name:     my-db\n"+
+		"\n"+
+		"Last Operation\n"+
+		"Status:   succeeded\n", Decolorize(buf.String()))
+}
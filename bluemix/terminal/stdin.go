@@ -0,0 +1,47 @@
+package terminal
+
+import (
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+
+	"golang.org/x/crypto/ssh/terminal"
+
+	. "github.com/IBM-Cloud/ibm-cloud-cli-sdk/i18n"
+)
+
+// MaxStdinSize is the maximum number of bytes ReadStdinIfPiped reads from
+// stdin.
+const MaxStdinSize = 10 * 1024 * 1024
+
+// ReadStdinIfPiped reads all data from stdin if stdin is piped or redirected
+// from a file. The returned bool reports whether stdin was piped. If stdin is
+// a terminal, it returns immediately without reading. An error is returned if
+// the data exceeds MaxStdinSize.
+func ReadStdinIfPiped() ([]byte, bool, error) {
+	return readIfPiped(os.Stdin, MaxStdinSize)
+}
+
+func readIfPiped(f *os.File, limit int64) ([]byte, bool, error) {
+	if terminal.IsTerminal(int(f.Fd())) {
+		return nil, false, nil
+	}
+
+	stat, err := f.Stat()
+	if err != nil {
+		return nil, false, err
+	}
+	if stat.Mode()&os.ModeCharDevice != 0 {
+		return nil, false, nil
+	}
+
+	data, err := ioutil.ReadAll(io.LimitReader(f, limit+1))
+	if err != nil {
+		return nil, true, err
+	}
+	if int64(len(data)) > limit {
+		return nil, true, errors.New(T("Input from stdin exceeds the limit of {{.Limit}} bytes", map[string]interface{}{"Limit": limit}))
+	}
+	return data, true, nil
+}
@@ -0,0 +1,69 @@
+package terminal
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadIfPiped_Pipe(t *testing.T) {
+	assert := assert.New(t)
+
+	r, w, err := os.Pipe()
+	assert.NoError(err)
+	defer r.Close()
+
+	go func() {
+		w.Write([]byte("piped data"))
+		w.Close()
+	}()
+
+	data, piped, err := readIfPiped(r, 1024)
+	assert.NoError(err)
+	assert.True(piped)
+	assert.Equal("piped data", string(data))
+}
+
+func TestReadIfPiped_File(t *testing.T) {
+	assert := assert.New(t)
+
+	f, err := ioutil.TempFile("", "stdin_test")
+	assert.NoError(err)
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	_, err = f.WriteString("0123456789")
+	assert.NoError(err)
+	_, err = f.Seek(0, 0)
+	assert.NoError(err)
+
+	data, piped, err := readIfPiped(f, 10)
+	assert.NoError(err)
+	assert.True(piped)
+	assert.Equal("0123456789", string(data))
+
+	_, err = f.Seek(0, 0)
+	assert.NoError(err)
+
+	data, piped, err = readIfPiped(f, 9)
+	assert.EqualError(err, "Input from stdin exceeds the limit of 9 bytes")
+	assert.True(piped)
+	assert.Nil(data)
+}
+
+func TestReadIfPiped_CharDevice(t *testing.T) {
+	assert := assert.New(t)
+
+	f, err := os.Open(os.DevNull)
+	if err != nil {
+		t.Skip("no null device")
+	}
+	defer f.Close()
+
+	data, piped, err := readIfPiped(f, 1024)
+	assert.NoError(err)
+	assert.False(piped)
+	assert.Nil(data)
+}
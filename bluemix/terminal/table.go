@@ -1,10 +1,13 @@
 package terminal
 
 import (
+	"encoding/csv"
 	"fmt"
 	"github.com/mattn/go-runewidth"
 	"io"
 	"strings"
+
+	. "github.com/IBM-Cloud/ibm-cloud-cli-sdk/i18n"
 )
 
 type Table interface {
@@ -12,12 +15,35 @@ type Table interface {
 	Print()
 }
 
+// PaginatedTable is a Table that prints a pagination footer. The table
+// returned by NewTable implements it:
+//
+//	table := ui.Table(headers).(terminal.PaginatedTable)
+//	table.SetPagination(1, 50, 200)
+type PaginatedTable interface {
+	Table
+
+	// SetPagination sets the 1-based range of the rows in the current page
+	// and the total number of rows. A footer like "Showing 1-50 of 200" is
+	// printed after the rows.
+	SetPagination(start int, end int, total int)
+
+	// SetQuiet suppresses the pagination footer if quiet is true.
+	SetQuiet(quiet bool)
+}
+
 type PrintableTable struct {
 	writer        io.Writer
 	headers       []string
 	headerPrinted bool
 	maxSizes      []int
 	rows          [][]string //each row is single line
+	pagination    *pagination
+	quiet         bool
+}
+
+type pagination struct {
+	start, end, total int
 }
 
 func NewTable(w io.Writer, headers []string) Table {
@@ -68,6 +94,28 @@ func (t *PrintableTable) Print() {
 	}
 
 	t.rows = [][]string{}
+
+	if t.pagination != nil && !t.quiet {
+		t.printFooter()
+	}
+}
+
+func (t *PrintableTable) SetPagination(start int, end int, total int) {
+	t.pagination = &pagination{start: start, end: end, total: total}
+}
+
+func (t *PrintableTable) SetQuiet(quiet bool) {
+	t.quiet = quiet
+}
+
+func (t *PrintableTable) printFooter() {
+	p := t.pagination
+	if p.total == 0 || p.end < p.start {
+		fmt.Fprintln(t.writer, "\n"+T("Showing 0 of {{.Total}}", map[string]interface{}{"Total": p.total}))
+		return
+	}
+	fmt.Fprintln(t.writer, "\n"+T("Showing {{.Start}}-{{.End}} of {{.Total}}",
+		map[string]interface{}{"Start": p.start, "End": p.end, "Total": p.total}))
 }
 
 func (t *PrintableTable) calculateMaxSize(row []string) {
@@ -106,3 +154,22 @@ func (t *PrintableTable) cellValue(col int, value string) string {
 	}
 	return fmt.Sprintf("%s%s   ", value, padding)
 }
+
+// PrintCSV writes the headers and rows to w in CSV format. Fields are quoted
+// and escaped as defined by RFC 4180. Colors are removed from the values.
+func PrintCSV(w io.Writer, headers []string, rows [][]string) error {
+	csvWriter := csv.NewWriter(w)
+
+	for _, row := range append([][]string{headers}, rows...) {
+		record := make([]string, len(row))
+		for i, value := range row {
+			record[i] = Decolorize(value)
+		}
+		if err := csvWriter.Write(record); err != nil {
+			return err
+		}
+	}
+
+	csvWriter.Flush()
+	return csvWriter.Error()
+}
@@ -0,0 +1,57 @@
+package terminal
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTablePagination(t *testing.T) {
+	buf := new(bytes.Buffer)
+	table := NewTable(buf, []string{"Name"}).(PaginatedTable)
+	table.Add("foo")
+	table.SetPagination(1, 50, 200)
+	table.Print()
+
+	assert.Contains(t, buf.String(), "Showing 1-50 of 200")
+}
+
+func TestTablePagination_Empty(t *testing.T) {
+	buf := new(bytes.Buffer)
+	table := NewTable(buf, []string{"Name"}).(PaginatedTable)
+	table.SetPagination(1, 0, 0)
+	table.Print()
+
+	assert.Contains(t, buf.String(), "Showing 0 of 0")
+}
+
+func TestTablePagination_Quiet(t *testing.T) {
+	buf := new(bytes.Buffer)
+	table := NewTable(buf, []string{"Name"}).(PaginatedTable)
+	table.Add("foo")
+	table.SetPagination(1, 1, 1)
+	table.SetQuiet(true)
+	table.Print()
+
+	assert.NotContains(t, buf.String(), "Showing")
+}
+
+func TestPrintCSV(t *testing.T) {
+	assert := assert.New(t)
+
+	buf := new(bytes.Buffer)
+	err := PrintCSV(buf, []string{"Name", "Description"}, [][]string{
+		{"db", `a "quoted" value`},
+		{"cache", "one, two"},
+		{"queue", "first line\nsecond line"},
+		{"\x1b[31mred\x1b[0m", "plain"},
+	})
+	assert.NoError(err)
+	assert.Equal(""+
+		"Name,Description\n"+
+		"db,\"a \"\"quoted\"\" value\"\n"+
+		"cache,\"one, two\"\n"+
+		"queue,\"first line\nsecond line\"\n"+
+		"red,plain\n", buf.String())
+}
@@ -0,0 +1,73 @@
+package trace
+
+import (
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// EventType is the type of a trace event
+type EventType string
+
+const (
+	EventRequest  EventType = "request"
+	EventResponse EventType = "response"
+)
+
+// Event is a structured trace event of an HTTP interaction. Sensitive user
+// data in the header and dump is replaced by "[PRIVATE DATA HIDDEN]".
+type Event struct {
+	Type       EventType
+	Time       time.Time
+	Method     string
+	URL        string
+	StatusCode int           // status code of the response, 0 for request
+	Header     http.Header   // sanitized header
+	Elapsed    time.Duration // elapsed time since the request, 0 for request
+	Dump       string        // sanitized dump of the request or response
+}
+
+// Sink receives trace events.
+type Sink interface {
+	Trace(event Event)
+}
+
+// EventSink is the sink receiving trace events in addition to the text trace
+// written to Logger. Default is nil meaning no event is sent.
+var EventSink Sink
+
+// Recorder is a Sink recording trace events in memory, e.g. for assertions
+// in tests. It is safe for concurrent use.
+type Recorder struct {
+	lock   sync.Mutex
+	events []Event
+}
+
+// Trace records the event.
+func (r *Recorder) Trace(event Event) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.events = append(r.events, event)
+}
+
+// Events returns the recorded events.
+func (r *Recorder) Events() []Event {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return append([]Event{}, r.events...)
+}
+
+// SanitizeHeader returns a copy of the header with values of Authorization
+// and X-Auth* headers replaced by "[PRIVATE DATA HIDDEN]".
+func SanitizeHeader(header http.Header) http.Header {
+	sanitized := make(http.Header, len(header))
+	for k, vs := range header {
+		if strings.EqualFold(k, "Authorization") || strings.HasPrefix(strings.ToLower(k), "x-auth") {
+			sanitized[k] = []string{privateDataPlaceholder}
+		} else {
+			sanitized[k] = append([]string{}, vs...)
+		}
+	}
+	return sanitized
+}
@@ -0,0 +1,141 @@
+package trace
+
+import (
+	"reflect"
+	"strings"
+)
+
+// RedactStruct returns a copy of v with sensitive data masked for safe
+// logging. A struct field is masked if it has tag `redact:"true"`, or its
+// name or JSON name matches one of fieldNames case-insensitively. A map entry
+// is masked if its key matches one of fieldNames. Nested structs, pointers,
+// maps and slices are redacted recursively; a pointer or map that is
+// referenced more than once, e.g. in a cycle, is copied once. v itself is not
+// modified.
+//
+// String values are replaced by "[PRIVATE DATA HIDDEN]", values of other
+// types are replaced by the zero value.
+func RedactStruct(v interface{}, fieldNames ...string) interface{} {
+	if v == nil {
+		return nil
+	}
+
+	names := make(map[string]bool)
+	for _, n := range fieldNames {
+		names[strings.ToLower(n)] = true
+	}
+	r := &redactor{names: names, copies: make(map[reference]reflect.Value)}
+	return r.redact(reflect.ValueOf(v)).Interface()
+}
+
+// reference identifies a pointer or map. The type is needed since a pointer
+// to a struct and to its first field have the same address.
+type reference struct {
+	ptr uintptr
+	typ reflect.Type
+}
+
+type redactor struct {
+	names map[string]bool
+
+	// copies are the redacted copies of the visited pointers and maps
+	copies map[reference]reflect.Value
+}
+
+func (r *redactor) redact(v reflect.Value) reflect.Value {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return v
+		}
+		ref := reference{v.Pointer(), v.Type()}
+		if c, ok := r.copies[ref]; ok {
+			return c
+		}
+		c := reflect.New(v.Type().Elem())
+		r.copies[ref] = c
+		c.Elem().Set(r.redact(v.Elem()))
+		return c
+	case reflect.Interface:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.New(v.Type()).Elem()
+		c.Set(r.redact(v.Elem()))
+		return c
+	case reflect.Struct:
+		c := reflect.New(v.Type()).Elem()
+		c.Set(v)
+		for i := 0; i < v.NumField(); i++ {
+			f := v.Type().Field(i)
+			if f.PkgPath != "" { // unexported
+				continue
+			}
+			if isRedactedField(f, r.names) {
+				c.Field(i).Set(masked(f.Type))
+			} else {
+				c.Field(i).Set(r.redact(v.Field(i)))
+			}
+		}
+		return c
+	case reflect.Map:
+		if v.IsNil() {
+			return v
+		}
+		ref := reference{v.Pointer(), v.Type()}
+		if c, ok := r.copies[ref]; ok {
+			return c
+		}
+		c := reflect.MakeMap(v.Type())
+		r.copies[ref] = c
+		for _, k := range v.MapKeys() {
+			if k.Kind() == reflect.String && r.names[strings.ToLower(k.String())] {
+				c.SetMapIndex(k, masked(v.Type().Elem()))
+			} else {
+				c.SetMapIndex(k, r.redact(v.MapIndex(k)))
+			}
+		}
+		return c
+	case reflect.Slice:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		for i := 0; i < v.Len(); i++ {
+			c.Index(i).Set(r.redact(v.Index(i)))
+		}
+		return c
+	case reflect.Array:
+		c := reflect.New(v.Type()).Elem()
+		for i := 0; i < v.Len(); i++ {
+			c.Index(i).Set(r.redact(v.Index(i)))
+		}
+		return c
+	default:
+		return v
+	}
+}
+
+func isRedactedField(f reflect.StructField, names map[string]bool) bool {
+	if f.Tag.Get("redact") == "true" {
+		return true
+	}
+	if names[strings.ToLower(f.Name)] {
+		return true
+	}
+	jsonName := strings.Split(f.Tag.Get("json"), ",")[0]
+	return jsonName != "" && names[strings.ToLower(jsonName)]
+}
+
+func masked(t reflect.Type) reflect.Value {
+	placeholder := reflect.ValueOf(privateDataPlaceholder)
+	if t.Kind() == reflect.String {
+		return placeholder.Convert(t)
+	}
+	if placeholder.Type().AssignableTo(t) {
+		c := reflect.New(t).Elem()
+		c.Set(placeholder)
+		return c
+	}
+	return reflect.Zero(t)
+}
@@ -55,3 +55,64 @@ func TestSanitize(t *testing.T) {
 		assert.Equal(t, test.expected, trace.Sanitize(test.input))
 	}
 }
+
+func TestRedactStruct(t *testing.T) {
+	assert := assert.New(t)
+
+	type credentials struct {
+		User     string
+		Password string `redact:"true"`
+	}
+	type request struct {
+		Name        string
+		APIKey      string `json:"api_key"`
+		Credentials *credentials
+		Extra       map[string]interface{}
+	}
+
+	req := request{
+		Name:        "test",
+		APIKey:      "my-api-key",
+		Credentials: &credentials{User: "joe", Password: "my-password"},
+		Extra:       map[string]interface{}{"token": "my-token", "count": 1},
+	}
+
+	redacted := trace.RedactStruct(req, "api_key", "token").(request)
+	assert.Equal("test", redacted.Name)
+	assert.Equal("[PRIVATE DATA HIDDEN]", redacted.APIKey)
+	assert.Equal("joe", redacted.Credentials.User)
+	assert.Equal("[PRIVATE DATA HIDDEN]", redacted.Credentials.Password)
+	assert.Equal("[PRIVATE DATA HIDDEN]", redacted.Extra["token"])
+	assert.Equal(1, redacted.Extra["count"])
+
+	assert.Equal("my-api-key", req.APIKey)
+	assert.Equal("my-password", req.Credentials.Password)
+	assert.Equal("my-token", req.Extra["token"])
+}
+
+func TestRedactStruct_Cycle(t *testing.T) {
+	assert := assert.New(t)
+
+	type node struct {
+		Name   string
+		Secret string `redact:"true"`
+		Next   *node
+		Attrs  map[string]interface{}
+	}
+
+	a := &node{Name: "a", Secret: "secret-a"}
+	b := &node{Name: "b", Secret: "secret-b", Next: a}
+	a.Next = b
+	a.Attrs = map[string]interface{}{"token": "my-token"}
+	a.Attrs["self"] = a.Attrs
+
+	redacted := trace.RedactStruct(a, "token").(*node)
+	assert.Equal("a", redacted.Name)
+	assert.Equal("[PRIVATE DATA HIDDEN]", redacted.Secret)
+	assert.Equal("[PRIVATE DATA HIDDEN]", redacted.Next.Secret)
+	assert.True(redacted.Next.Next == redacted)
+	assert.Equal("[PRIVATE DATA HIDDEN]", redacted.Attrs["token"])
+
+	assert.Equal("secret-a", a.Secret)
+	assert.Equal("secret-b", b.Secret)
+}
@@ -0,0 +1,42 @@
+// Package batch provides helpers to split items into batches for APIs that
+// limit the number of items per request.
+package batch
+
+// Chunk splits the items into chunks of at most size items, preserving
+// order. The last chunk holds the remainder. If size <= 0, all items are
+// returned in a single chunk. No chunk is returned for empty items.
+func Chunk(items []string, size int) [][]string {
+	var chunks [][]string
+	for _, r := range Ranges(len(items), size) {
+		chunks = append(chunks, items[r[0]:r[1]])
+	}
+	return chunks
+}
+
+// Ranges returns the [start, end) index ranges that split a slice of length
+// n into chunks of at most size elements, so that slices of any type can be
+// chunked:
+//
+//	for _, r := range batch.Ranges(len(instances), 50) {
+//		update(instances[r[0]:r[1]])
+//	}
+//
+// If size <= 0, a single range covering the slice is returned.
+func Ranges(n int, size int) [][2]int {
+	if n <= 0 {
+		return nil
+	}
+	if size <= 0 {
+		size = n
+	}
+
+	ranges := make([][2]int, 0, (n+size-1)/size)
+	for start := 0; start < n; start += size {
+		end := start + size
+		if end > n {
+			end = n
+		}
+		ranges = append(ranges, [2]int{start, end})
+	}
+	return ranges
+}
@@ -0,0 +1,28 @@
+package batch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChunk(t *testing.T) {
+	assert := assert.New(t)
+
+	items := []string{"a", "b", "c", "d", "e", "f"}
+
+	assert.Equal([][]string{{"a", "b", "c"}, {"d", "e", "f"}}, Chunk(items, 3))
+	assert.Equal([][]string{{"a", "b", "c", "d"}, {"e", "f"}}, Chunk(items, 4))
+	assert.Equal([][]string{items}, Chunk(items, 10))
+	assert.Equal([][]string{items}, Chunk(items, 0))
+	assert.Equal([][]string{items}, Chunk(items, -1))
+	assert.Empty(Chunk(nil, 3))
+}
+
+func TestRanges(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal([][2]int{{0, 2}, {2, 4}, {4, 5}}, Ranges(5, 2))
+	assert.Equal([][2]int{{0, 5}}, Ranges(5, 0))
+	assert.Empty(Ranges(0, 2))
+}
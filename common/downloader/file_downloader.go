@@ -44,17 +44,12 @@ func (d *FileDownloader) Download(url string) (dest string, size int64, err erro
 // DownloadTo downloads a file from a URL to the file with the specified name in
 // the download directory
 func (d *FileDownloader) DownloadTo(url string, outputName string) (dest string, size int64, err error) {
-	req, err := d.createRequest(url)
+	req, err := d.createRequest(http.MethodGet, url)
 	if err != nil {
 		return "", 0, fmt.Errorf("download request error: %v", err)
 	}
 
-	client := d.Client
-	if client == nil {
-		client = http.DefaultClient
-	}
-
-	resp, err := client.Do(req)
+	resp, err := d.client().Do(req)
 	if err != nil {
 		return "", 0, err
 	}
@@ -89,19 +84,164 @@ func (d *FileDownloader) DownloadTo(url string, outputName string) (dest string,
 	return dest, size, nil
 }
 
+// ResumeDownloadTo downloads a file from a URL to the file with the specified
+// name in the download directory. If the file exists, e.g. a previous
+// download was interrupted, only the remainder is fetched with a Range
+// request and appended to the file. If server does not support range
+// requests, i.e. it does not respond with header "Accept-Ranges: bytes", the
+// file is downloaded again in full. The Range request is conditional on the
+// ETag or Last-Modified of the file in the HEAD response (If-Range), so that
+// the file is downloaded in full if it changed in between. The returned size
+// is the size of the whole file.
+func (d *FileDownloader) ResumeDownloadTo(url string, outputName string) (dest string, size int64, err error) {
+	dest = filepath.Join(d.SaveDir, outputName)
+
+	var offset int64
+	if info, err := os.Stat(dest); err == nil {
+		offset = info.Size()
+	} else if !os.IsNotExist(err) {
+		return dest, 0, err
+	}
+
+	var validator string
+	if offset > 0 {
+		var supported bool
+		supported, validator, err = d.supportsRange(url)
+		if err != nil {
+			return dest, 0, err
+		}
+		if !supported {
+			offset = 0
+		}
+	}
+
+	req, err := d.createRequest(http.MethodGet, url)
+	if err != nil {
+		return dest, 0, fmt.Errorf("download request error: %v", err)
+	}
+	if offset > 0 {
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+		if validator != "" {
+			req.Header.Set("If-Range", validator)
+		}
+	}
+
+	resp, err := d.client().Do(req)
+	if err != nil {
+		return dest, 0, err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusPartialContent:
+		start, err := contentRangeStart(resp.Header.Get("Content-Range"))
+		if err != nil {
+			return dest, 0, err
+		}
+		if start != offset {
+			return dest, 0, fmt.Errorf("Unexpected content range start %d, expected %d", start, offset)
+		}
+	case http.StatusOK:
+		// server ignored the range, the full file is sent
+		offset = 0
+	case http.StatusRequestedRangeNotSatisfiable:
+		// the file is complete if the range starts at the end of the file
+		if total, err := contentRangeTotal(resp.Header.Get("Content-Range")); err == nil && total == offset {
+			return dest, offset, nil
+		}
+		return dest, 0, fmt.Errorf("Unexpected response code %d", resp.StatusCode)
+	default:
+		return dest, 0, fmt.Errorf("Unexpected response code %d", resp.StatusCode)
+	}
+
+	flag := os.O_WRONLY | os.O_CREATE | os.O_TRUNC
+	if offset > 0 {
+		flag = os.O_WRONLY | os.O_APPEND
+	}
+	f, err := os.OpenFile(dest, flag, 0600)
+	if err != nil {
+		return dest, 0, err
+	}
+	defer f.Close()
+
+	var r io.Reader = resp.Body
+	if d.ProxyReader != nil {
+		defer d.ProxyReader.Finish()
+		r = d.ProxyReader.Proxy(resp.ContentLength, r)
+	}
+
+	n, err := io.Copy(f, r)
+	return dest, offset + n, err
+}
+
+// supportsRange returns whether server accepts range requests for the URL,
+// and the validator of the file for header If-Range: the ETag if it is a
+// strong one, otherwise Last-Modified. The validator is empty if there is
+// neither.
+func (d *FileDownloader) supportsRange(url string) (supported bool, validator string, err error) {
+	req, err := d.createRequest(http.MethodHead, url)
+	if err != nil {
+		return false, "", fmt.Errorf("download request error: %v", err)
+	}
+
+	resp, err := d.client().Do(req)
+	if err != nil {
+		return false, "", err
+	}
+	resp.Body.Close()
+
+	supported = resp.StatusCode == http.StatusOK && strings.EqualFold(resp.Header.Get("Accept-Ranges"), "bytes")
+
+	// weak ETags are not allowed in If-Range
+	if etag := resp.Header.Get("ETag"); etag != "" && !strings.HasPrefix(etag, "W/") {
+		validator = etag
+	} else {
+		validator = resp.Header.Get("Last-Modified")
+	}
+	return supported, validator, nil
+}
+
+// contentRangeStart returns the first byte position of Content-Range header
+// "bytes start-end/total"
+func contentRangeStart(header string) (int64, error) {
+	var start, end int64
+	var total string
+	if _, err := fmt.Sscanf(header, "bytes %d-%d/%s", &start, &end, &total); err != nil {
+		return 0, fmt.Errorf("Invalid Content-Range header '%s'", header)
+	}
+	return start, nil
+}
+
+// contentRangeTotal returns the complete length of Content-Range header
+// "bytes */total" sent with 416 Range Not Satisfiable
+func contentRangeTotal(header string) (int64, error) {
+	var total int64
+	if _, err := fmt.Sscanf(header, "bytes */%d", &total); err != nil {
+		return 0, fmt.Errorf("Invalid Content-Range header '%s'", header)
+	}
+	return total, nil
+}
+
 // RemoveDir removes the download directory
 func (d *FileDownloader) RemoveDir() error {
 	return os.RemoveAll(d.SaveDir)
 }
 
-func (d *FileDownloader) createRequest(url string) (*http.Request, error) {
-	r, err := http.NewRequest(http.MethodGet, url, nil)
+func (d *FileDownloader) client() *http.Client {
+	if d.Client == nil {
+		return http.DefaultClient
+	}
+	return d.Client
+}
+
+func (d *FileDownloader) createRequest(method string, url string) (*http.Request, error) {
+	r, err := http.NewRequest(method, url, nil)
 	if err != nil {
 		return nil, err
 	}
 
 	if d.DefaultHeader != nil {
-		r.Header = d.DefaultHeader
+		r.Header = d.DefaultHeader.Clone()
 	}
 
 	if r.Header.Get("User-Agent") == "" {
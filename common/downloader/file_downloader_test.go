@@ -7,7 +7,9 @@ import (
 	"net/http/httptest"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
@@ -121,6 +123,121 @@ func (suite *DownloadTestSuite) TestDownloadRequestHeader() {
 	suite.downloader.Download(fileServer.URL + "/test.txt")
 }
 
+func (suite *DownloadTestSuite) TestResumeDownloadTo() {
+	assert := assert.New(suite.T())
+
+	content := "this is the file content"
+	var ranges []string
+	fileServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ranges = append(ranges, r.Header.Get("Range"))
+		http.ServeContent(w, r, "test.txt", time.Time{}, strings.NewReader(content))
+	}))
+	defer fileServer.Close()
+
+	dest := filepath.Join(suite.downloader.SaveDir, "out")
+	assert.NoError(ioutil.WriteFile(dest, []byte(content[:7]), 0600))
+
+	_, size, err := suite.downloader.ResumeDownloadTo(fileServer.URL, "out")
+	assert.NoError(err)
+	assert.Equal(int64(len(content)), size)
+	assert.Equal([]string{"", "bytes=7-"}, ranges)
+
+	b, _ := ioutil.ReadFile(dest)
+	assert.Equal(content, string(b))
+
+	// already complete
+	_, size, err = suite.downloader.ResumeDownloadTo(fileServer.URL, "out")
+	assert.NoError(err)
+	assert.Equal(int64(len(content)), size)
+
+	b, _ = ioutil.ReadFile(dest)
+	assert.Equal(content, string(b))
+}
+
+func (suite *DownloadTestSuite) TestResumeDownloadTo_IfRange() {
+	assert := assert.New(suite.T())
+
+	content := "this is the file content"
+	var ifRanges []string
+	fileServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ifRanges = append(ifRanges, r.Header.Get("If-Range"))
+		w.Header().Set("ETag", `"v1"`)
+		http.ServeContent(w, r, "test.txt", time.Time{}, strings.NewReader(content))
+	}))
+	defer fileServer.Close()
+
+	dest := filepath.Join(suite.downloader.SaveDir, "out")
+	assert.NoError(ioutil.WriteFile(dest, []byte(content[:7]), 0600))
+
+	_, size, err := suite.downloader.ResumeDownloadTo(fileServer.URL, "out")
+	assert.NoError(err)
+	assert.Equal(int64(len(content)), size)
+	assert.Equal([]string{"", `"v1"`}, ifRanges)
+
+	b, _ := ioutil.ReadFile(dest)
+	assert.Equal(content, string(b))
+}
+
+func (suite *DownloadTestSuite) TestResumeDownloadTo_Changed() {
+	assert := assert.New(suite.T())
+
+	// the file changes after the HEAD request
+	content := "this is the new file content"
+	var requests int
+	fileServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("ETag", fmt.Sprintf(`"v%d"`, requests))
+		http.ServeContent(w, r, "test.txt", time.Time{}, strings.NewReader(content))
+	}))
+	defer fileServer.Close()
+
+	dest := filepath.Join(suite.downloader.SaveDir, "out")
+	assert.NoError(ioutil.WriteFile(dest, []byte("this is the old"), 0600))
+
+	_, size, err := suite.downloader.ResumeDownloadTo(fileServer.URL, "out")
+	assert.NoError(err)
+	assert.Equal(int64(len(content)), size)
+
+	b, _ := ioutil.ReadFile(dest)
+	assert.Equal(content, string(b))
+}
+
+func (suite *DownloadTestSuite) TestResumeDownloadTo_NewFile() {
+	assert := assert.New(suite.T())
+
+	fileServer := CreateServerReturnContent("")
+	defer fileServer.Close()
+
+	dest, size, err := suite.downloader.ResumeDownloadTo(fileServer.URL, "out")
+	assert.NoError(err)
+	assert.Equal(int64(len("this is the file content")), size)
+
+	b, _ := ioutil.ReadFile(dest)
+	assert.Equal("this is the file content", string(b))
+}
+
+func (suite *DownloadTestSuite) TestResumeDownloadTo_RangeNotSupported() {
+	assert := assert.New(suite.T())
+
+	var ranges []string
+	fileServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ranges = append(ranges, r.Header.Get("Range"))
+		fmt.Fprint(w, "this is the file content")
+	}))
+	defer fileServer.Close()
+
+	dest := filepath.Join(suite.downloader.SaveDir, "out")
+	assert.NoError(ioutil.WriteFile(dest, []byte("stale"), 0600))
+
+	_, size, err := suite.downloader.ResumeDownloadTo(fileServer.URL, "out")
+	assert.NoError(err)
+	assert.Equal(int64(len("this is the file content")), size)
+	assert.Equal([]string{"", ""}, ranges)
+
+	b, _ := ioutil.ReadFile(dest)
+	assert.Equal("this is the file content", string(b))
+}
+
 func CreateServerReturnContent(content string) *httptest.Server {
 	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		fmt.Fprint(w, "this is the file content")
@@ -0,0 +1,157 @@
+package rest
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// responseCache caches successful responses in memory
+type responseCache struct {
+	ttl     time.Duration
+	lock    sync.Mutex
+	entries map[string]cacheEntry
+}
+
+type cacheEntry struct {
+	statusCode int
+	header     http.Header
+	body       []byte
+	expiresAt  time.Time
+}
+
+func newResponseCache(ttl time.Duration) *responseCache {
+	return &responseCache{
+		ttl:     ttl,
+		entries: make(map[string]cacheEntry),
+	}
+}
+
+// get returns the cached response of the request, or nil if not cached or
+// expired.
+func (c *responseCache) get(req *http.Request) *http.Response {
+	if noCache(req.Header) {
+		return nil
+	}
+
+	key := cacheKey(req)
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	e, ok := c.entries[key]
+	if !ok {
+		return nil
+	}
+	if time.Now().After(e.expiresAt) {
+		delete(c.entries, key)
+		return nil
+	}
+
+	return &http.Response{
+		Status:        strconv.Itoa(e.statusCode) + " " + http.StatusText(e.statusCode),
+		StatusCode:    e.statusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        e.header.Clone(),
+		Body:          ioutil.NopCloser(bytes.NewReader(e.body)),
+		ContentLength: int64(len(e.body)),
+		Request:       req,
+	}
+}
+
+// put caches the response if it is successful and cacheable. The response
+// body is read and replaced so that it can still be consumed. If maxBytes is
+// positive, at most maxBytes+1 bytes are buffered and a larger body is not
+// cached; the rest of the body is left to the caller, which reports
+// ErrResponseBodyTooLarge.
+func (c *responseCache) put(req *http.Request, resp *http.Response, maxBytes int64) error {
+	if resp.StatusCode != http.StatusOK || noCache(req.Header) || noCache(resp.Header) {
+		return nil
+	}
+
+	ttl := c.ttl
+	if maxAge, ok := maxAge(resp.Header); ok {
+		ttl = maxAge
+	}
+	if ttl <= 0 {
+		return nil
+	}
+
+	var reader io.Reader = resp.Body
+	if maxBytes > 0 {
+		reader = io.LimitReader(resp.Body, maxBytes+1)
+	}
+	body, err := ioutil.ReadAll(reader)
+	if err == nil && maxBytes > 0 && int64(len(body)) > maxBytes {
+		resp.Body = &decompressedBody{Reader: io.MultiReader(bytes.NewReader(body), resp.Body), body: resp.Body}
+		return nil
+	}
+	resp.Body.Close()
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.entries[cacheKey(req)] = cacheEntry{
+		statusCode: resp.StatusCode,
+		header:     resp.Header.Clone(),
+		body:       body,
+		expiresAt:  time.Now().Add(ttl),
+	}
+	return nil
+}
+
+// cacheKey returns the key of the request in the cache, which consists of
+// the method, the URL and the headers that affect the response.
+func cacheKey(req *http.Request) string {
+	return strings.Join([]string{
+		req.Method,
+		req.URL.String(),
+		req.Header.Get("Authorization"),
+		req.Header.Get("Accept"),
+	}, "\n")
+}
+
+// noCache returns whether the Cache-Control header has directive no-store or
+// no-cache.
+func noCache(h http.Header) bool {
+	for _, d := range cacheControl(h) {
+		if d == "no-store" || d == "no-cache" {
+			return true
+		}
+	}
+	return false
+}
+
+// maxAge returns the max-age directive of the Cache-Control header.
+func maxAge(h http.Header) (time.Duration, bool) {
+	for _, d := range cacheControl(h) {
+		if strings.HasPrefix(d, "max-age=") {
+			seconds, err := strconv.Atoi(strings.TrimPrefix(d, "max-age="))
+			if err == nil {
+				return time.Duration(seconds) * time.Second, true
+			}
+		}
+	}
+	return 0, false
+}
+
+func cacheControl(h http.Header) []string {
+	var directives []string
+	for _, v := range h.Values("Cache-Control") {
+		for _, d := range strings.Split(v, ",") {
+			directives = append(directives, strings.ToLower(strings.TrimSpace(d)))
+		}
+	}
+	return directives
+}
@@ -0,0 +1,100 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDo_ResponseCache(t *testing.T) {
+	assert := assert.New(t)
+
+	var requests int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		fmt.Fprintf(w, `{"count": %d}`, requests)
+	}))
+	defer ts.Close()
+
+	client := NewClient().WithResponseCache(time.Minute)
+
+	var res struct{ Count int }
+	_, err := client.Do(GetRequest(ts.URL), &res, nil)
+	assert.NoError(err)
+	assert.Equal(1, res.Count)
+
+	_, err = client.Do(GetRequest(ts.URL), &res, nil)
+	assert.NoError(err)
+	assert.Equal(1, res.Count)
+	assert.Equal(1, requests)
+
+	_, err = client.Do(GetRequest(ts.URL).NoCache(), &res, nil)
+	assert.NoError(err)
+	assert.Equal(2, res.Count)
+
+	_, err = client.Do(GetRequest(ts.URL).Set("Authorization", "other"), &res, nil)
+	assert.NoError(err)
+	assert.Equal(3, res.Count)
+
+	_, err = client.Do(PostRequest(ts.URL), &res, nil)
+	assert.NoError(err)
+	assert.Equal(4, res.Count)
+}
+
+func TestDo_ResponseCache_CacheControl(t *testing.T) {
+	assert := assert.New(t)
+
+	var requests int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.Path == "/no-store" {
+			w.Header().Set("Cache-Control", "private, no-store")
+		} else {
+			w.Header().Set("Cache-Control", "max-age=0")
+		}
+		fmt.Fprint(w, `{}`)
+	}))
+	defer ts.Close()
+
+	client := NewClient().WithResponseCache(time.Minute)
+
+	for i := 0; i < 2; i++ {
+		_, err := client.Do(GetRequest(ts.URL+"/no-store"), nil, nil)
+		assert.NoError(err)
+		_, err = client.Do(GetRequest(ts.URL+"/expired"), nil, nil)
+		assert.NoError(err)
+	}
+	assert.Equal(4, requests)
+}
+
+func TestDo_ResponseCache_MaxResponseBytes(t *testing.T) {
+	assert := assert.New(t)
+
+	var requests int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		fmt.Fprint(w, `{"name": "a very long name"}`)
+	}))
+	defer ts.Close()
+
+	client := NewClient().WithResponseCache(time.Minute).WithMaxResponseBytes(10)
+
+	for i := 0; i < 2; i++ {
+		_, err := client.Do(GetRequest(ts.URL), &struct{ Name string }{}, nil)
+		assert.Equal(ErrResponseBodyTooLarge, err)
+	}
+	assert.Equal(2, requests)
+
+	client.MaxResponseBytes = 100
+	var res struct{ Name string }
+	_, err := client.Do(GetRequest(ts.URL), &res, nil)
+	assert.NoError(err)
+	assert.Equal("a very long name", res.Name)
+	_, err = client.Do(GetRequest(ts.URL), &res, nil)
+	assert.NoError(err)
+	assert.Equal(3, requests)
+}
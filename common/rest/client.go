@@ -2,17 +2,36 @@
 package rest
 
 import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"net/http"
+	"strings"
+	"time"
 )
 
 // ErrEmptyResponseBody means the client receives an unexpected empty response from server
 var ErrEmptyResponseBody = errors.New("empty response body")
 
+// ErrNotModified is returned by Do when server returns 304 Not Modified for a
+// conditional request, e.g. a request with If-None-Match header. It means the
+// resource is unchanged and respV is left untouched, for example:
+//
+//	resp, err := client.Do(GetRequest(url).IfNoneMatch(etag), &v, nil)
+//	if err == ErrNotModified {
+//		// use the previously fetched value
+//	}
+var ErrNotModified = errors.New("not modified")
+
+// ErrResponseBodyTooLarge means the response body exceeds the maximum size
+// set by Client.WithMaxResponseBytes
+var ErrResponseBodyTooLarge = errors.New("response body too large")
+
 // ErrorResponse is the status code and response received from the server when an error occurs.
 type ErrorResponse struct {
 	StatusCode int    //  Response status code
@@ -23,10 +42,44 @@ func (e *ErrorResponse) Error() string {
 	return fmt.Sprintf("Error response from server. Status code: %v; message: %v", e.StatusCode, e.Message)
 }
 
+// Signer signs an outgoing HTTP request, e.g. adds an HMAC signature for
+// services that don't accept bearer tokens.
+type Signer interface {
+	Sign(req *http.Request) error
+}
+
+// SignerFunc is an adapter to allow the use of ordinary functions as Signer.
+type SignerFunc func(req *http.Request) error
+
+// Sign calls f(req).
+func (f SignerFunc) Sign(req *http.Request) error {
+	return f(req)
+}
+
 // Client is a simple HTTP and REST client. Create it with NewClient method.
 type Client struct {
 	HTTPClient    *http.Client // HTTP client, default is HTTP DefaultClient
 	DefaultHeader http.Header  // Default header applied to all outgoing HTTP request.
+	UseNumber     bool         // Decode JSON numbers into interface{} as json.Number instead of float64
+
+	// Maximum number of bytes read from the response body if the response is
+	// decoded into a value, default is 0 meaning unlimited.
+	MaxResponseBytes int64
+
+	// Disable decompression of the response body compressed with gzip or
+	// deflate Content-Encoding.
+	DisableDecompression bool
+
+	// Signer signs every request before it is sent, default is nil meaning
+	// requests are sent as is.
+	Signer Signer
+
+	// TokenRefresher is called when server responds 401 Unauthorized. The
+	// request is retried once with the returned token set as the
+	// Authorization header. Default is nil meaning no retry.
+	TokenRefresher func() (string, error)
+
+	cache *responseCache
 }
 
 // NewClient creates a client.
@@ -37,8 +90,41 @@ func NewClient() *Client {
 	}
 }
 
+// WithSigner sets the signer to sign requests before they are sent.
+func (c *Client) WithSigner(signer Signer) *Client {
+	c.Signer = signer
+	return c
+}
+
+// WithTokenRefresher sets the callback to refresh the token when server
+// responds 401 Unauthorized.
+func (c *Client) WithTokenRefresher(refresher func() (string, error)) *Client {
+	c.TokenRefresher = refresher
+	return c
+}
+
+// WithResponseCache enables caching successful responses of GET requests in
+// memory for ttl. Responses are cached per URL and the Authorization and
+// Accept headers. The Cache-Control header of the response takes precedence:
+// "no-store" or "no-cache" disables caching the response and "max-age"
+// overrides ttl. Use Request.NoCache to bypass the cache for a request.
+// Responses streamed to an io.Writer are never cached.
+func (c *Client) WithResponseCache(ttl time.Duration) *Client {
+	c.cache = newResponseCache(ttl)
+	return c
+}
+
+// WithMaxResponseBytes sets the maximum number of bytes read from the
+// response body. ErrResponseBodyTooLarge is returned if the limit is
+// exceeded. It does not apply to a response streamed to an io.Writer.
+func (c *Client) WithMaxResponseBytes(n int64) *Client {
+	c.MaxResponseBytes = n
+	return c
+}
+
 // Do sends a request and returns a HTTP response whose body is consumed and
-// closed.
+// closed. Response headers, e.g. pagination tokens or ETag, can be read from
+// the returned response.
 //
 // If respV is not nil, the value it points to is JSON decoded when server
 // returns a successful response.
@@ -46,6 +132,8 @@ func NewClient() *Client {
 // If errV is not nil, the value it points to is JSON decoded when server
 // returns an unsuccessfully response. If the response text is not a JSON
 // string, a more generic ErrorResponse error is returned.
+//
+// If server returns 304 Not Modified, ErrNotModified is returned.
 func (c *Client) Do(r *Request, respV interface{}, errV interface{}) (*http.Response, error) {
 	req, err := c.makeRequest(r)
 	if err != nil {
@@ -57,20 +145,56 @@ func (c *Client) Do(r *Request, respV interface{}, errV interface{}) (*http.Resp
 		client = http.DefaultClient
 	}
 
-	resp, err := client.Do(req)
-	if err != nil {
-		return resp, err
+	_, stream := respV.(io.Writer)
+	cacheable := c.cache != nil && !r.noCache && !stream && req.Method == http.MethodGet
+
+	var resp *http.Response
+	if cacheable {
+		resp = c.cache.get(req)
+	}
+	if resp == nil {
+		resp, err = client.Do(req)
+		if err != nil {
+			return resp, err
+		}
+		req, resp, err = c.retryUnauthorized(client, req, resp)
+		if err != nil {
+			return resp, err
+		}
+		// the response is cached under the request sent, i.e. with the
+		// refreshed token if retried
+		if cacheable {
+			if err := c.cache.put(req, resp, c.MaxResponseBytes); err != nil {
+				resp.Body.Close()
+				return resp, fmt.Errorf("Error reading response: %v", err)
+			}
+		}
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode == http.StatusNotModified {
+		return resp, ErrNotModified
+	}
+
+	if !c.DisableDecompression && hasBody(resp) {
+		if err := decompress(resp); err != nil {
+			return resp, fmt.Errorf("Error reading response: %v", err)
+		}
+	}
+
+	body := c.limitReader(resp.Body)
+
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		raw, err := ioutil.ReadAll(resp.Body)
+		raw, err := ioutil.ReadAll(body)
+		if err == ErrResponseBodyTooLarge {
+			return resp, err
+		}
 		if err != nil {
 			return resp, fmt.Errorf("Error reading response: %v", err)
 		}
 
 		if len(raw) > 0 && errV != nil {
-			if json.Unmarshal(raw, errV) == nil {
+			if c.decoder(r, bytes.NewReader(raw)).Decode(errV) == nil {
 				return resp, nil
 			}
 		}
@@ -83,7 +207,7 @@ func (c *Client) Do(r *Request, respV interface{}, errV interface{}) (*http.Resp
 		case io.Writer:
 			_, err = io.Copy(respV.(io.Writer), resp.Body)
 		default:
-			err = json.NewDecoder(resp.Body).Decode(respV)
+			err = c.decoder(r, body).Decode(respV)
 			if err == io.EOF {
 				err = ErrEmptyResponseBody
 			}
@@ -93,6 +217,141 @@ func (c *Client) Do(r *Request, respV interface{}, errV interface{}) (*http.Resp
 	return resp, err
 }
 
+// retryUnauthorized resends the request with a refreshed token if server
+// responds 401 and a token refresher is set. The request is signed again if
+// a signer is set. The request is retried at most
+// once and only if its body can be replayed. Otherwise, or if the token can't
+// be refreshed, the original request and response are returned. If the
+// retried request can't be signed, the original response is closed and the
+// error is returned.
+func (c *Client) retryUnauthorized(client *http.Client, req *http.Request, resp *http.Response) (*http.Request, *http.Response, error) {
+	if c.TokenRefresher == nil || resp.StatusCode != http.StatusUnauthorized {
+		return req, resp, nil
+	}
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return req, resp, nil
+	}
+
+	token, err := c.TokenRefresher()
+	if err != nil || token == "" {
+		return req, resp, nil
+	}
+
+	retry := req.Clone(req.Context())
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return req, resp, nil
+		}
+		retry.Body = body
+	}
+	retry.Header.Set("Authorization", token)
+
+	// the signature may depend on the token, a timestamp or a nonce
+	var signErr error
+	if c.Signer != nil {
+		signErr = c.Signer.Sign(retry)
+	}
+
+	io.Copy(ioutil.Discard, resp.Body)
+	resp.Body.Close()
+
+	if signErr != nil {
+		return req, nil, signErr
+	}
+
+	resp, err = client.Do(retry)
+	return retry, resp, err
+}
+
+// hasBody returns whether the response may have a body. Responses to HEAD
+// requests, 204 No Content and 304 Not Modified responses and responses with
+// Content-Length 0 have none, even if Content-Encoding is set.
+func hasBody(resp *http.Response) bool {
+	if resp.Request != nil && resp.Request.Method == http.MethodHead {
+		return false
+	}
+	switch resp.StatusCode {
+	case http.StatusNoContent, http.StatusNotModified:
+		return false
+	}
+	return resp.ContentLength != 0
+}
+
+// decompress replaces the response body with a reader decompressing the
+// body according to the Content-Encoding header. Content-Encoding and
+// Content-Length headers are removed since they don't apply to the
+// decompressed body.
+func decompress(resp *http.Response) error {
+	var reader io.Reader
+	var err error
+
+	switch strings.ToLower(resp.Header.Get("Content-Encoding")) {
+	case "gzip":
+		reader, err = gzip.NewReader(resp.Body)
+	case "deflate":
+		reader, err = zlib.NewReader(resp.Body)
+	default:
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	resp.Body = &decompressedBody{Reader: reader, body: resp.Body}
+	resp.Header.Del("Content-Encoding")
+	resp.Header.Del("Content-Length")
+	resp.ContentLength = -1
+	resp.Uncompressed = true
+	return nil
+}
+
+// decompressedBody reads from Reader and closes the original response body
+type decompressedBody struct {
+	io.Reader
+	body io.ReadCloser
+}
+
+func (b *decompressedBody) Close() error {
+	return b.body.Close()
+}
+
+func (c *Client) limitReader(r io.Reader) io.Reader {
+	if c.MaxResponseBytes <= 0 {
+		return r
+	}
+	return &maxBytesReader{r: r, n: c.MaxResponseBytes}
+}
+
+// maxBytesReader reads from r and returns ErrResponseBodyTooLarge if more
+// than n bytes are available.
+type maxBytesReader struct {
+	r io.Reader
+	n int64 // bytes remaining
+}
+
+func (m *maxBytesReader) Read(p []byte) (int, error) {
+	if int64(len(p)) > m.n+1 {
+		p = p[:m.n+1]
+	}
+	n, err := m.r.Read(p)
+	if int64(n) > m.n {
+		n = int(m.n)
+		m.n = 0
+		return n, ErrResponseBodyTooLarge
+	}
+	m.n -= int64(n)
+	return n, err
+}
+
+func (c *Client) decoder(r *Request, reader io.Reader) *json.Decoder {
+	d := json.NewDecoder(reader)
+	if c.UseNumber || (r != nil && r.useNumber) {
+		d.UseNumber()
+	}
+	return d
+}
+
 func (c *Client) makeRequest(r *Request) (*http.Request, error) {
 	req, err := r.Build()
 	if err != nil {
@@ -108,6 +367,12 @@ func (c *Client) makeRequest(r *Request) (*http.Request, error) {
 		req.Header.Set("Content-Type", "application/json")
 	}
 
+	if c.Signer != nil {
+		if err := c.Signer.Sign(req); err != nil {
+			return nil, err
+		}
+	}
+
 	return req, nil
 }
 
@@ -1,13 +1,22 @@
 package rest
 
 import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/common/wait"
 )
 
 var noContentHandler = func(w http.ResponseWriter, r *http.Request) {
@@ -45,6 +54,328 @@ func TestDo_WithoutSuccessV(t *testing.T) {
 	assert.Equal(http.StatusOK, resp.StatusCode)
 }
 
+func TestDo_UseNumber(t *testing.T) {
+	assert := assert.New(t)
+
+	ts := httptest.NewServer(serveHandler(200, "{\"id\": 9007199254740993}"))
+	defer ts.Close()
+
+	var res map[string]interface{}
+	_, err := NewClient().Do(GetRequest(ts.URL).UseNumber(), &res, nil)
+	assert.NoError(err)
+	assert.Equal(json.Number("9007199254740993"), res["id"])
+
+	client := NewClient()
+	client.UseNumber = true
+	res = nil
+	_, err = client.Do(GetRequest(ts.URL), &res, nil)
+	assert.NoError(err)
+	assert.Equal(json.Number("9007199254740993"), res["id"])
+
+	res = nil
+	_, err = NewClient().Do(GetRequest(ts.URL), &res, nil)
+	assert.NoError(err)
+	assert.IsType(float64(0), res["id"])
+}
+
+func TestDo_MaxResponseBytes(t *testing.T) {
+	assert := assert.New(t)
+
+	ts := httptest.NewServer(serveHandler(200, "{\"foo\": \"bar\"}"))
+	defer ts.Close()
+
+	var res map[string]string
+	_, err := NewClient().WithMaxResponseBytes(16).Do(GetRequest(ts.URL), &res, nil)
+	assert.NoError(err)
+	assert.Equal("bar", res["foo"])
+
+	res = nil
+	_, err = NewClient().WithMaxResponseBytes(10).Do(GetRequest(ts.URL), &res, nil)
+	assert.Equal(ErrResponseBodyTooLarge, err)
+
+	errTs := httptest.NewServer(serveHandler(500, "Internal server error."))
+	defer errTs.Close()
+
+	_, err = NewClient().WithMaxResponseBytes(10).Do(GetRequest(errTs.URL), nil, nil)
+	assert.Equal(ErrResponseBodyTooLarge, err)
+}
+
+func TestDo_Decompression(t *testing.T) {
+	assert := assert.New(t)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Encoding", "gzip")
+		gw := gzip.NewWriter(w)
+		fmt.Fprint(gw, "{\"foo\": \"bar\"}")
+		gw.Close()
+	}))
+	defer ts.Close()
+
+	var res map[string]string
+	resp, err := NewClient().Do(GetRequest(ts.URL).Set("Accept-Encoding", "gzip"), &res, nil)
+	assert.NoError(err)
+	assert.Equal("bar", res["foo"])
+	assert.Equal("", resp.Header.Get("Content-Encoding"))
+	assert.Equal(int64(-1), resp.ContentLength)
+
+	client := NewClient()
+	client.DisableDecompression = true
+	var buf bytes.Buffer
+	_, err = client.Do(GetRequest(ts.URL).Set("Accept-Encoding", "gzip"), &buf, nil)
+	assert.NoError(err)
+	gr, err := gzip.NewReader(&buf)
+	assert.NoError(err)
+	raw, _ := ioutil.ReadAll(gr)
+	assert.Equal("{\"foo\": \"bar\"}", string(raw))
+}
+
+func TestDo_Decompression_NoBody(t *testing.T) {
+	assert := assert.New(t)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Encoding", "gzip")
+		switch r.URL.Path {
+		case "/no-content":
+			w.WriteHeader(http.StatusNoContent)
+		case "/not-modified":
+			w.WriteHeader(http.StatusNotModified)
+		case "/empty":
+			w.Header().Set("Content-Length", "0")
+		}
+	}))
+	defer ts.Close()
+
+	client := NewClient()
+
+	_, err := client.Do(GetRequest(ts.URL+"/no-content"), nil, nil)
+	assert.NoError(err)
+
+	_, err = client.Do(GetRequest(ts.URL+"/not-modified"), nil, nil)
+	assert.Equal(ErrNotModified, err)
+
+	_, err = client.Do(GetRequest(ts.URL+"/empty"), nil, nil)
+	assert.NoError(err)
+
+	_, err = client.Do(HeadRequest(ts.URL), nil, nil)
+	assert.NoError(err)
+}
+
+func TestDo_Signer(t *testing.T) {
+	assert := assert.New(t)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal("HMAC signature", r.Header.Get("Authorization"))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	signer := SignerFunc(func(req *http.Request) error {
+		req.Header.Set("Authorization", "HMAC signature")
+		return nil
+	})
+	_, err := NewClient().WithSigner(signer).Do(GetRequest(ts.URL), nil, nil)
+	assert.NoError(err)
+
+	signErr := fmt.Errorf("sign failed")
+	_, err = NewClient().WithSigner(SignerFunc(func(*http.Request) error { return signErr })).Do(GetRequest(ts.URL), nil, nil)
+	assert.Equal(signErr, err)
+}
+
+func TestDo_TokenRefresher(t *testing.T) {
+	assert := assert.New(t)
+
+	var requests int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		body, _ := ioutil.ReadAll(r.Body)
+		assert.Equal(`{"name":"test"}`, string(body))
+
+		if r.Header.Get("Authorization") != "Bearer new" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		fmt.Fprint(w, `{"id":"1"}`)
+	}))
+	defer ts.Close()
+
+	var refreshes int
+	client := NewClient().WithTokenRefresher(func() (string, error) {
+		refreshes++
+		return "Bearer new", nil
+	})
+
+	var res map[string]string
+	_, err := client.Do(PostRequest(ts.URL).Set("Authorization", "Bearer old").Body(map[string]string{"name": "test"}), &res, nil)
+	assert.NoError(err)
+	assert.Equal("1", res["id"])
+	assert.Equal(2, requests)
+	assert.Equal(1, refreshes)
+}
+
+func TestDo_TokenRefresher_RetryOnce(t *testing.T) {
+	assert := assert.New(t)
+
+	var requests int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer ts.Close()
+
+	client := NewClient().WithTokenRefresher(func() (string, error) {
+		return "Bearer new", nil
+	})
+	resp, err := client.Do(GetRequest(ts.URL), nil, nil)
+	assert.Error(err)
+	assert.Equal(http.StatusUnauthorized, resp.StatusCode)
+	assert.Equal(2, requests)
+
+	requests = 0
+	_, err = NewClient().Do(GetRequest(ts.URL), nil, nil)
+	assert.Error(err)
+	assert.Equal(1, requests)
+}
+
+func TestDo_TokenRefresher_Signer(t *testing.T) {
+	assert := assert.New(t)
+
+	var nonces []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		nonces = append(nonces, r.Header.Get("X-Nonce"))
+		if r.Header.Get("Authorization") != "Bearer new" {
+			w.WriteHeader(http.StatusUnauthorized)
+		}
+	}))
+	defer ts.Close()
+
+	var signs int
+	client := NewClient().WithSigner(SignerFunc(func(req *http.Request) error {
+		signs++
+		req.Header.Set("X-Nonce", fmt.Sprint(signs))
+		return nil
+	})).WithTokenRefresher(func() (string, error) {
+		return "Bearer new", nil
+	})
+
+	_, err := client.Do(GetRequest(ts.URL), nil, nil)
+	assert.NoError(err)
+	assert.Equal([]string{"1", "2"}, nonces)
+}
+
+func TestDo_TokenRefresher_SignError(t *testing.T) {
+	assert := assert.New(t)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer ts.Close()
+
+	signErr := errors.New("sign failed")
+	var signs int
+	client := NewClient().WithSigner(SignerFunc(func(req *http.Request) error {
+		signs++
+		if signs > 1 {
+			return signErr
+		}
+		return nil
+	})).WithTokenRefresher(func() (string, error) {
+		return "Bearer new", nil
+	})
+
+	resp, err := client.Do(GetRequest(ts.URL), nil, nil)
+	assert.Equal(signErr, err)
+	assert.Nil(resp)
+}
+
+func TestDo_TokenRefresher_Cache(t *testing.T) {
+	assert := assert.New(t)
+
+	var requests int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.Header.Get("Authorization") != "Bearer new" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		fmt.Fprint(w, `{"id":"1"}`)
+	}))
+	defer ts.Close()
+
+	client := NewClient().WithResponseCache(time.Minute).WithTokenRefresher(func() (string, error) {
+		return "Bearer new", nil
+	})
+
+	var res map[string]string
+	_, err := client.Do(GetRequest(ts.URL).Set("Authorization", "Bearer old"), &res, nil)
+	assert.NoError(err)
+	assert.Equal(2, requests)
+
+	// cached under the refreshed token, not the stale one
+	_, err = client.Do(GetRequest(ts.URL).Set("Authorization", "Bearer new"), &res, nil)
+	assert.NoError(err)
+	assert.Equal("1", res["id"])
+	assert.Equal(2, requests)
+}
+
+func TestDo_StreamBody(t *testing.T) {
+	assert := assert.New(t)
+
+	var requests int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		body, _ := ioutil.ReadAll(r.Body)
+		assert.Equal("abcde", string(body))
+		assert.Equal([]string{"chunked"}, r.TransferEncoding)
+		assert.Empty(r.Header.Get("Content-Length"))
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer ts.Close()
+
+	var refreshes int
+	client := NewClient().WithTokenRefresher(func() (string, error) {
+		refreshes++
+		return "Bearer new", nil
+	})
+
+	pr, pw := io.Pipe()
+	go func() {
+		pw.Write([]byte("abc"))
+		pw.Write([]byte("de"))
+		pw.Close()
+	}()
+
+	resp, err := client.Do(PutRequest(ts.URL).StreamBody(pr), nil, nil)
+	assert.Error(err)
+	assert.Equal(http.StatusUnauthorized, resp.StatusCode)
+	assert.Equal(1, requests)
+	assert.Equal(0, refreshes)
+}
+
+func TestDo_NotModified(t *testing.T) {
+	assert := assert.New(t)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") == "\"v1\"" {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", "\"v1\"")
+		fmt.Fprint(w, "{\"foo\": \"bar\"}")
+	}))
+	defer ts.Close()
+
+	var res map[string]string
+	resp, err := NewClient().Do(GetRequest(ts.URL), &res, nil)
+	assert.NoError(err)
+	assert.Equal("bar", res["foo"])
+
+	var res2 map[string]string
+	resp, err = NewClient().Do(GetRequest(ts.URL).IfNoneMatch(resp.Header.Get("ETag")), &res2, nil)
+	assert.Equal(ErrNotModified, err)
+	assert.Equal(http.StatusNotModified, resp.StatusCode)
+	assert.Nil(res2)
+}
+
 func TestDo_ServerError_WithoutErrorV(t *testing.T) {
 	assert := assert.New(t)
 
@@ -111,3 +442,44 @@ func TestDownloadFile(t *testing.T) {
 	bytes, _ := ioutil.ReadFile(f.Name())
 	assert.Equal("abcedefg", string(bytes))
 }
+
+func TestWaitForOperation(t *testing.T) {
+	assert := assert.New(t)
+
+	oldBackoff := newOperationBackoff
+	newOperationBackoff = func() *wait.Backoff { return wait.NewBackoff(time.Millisecond, time.Millisecond, 1) }
+	defer func() { newOperationBackoff = oldBackoff }()
+
+	polls := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal("Bearer token", r.Header.Get("Authorization"))
+		switch r.URL.Path {
+		case "/resources":
+			w.Header().Set("Location", "/operations/1")
+			w.WriteHeader(http.StatusAccepted)
+		case "/operations/1":
+			polls++
+			if polls < 3 {
+				w.WriteHeader(http.StatusAccepted)
+				fmt.Fprint(w, "{\"state\": \"in progress\"}")
+				return
+			}
+			fmt.Fprint(w, "{\"state\": \"done\"}")
+		}
+	}))
+	defer ts.Close()
+
+	client := NewClient()
+	accepted, err := client.Do(PostRequest(ts.URL+"/resources").Set("Authorization", "Bearer token"), nil, nil)
+	assert.NoError(err)
+	assert.Equal(http.StatusAccepted, accepted.StatusCode)
+
+	progress := 0
+	var res map[string]string
+	resp, err := client.WaitForOperation(context.Background(), accepted, &res, func(*http.Response) { progress++ })
+	assert.NoError(err)
+	assert.Equal(http.StatusOK, resp.StatusCode)
+	assert.Equal("done", res["state"])
+	assert.Equal(3, polls)
+	assert.Equal(2, progress)
+}
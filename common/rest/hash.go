@@ -0,0 +1,150 @@
+package rest
+
+import (
+	"bytes"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"hash"
+	"io"
+	"io/ioutil"
+	"net/http"
+)
+
+// Headers set by Request.HashBody
+const (
+	ContentMD5Header    = "Content-MD5"      // base64 encoded MD5 of the body, see RFC 1864
+	ContentSHA256Header = "X-Content-SHA256" // hex encoded SHA256 of the body
+)
+
+// ContentHash is the MD5 and SHA256 hashes of a request body, computed while
+// the body is sent. The zero value is ready to use, see Request.HashBody.
+type ContentHash struct {
+	md5    hash.Hash
+	sha256 hash.Hash
+	done   bool
+}
+
+// MD5 returns the MD5 hash of the body, or nil if the body has not been
+// sent completely.
+func (h *ContentHash) MD5() []byte {
+	if !h.done {
+		return nil
+	}
+	return h.md5.Sum(nil)
+}
+
+// SHA256 returns the SHA256 hash of the body, or nil if the body has not
+// been sent completely.
+func (h *ContentHash) SHA256() []byte {
+	if !h.done {
+		return nil
+	}
+	return h.sha256.Sum(nil)
+}
+
+// ContentMD5 returns the MD5 hash in the format of Content-MD5 header.
+func (h *ContentHash) ContentMD5() string {
+	if !h.done {
+		return ""
+	}
+	return base64.StdEncoding.EncodeToString(h.MD5())
+}
+
+// ContentSHA256 returns the hex encoded SHA256 hash.
+func (h *ContentHash) ContentSHA256() string {
+	if !h.done {
+		return ""
+	}
+	return hex.EncodeToString(h.SHA256())
+}
+
+func (h *ContentHash) reset() {
+	h.md5 = md5.New()
+	h.sha256 = sha256.New()
+	h.done = false
+}
+
+func (h *ContentHash) write(p []byte) {
+	h.md5.Write(p)
+	h.sha256.Write(p)
+}
+
+func (h *ContentHash) setHeader(header http.Header) {
+	header.Set(ContentMD5Header, h.ContentMD5())
+	header.Set(ContentSHA256Header, h.ContentSHA256())
+}
+
+// hashBody hashes the body of req. A body streamed with StreamBody is
+// hashed as it is read by the transport and the hashes are sent as trailers
+// of the chunked request. Otherwise the body is hashed up front and the
+// hashes are sent as headers: a body that implements io.Seeker, e.g. a file,
+// is read and then rewound, along with its length; any other body is read
+// into memory. The returned length is -1 if it is unknown.
+func (r *Request) hashBody(body io.Reader) (io.Reader, int64, http.Header, error) {
+	r.hash.reset()
+
+	if r.chunked {
+		trailer := http.Header{ContentMD5Header: nil, ContentSHA256Header: nil}
+		return &hashingReader{r: body, hash: r.hash, trailer: trailer}, -1, trailer, nil
+	}
+
+	if seeker, ok := body.(io.ReadSeeker); ok {
+		start, err := seeker.Seek(0, io.SeekCurrent)
+		if err != nil {
+			return nil, -1, nil, err
+		}
+		n, err := io.Copy(writerFunc(r.hash.write), seeker)
+		if err != nil {
+			return nil, -1, nil, err
+		}
+		if _, err := seeker.Seek(start, io.SeekStart); err != nil {
+			return nil, -1, nil, err
+		}
+		r.hash.done = true
+		r.hash.setHeader(r.header)
+		return seeker, n, nil, nil
+	}
+
+	raw, err := ioutil.ReadAll(body)
+	if err != nil {
+		return nil, -1, nil, err
+	}
+	r.hash.write(raw)
+	r.hash.done = true
+	r.hash.setHeader(r.header)
+	return bytes.NewReader(raw), int64(len(raw)), nil, nil
+}
+
+type writerFunc func(p []byte)
+
+func (f writerFunc) Write(p []byte) (int, error) {
+	f(p)
+	return len(p), nil
+}
+
+// hashingReader hashes the data read from r and sets the trailer once r is
+// drained.
+type hashingReader struct {
+	r       io.Reader
+	hash    *ContentHash
+	trailer http.Header
+}
+
+func (h *hashingReader) Read(p []byte) (int, error) {
+	n, err := h.r.Read(p)
+	h.hash.write(p[:n])
+	if err == io.EOF && !h.hash.done {
+		h.hash.done = true
+		h.hash.setHeader(h.trailer)
+	}
+	return n, err
+}
+
+func (h *hashingReader) Close() error {
+	if c, ok := h.r.(io.Closer); ok {
+		return c.Close()
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+package rest
+
+import (
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHashBody(t *testing.T) {
+	assert := assert.New(t)
+
+	md5Sum := md5.Sum([]byte("abcde"))
+	sha256Sum := sha256.Sum256([]byte("abcde"))
+	expectedMD5 := base64.StdEncoding.EncodeToString(md5Sum[:])
+	expectedSHA256 := hex.EncodeToString(sha256Sum[:])
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		assert.Equal("abcde", string(body))
+
+		if r.URL.Path == "/stream" {
+			assert.Equal([]string{"chunked"}, r.TransferEncoding)
+			assert.Equal(expectedMD5, r.Trailer.Get(ContentMD5Header))
+			assert.Equal(expectedSHA256, r.Trailer.Get(ContentSHA256Header))
+		} else {
+			assert.Equal(int64(5), r.ContentLength)
+			assert.Equal(expectedMD5, r.Header.Get(ContentMD5Header))
+			assert.Equal(expectedSHA256, r.Header.Get(ContentSHA256Header))
+		}
+	}))
+	defer ts.Close()
+
+	var h ContentHash
+	_, err := NewClient().Do(PutRequest(ts.URL+"/memory").Body("abcde").HashBody(&h), nil, nil)
+	assert.NoError(err)
+	assert.Equal(expectedMD5, h.ContentMD5())
+	assert.Equal(expectedSHA256, h.ContentSHA256())
+	assert.Equal(md5Sum[:], h.MD5())
+	assert.Equal(sha256Sum[:], h.SHA256())
+
+	h = ContentHash{}
+	_, err = NewClient().Do(PutRequest(ts.URL+"/stream").StreamBody(strings.NewReader("abcde")).HashBody(&h), nil, nil)
+	assert.NoError(err)
+	assert.Equal(expectedMD5, h.ContentMD5())
+	assert.Equal(expectedSHA256, h.ContentSHA256())
+}
+
+func TestHashBody_Reader(t *testing.T) {
+	assert := assert.New(t)
+
+	md5Sum := md5.Sum([]byte("abcde"))
+	expectedMD5 := base64.StdEncoding.EncodeToString(md5Sum[:])
+
+	f, err := ioutil.TempFile("", "hash")
+	assert.NoError(err)
+	defer os.Remove(f.Name())
+	defer f.Close()
+	_, err = f.WriteString("abcde")
+	assert.NoError(err)
+	_, err = f.Seek(0, io.SeekStart)
+	assert.NoError(err)
+
+	var h ContentHash
+	req, err := PutRequest("http://www.example.com").Body(f).HashBody(&h).Build()
+	assert.NoError(err)
+	assert.Nil(req.Trailer)
+	assert.Equal(int64(5), req.ContentLength)
+	assert.Equal(expectedMD5, req.Header.Get(ContentMD5Header))
+	body, _ := ioutil.ReadAll(req.Body)
+	assert.Equal("abcde", string(body))
+
+	h = ContentHash{}
+	req, err = PutRequest("http://www.example.com").Body(ioutil.NopCloser(strings.NewReader("abcde"))).HashBody(&h).Build()
+	assert.NoError(err)
+	assert.Equal(int64(5), req.ContentLength)
+	assert.Equal(expectedMD5, h.ContentMD5())
+}
+
+func TestHashBody_NotSent(t *testing.T) {
+	assert := assert.New(t)
+
+	var h ContentHash
+	req, err := PutRequest("http://www.example.com").StreamBody(strings.NewReader("abcde")).HashBody(&h).Build()
+	assert.NoError(err)
+	assert.NotNil(req.Trailer)
+	assert.Nil(h.MD5())
+	assert.Empty(h.ContentMD5())
+}
@@ -0,0 +1,86 @@
+package rest
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/common/wait"
+)
+
+// ErrNoOperationLocation means the accepted response doesn't have a Location
+// header to poll the status of the operation.
+var ErrNoOperationLocation = errors.New("no Location header in accepted response")
+
+// newOperationBackoff returns the backoff between polls of an operation
+var newOperationBackoff = func() *wait.Backoff {
+	return wait.NewBackoff(time.Second, 30*time.Second, 2)
+}
+
+// WaitForOperation waits for an asynchronous operation to complete. Given a
+// 202 Accepted response, it polls the URL in its Location header with
+// increasing intervals as long as server returns 202 Accepted. When server
+// returns another successful response, the response is decoded into respV as
+// in Do and returned.
+//
+// If onProgress is not nil, it is called with every 202 Accepted response
+// while the operation is in progress. The response body is already closed.
+//
+// The headers of the original request, e.g. Authorization, are sent in the
+// polling requests. It stops when ctx is cancelled.
+func (c *Client) WaitForOperation(ctx context.Context, accepted *http.Response, respV interface{}, onProgress func(*http.Response)) (*http.Response, error) {
+	location, err := accepted.Location()
+	if err != nil {
+		return nil, ErrNoOperationLocation
+	}
+
+	var resp *http.Response
+	var body bytes.Buffer
+	err = newOperationBackoff().Retry(ctx, func() (bool, error) {
+		req := GetRequest(location.String())
+		if accepted.Request != nil {
+			for k, vs := range accepted.Request.Header {
+				if k == "Content-Type" || k == "Content-Length" {
+					continue
+				}
+				for _, v := range vs {
+					req.Add(k, v)
+				}
+			}
+		}
+
+		body.Reset()
+		var err error
+		resp, err = c.Do(req, &body, nil)
+		if err != nil {
+			return false, err
+		}
+
+		if resp.StatusCode == http.StatusAccepted {
+			if onProgress != nil {
+				onProgress(resp)
+			}
+			return false, nil
+		}
+		return true, nil
+	})
+	if err != nil {
+		return resp, err
+	}
+
+	if respV != nil {
+		switch respV.(type) {
+		case io.Writer:
+			_, err = io.Copy(respV.(io.Writer), &body)
+		default:
+			err = c.decoder(nil, &body).Decode(respV)
+			if err == io.EOF {
+				err = ErrEmptyResponseBody
+			}
+		}
+	}
+	return resp, err
+}
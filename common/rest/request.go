@@ -82,6 +82,18 @@ type Request struct {
 
 	// custom request body
 	body interface{}
+
+	// send the body with chunked transfer encoding
+	chunked bool
+
+	// hashes of the body computed while it is sent
+	hash *ContentHash
+
+	// decode JSON numbers in response as json.Number
+	useNumber bool
+
+	// bypass the response cache of the client
+	noCache bool
 }
 
 // NewRequest creates a new request with a given rawUrl.
@@ -152,6 +164,28 @@ func (r *Request) Set(key string, value string) *Request {
 	return r
 }
 
+// UseNumber makes the client decode JSON numbers in the response into an
+// interface{} as json.Number instead of float64, so that large numeric IDs do
+// not lose precision.
+func (r *Request) UseNumber() *Request {
+	r.useNumber = true
+	return r
+}
+
+// NoCache makes the client send the request to server even if the response
+// is cached, see Client.WithResponseCache. The response is not cached either.
+func (r *Request) NoCache() *Request {
+	r.noCache = true
+	return r
+}
+
+// IfNoneMatch sets the If-None-Match header with the given ETag to make a
+// conditional request. Client returns ErrNotModified if the resource is not
+// modified.
+func (r *Request) IfNoneMatch(etag string) *Request {
+	return r.Set("If-None-Match", etag)
+}
+
 // Query appends the key, value pair to the request query which will be
 // encoded as url query parameters on HTTP request's url.
 func (r *Request) Query(key string, value string) *Request {
@@ -180,6 +214,36 @@ func (r *Request) Body(body interface{}) *Request {
 	return r
 }
 
+// StreamBody sets the request body to be read from reader whose length is
+// unknown, e.g. a pipe. The body is sent with chunked transfer encoding and
+// Content-Length header is not set. Since the body can't be replayed, the
+// request is not retried when server responds 401, see
+// Client.TokenRefresher.
+func (r *Request) StreamBody(reader io.Reader) *Request {
+	r.body = reader
+	r.chunked = true
+	return r
+}
+
+// HashBody computes the MD5 and SHA256 hashes of the request body while it
+// is sent and sets them in the Content-MD5 and X-Content-SHA256 headers. The
+// hashes are available from h after the request is sent:
+//
+//	var h rest.ContentHash
+//	client.Do(PutRequest(url).StreamBody(f).HashBody(&h), nil, nil)
+//	fmt.Println(h.ContentMD5())
+//
+// A body set with StreamBody is hashed as it streams, without a second pass
+// over the data, so the hashes are sent as trailers of the chunked request
+// instead of headers. Any other body is hashed before the request is sent:
+// an io.ReadSeeker, e.g. a file, is read twice and sent with its
+// Content-Length, other readers are read into memory. The hashes are
+// computed again if the request is rebuilt.
+func (r *Request) HashBody(h *ContentHash) *Request {
+	r.hash = h
+	return r
+}
+
 // Build builds a HTTP request according to the settings in the REST request.
 func (r *Request) Build() (*http.Request, error) {
 	url, err := r.buildURL()
@@ -192,11 +256,31 @@ func (r *Request) Build() (*http.Request, error) {
 		return nil, err
 	}
 
+	var trailer http.Header
+	length := int64(-1)
+	if r.hash != nil && body != nil {
+		body, length, trailer, err = r.hashBody(body)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	req, err := http.NewRequest(r.method, url, body)
 	if err != nil {
 		return req, err
 	}
 
+	if length >= 0 {
+		req.ContentLength = length
+	}
+
+	if r.chunked && body != nil {
+		req.ContentLength = -1
+		req.TransferEncoding = []string{"chunked"}
+		req.GetBody = nil
+		req.Trailer = trailer
+	}
+
 	for k, vs := range r.header {
 		for _, v := range vs {
 			req.Header.Add(k, v)
@@ -25,6 +25,35 @@ func TestRequestQueryParam(t *testing.T) {
 	assert.Contains(req.URL.String(), "bar=bar+Val")
 }
 
+func TestRequestMethods(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		req    *Request
+		method string
+	}{
+		{GetRequest("http://www.example.com"), "GET"},
+		{HeadRequest("http://www.example.com"), "HEAD"},
+		{PostRequest("http://www.example.com"), "POST"},
+		{PutRequest("http://www.example.com"), "PUT"},
+		{DeleteRequest("http://www.example.com"), "DELETE"},
+		{PatchRequest("http://www.example.com"), "PATCH"},
+		{OptionsRequest("http://www.example.com"), "OPTIONS"},
+	}
+
+	for _, test := range tests {
+		req, err := test.req.
+			Set("Accept", "application/json").
+			Query("limit", "50").
+			Build()
+
+		assert.NoError(err)
+		assert.Equal(test.method, req.Method)
+		assert.Equal("application/json", req.Header.Get("Accept"))
+		assert.Equal("50", req.URL.Query().Get("limit"))
+	}
+}
+
 func TestRequestHeader(t *testing.T) {
 	assert := assert.New(t)
 
@@ -128,3 +157,17 @@ func TestRequestJSON(t *testing.T) {
 	assert.NoError(err)
 	assert.Equal("{\"Name\":\"bar\"}", string(body))
 }
+
+func TestRequestStreamBody(t *testing.T) {
+	assert := assert.New(t)
+
+	req, err := PostRequest("http://www.example.com").StreamBody(strings.NewReader("abcde")).Build()
+	assert.NoError(err)
+	assert.Equal(int64(-1), req.ContentLength)
+	assert.Equal([]string{"chunked"}, req.TransferEncoding)
+	assert.Nil(req.GetBody)
+
+	body, err := ioutil.ReadAll(req.Body)
+	assert.NoError(err)
+	assert.Equal("abcde", string(body))
+}
@@ -0,0 +1,78 @@
+// Package wait provides helpers to wait for a condition with backoff.
+package wait
+
+import (
+	"context"
+	"time"
+)
+
+// MinDelay is the minimum delay returned by Backoff, so that a zero initial
+// delay or a factor below 1 can't make Retry spin without pausing.
+const MinDelay = time.Millisecond
+
+// Backoff computes exponentially increasing delays, starting from the
+// initial delay and multiplied by the factor after each attempt, up to the
+// maximum delay. Delays are at least MinDelay. It is not safe for concurrent
+// use.
+type Backoff struct {
+	Initial time.Duration // initial delay
+	Max     time.Duration // maximum delay, 0 means no limit
+	Factor  float64       // multiplier applied to the delay after each attempt
+
+	current time.Duration
+}
+
+// NewBackoff creates a backoff with the given initial delay, maximum delay
+// and factor.
+func NewBackoff(initial, max time.Duration, factor float64) *Backoff {
+	return &Backoff{
+		Initial: initial,
+		Max:     max,
+		Factor:  factor,
+	}
+}
+
+// Next returns the delay before the next attempt.
+func (b *Backoff) Next() time.Duration {
+	if b.current == 0 {
+		b.current = b.Initial
+	} else {
+		b.current = time.Duration(float64(b.current) * b.Factor)
+	}
+
+	if b.Max > 0 && b.current > b.Max {
+		b.current = b.Max
+	}
+	if b.current < MinDelay {
+		b.current = MinDelay
+	}
+	return b.current
+}
+
+// Reset resets the delay to the initial delay.
+func (b *Backoff) Reset() {
+	b.current = 0
+}
+
+// Retry calls fn until it returns done or an error, sleeping with the backoff
+// delay between the calls. It returns the error of fn, or the context error
+// if ctx is cancelled before fn is done.
+func (b *Backoff) Retry(ctx context.Context, fn func() (done bool, err error)) error {
+	for {
+		done, err := fn()
+		if err != nil {
+			return err
+		}
+		if done {
+			return nil
+		}
+
+		timer := time.NewTimer(b.Next())
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package wait
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBackoffNext(t *testing.T) {
+	assert := assert.New(t)
+
+	b := NewBackoff(time.Second, 5*time.Second, 2)
+	assert.Equal(time.Second, b.Next())
+	assert.Equal(2*time.Second, b.Next())
+	assert.Equal(4*time.Second, b.Next())
+	assert.Equal(5*time.Second, b.Next())
+	assert.Equal(5*time.Second, b.Next())
+
+	b.Reset()
+	assert.Equal(time.Second, b.Next())
+}
+
+func TestBackoffNext_MinDelay(t *testing.T) {
+	assert := assert.New(t)
+
+	b := NewBackoff(0, 0, 2)
+	assert.Equal(MinDelay, b.Next())
+	assert.Equal(2*MinDelay, b.Next())
+
+	b = NewBackoff(-time.Second, 0, 1)
+	assert.Equal(MinDelay, b.Next())
+	assert.Equal(MinDelay, b.Next())
+
+	b = NewBackoff(2*MinDelay, 0, 0.1)
+	assert.Equal(2*MinDelay, b.Next())
+	assert.Equal(MinDelay, b.Next())
+}
+
+func TestBackoffRetry(t *testing.T) {
+	assert := assert.New(t)
+
+	attempts := 0
+	err := NewBackoff(time.Millisecond, 0, 2).Retry(context.Background(), func() (bool, error) {
+		attempts++
+		return attempts == 3, nil
+	})
+	assert.NoError(err)
+	assert.Equal(3, attempts)
+}
+
+func TestBackoffRetry_Cancelled(t *testing.T) {
+	assert := assert.New(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	err := NewBackoff(time.Millisecond, 5*time.Millisecond, 2).Retry(ctx, func() (bool, error) {
+		return false, nil
+	})
+	assert.Equal(context.DeadlineExceeded, err)
+}
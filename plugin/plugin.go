@@ -4,8 +4,13 @@
 package plugin
 
 import (
+	"context"
 	"fmt"
+	"io"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/bluemix/models"
 )
@@ -34,6 +39,68 @@ func (v VersionType) String() string {
 	return fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Build)
 }
 
+// Compare compares the version with another one. It returns -1, 0 or 1 if
+// the version is less than, equal to or greater than the other one.
+func (v VersionType) Compare(other VersionType) int {
+	switch {
+	case v.Major != other.Major:
+		return compareInt(v.Major, other.Major)
+	case v.Minor != other.Minor:
+		return compareInt(v.Minor, other.Minor)
+	default:
+		return compareInt(v.Build, other.Build)
+	}
+}
+
+func compareInt(a, b int) int {
+	if a < b {
+		return -1
+	}
+	if a > b {
+		return 1
+	}
+	return 0
+}
+
+// ParseVersion parses a version string in the form of "major.minor.build",
+// for example "1.0.2". A leading "v" is allowed and missing parts are
+// treated as 0.
+func ParseVersion(s string) (VersionType, error) {
+	parts := strings.Split(strings.TrimPrefix(strings.TrimSpace(s), "v"), ".")
+	if len(parts) > 3 {
+		return VersionType{}, fmt.Errorf("invalid version '%s'", s)
+	}
+
+	var nums [3]int
+	for i, p := range parts {
+		n, err := strconv.Atoi(p)
+		if err != nil || n < 0 {
+			return VersionType{}, fmt.Errorf("invalid version '%s'", s)
+		}
+		nums[i] = n
+	}
+	return VersionType{Major: nums[0], Minor: nums[1], Build: nums[2]}, nil
+}
+
+// SortVersions sorts the versions in ascending order.
+func SortVersions(vs []VersionType) {
+	sort.SliceStable(vs, func(i, j int) bool {
+		return vs[i].Compare(vs[j]) < 0
+	})
+}
+
+// MaxVersion returns the newest of the versions, or the zero VersionType if
+// vs is empty.
+func MaxVersion(vs []VersionType) VersionType {
+	var max VersionType
+	for i, v := range vs {
+		if i == 0 || v.Compare(max) > 0 {
+			max = v
+		}
+	}
+	return max
+}
+
 // Namespace represents a category of commands that have similar
 // functionalities. A command under a namespace is run using 'bx [namespace]
 // [command]'.
@@ -89,6 +156,32 @@ type Flag struct {
 	Description string // description of the option
 	HasValue    bool   // whether the option requires a value or not
 	Hidden      bool   // true to hide the option in command help
+
+	// Completion describes the shell completion of the option value, see
+	// CompleteFlagValue. Optional. It is a pointer so that Flag stays
+	// comparable.
+	Completion *FlagCompletion `json:",omitempty"`
+}
+
+// FlagCompletion describes the values offered for shell completion of an
+// option value.
+type FlagCompletion struct {
+	// Candidates is the static list of values, e.g. region names.
+	Candidates []string `json:",omitempty"`
+
+	// Func returns the values that start with partial, e.g. names of existing
+	// resource groups. It takes precedence over Candidates and is only called
+	// when completion is requested.
+	Func func(c PluginContext, partial string) []string `json:"-"`
+}
+
+// SDKVersionSupported returns whether the plugin is built with SDK version
+// min or later. It returns true if min is empty.
+func (m PluginMetadata) SDKVersionSupported(min string) bool {
+	if min == "" {
+		return true
+	}
+	return compareVersion(m.SDKVersion.String(), min) >= 0
 }
 
 // Plugin is an interface for Bluemix CLI plugins.
@@ -122,6 +215,17 @@ type PluginContext interface {
 	// IAMTEndpoint return the endpoint of IAM token service
 	IAMEndpoint() string
 
+	// PasscodeEndpoint returns the URL to get a one-time passcode for login,
+	// e.g. https://iam.cloud.ibm.com/identity/passcode. Private endpoints are
+	// resolved to the public one since the page is only served publicly.
+	// It returns empty if IAM endpoint is not set.
+	PasscodeEndpoint() string
+
+	// LoginURL returns the URL of the console login page, e.g.
+	// https://cloud.ibm.com/login. It returns empty if console endpoint is not
+	// set.
+	LoginURL() string
+
 	// CloudName returns the name of the target cloud
 	CloudName() string
 
@@ -132,15 +236,66 @@ type PluginContext interface {
 	// Region returns the targeted region
 	CurrentRegion() models.Region
 
+	// DefaultRegion returns the default region of the current account, which
+	// the CLI reads from the account metadata on login. It may differ from
+	// the targeted region returned by CurrentRegion. An error is returned if
+	// the default region is unknown, e.g. the user has not logged in.
+	DefaultRegion() (models.Region, error)
+
 	// IAMToken returns the IAM access token
 	IAMToken() string
 
 	// IAMRefreshToken returns the IAM refresh token
 	IAMRefreshToken() string
 
-	// RefreshIAMToken refreshes and returns the IAM access token
+	// RefreshIAMToken refreshes and returns the IAM access token. If IAM
+	// responds with an error, an IAMTokenRefreshError is returned containing
+	// the HTTP status code, headers and body of the response. Concurrent
+	// calls share a single refresh.
 	RefreshIAMToken() (string, error)
 
+	// RefreshTokenExpiresAt returns the expiry time of the IAM refresh
+	// token, after which the user has to log in again, e.g. to warn that the
+	// session expires in a few days. The expiry of the access token is
+	// available from core_config.NewIAMTokenInfo(IAMToken()).ExpiresAt().
+	// An error is returned if the user has not logged in or the expiry is
+	// unknown, e.g. the session was created by an older CLI.
+	RefreshTokenExpiresAt() (time.Time, error)
+
+	// RefreshIAMTokenForAccount returns an IAM access token scoped to the
+	// given account. The token of the current session is not changed.
+	// An authentication.AccountAccessError is returned if the user has no
+	// access to the account.
+	RefreshIAMTokenForAccount(accountID string) (string, error)
+
+	// IAMTokenForChildAccount returns an IAM access token scoped to the child
+	// account of the enterprise, so that enterprise admins can manage the
+	// accounts in the enterprise. It uses the same token exchange as
+	// RefreshIAMTokenForAccount and the token of the current session is not
+	// changed.
+	//
+	// The user must be logged in to the enterprise account and be granted
+	// access to the child account, e.g. the Administrator role on the
+	// Enterprise service or a trusted profile of the child account for the
+	// enterprise. An authentication.EnterpriseAccessError is returned if IAM
+	// denies the access.
+	IAMTokenForChildAccount(accountID string) (string, error)
+
+	// RefreshAllTokens refreshes the IAM token and, if a CloudFoundry
+	// environment is targeted, the UAA token. A TokenRefreshError is returned
+	// if either fails; the other token is still refreshed.
+	RefreshAllTokens() error
+
+	// StartTokenRefresher refreshes the IAM token every interval in the
+	// background until ctx is cancelled or the returned stop function is
+	// called, so that long-running plugins always have a valid token.
+	// Refresh failures are written to the trace log. No refresher is started
+	// if interval is not positive.
+	//
+	//	stop := pluginContext.StartTokenRefresher(ctx, 15*time.Minute)
+	//	defer stop()
+	StartTokenRefresher(ctx context.Context, interval time.Duration) (stop func())
+
 	// UserEmail returns the Email of the logged in user
 	UserEmail() string
 
@@ -153,6 +308,18 @@ type PluginContext interface {
 	// account
 	IMSAccountID() string
 
+	// IMSUsername returns the username of the classic infrastructure (IMS)
+	// user linked to the logged in user. The credentials are read from the OS
+	// keyring, see core_config.IMSKeyringService. It is empty if the CLI
+	// didn't store them, which depends on how the user logged in, or if the
+	// keyring is not available.
+	IMSUsername() string
+
+	// IMSAPIKey returns the classic infrastructure (IMS) API key of the user
+	// returned by IMSUsername, read from the OS keyring. It is empty if not
+	// available. Treat it as a secret and never print or log it.
+	IMSAPIKey() string
+
 	// Account returns the targeted a BSS account
 	CurrentAccount() models.Account
 
@@ -178,7 +345,11 @@ type PluginContext interface {
 	// The value is "true", "false" or path of the trace output file.
 	Trace() string
 
-	// ColorEnabled returns whether terminal displays color or not
+	// ColorEnabled returns whether terminal displays color or not. Flag
+	// --no-color in the command line takes precedence over environment
+	// variable BLUEMIX_COLOR, which takes precedence over the config. If
+	// neither enables color explicitly, a non-empty NO_COLOR environment
+	// variable disables it.
 	ColorEnabled() string
 
 	// IsSSLDisabled returns whether skipping SSL validation or not
@@ -190,6 +361,10 @@ type PluginContext interface {
 	// HTTPTimeout returns a timeout for HTTP Client
 	HTTPTimeout() int
 
+	// HTTPTimeoutDuration returns the timeout for HTTP Client as a duration.
+	// It returns DefaultHTTPTimeout if the timeout is not set.
+	HTTPTimeoutDuration() time.Duration
+
 	// VersionCheckEnabled() returns whether checking for update is performmed
 	VersionCheckEnabled() bool
 
@@ -199,8 +374,150 @@ type PluginContext interface {
 	// CommandNamespace returns the name of the parsed namespace
 	CommandNamespace() string
 
-	// CLIName returns binary name of the Bluemix CLI that is invoking the plugin
+	// CLIName returns binary name of the Bluemix CLI that is invoking the plugin.
+	// It is the authoritative source of IsClassicCLI and IsIBMCloudCLI.
 	CLIName() string
+
+	// IsClassicCLI returns whether the plugin is invoked by the classic
+	// Bluemix CLI, i.e. binary 'bx' or 'bluemix'
+	IsClassicCLI() bool
+
+	// IsIBMCloudCLI returns whether the plugin is invoked by the IBM Cloud CLI,
+	// i.e. binary 'ibmcloud'
+	IsIBMCloudCLI() bool
+
+	// CLIVersion returns version of the Bluemix CLI that is invoking the
+	// plugin, or empty if the version is unknown
+	CLIVersion() string
+
+	// CorrelationID returns the ID of the CLI invocation, which is set by the
+	// CLI in environment variable IBMCLOUD_CORRELATION_ID. If the variable is
+	// not set, an ID is generated once per process. It is sent in the
+	// X-Correlation-ID and X-Request-ID headers by clients created with
+	// NewClientFromContext so that a request can be traced across services.
+	CorrelationID() string
+
+	// CheckForUpdate returns the newer version of the plugin found in the
+	// plugin repositories, or nil if the plugin is up to date or checking for
+	// update is disabled. The result is cached for a day, and so is a failed
+	// check. Updates of the CLI itself are not checked, which the CLI does.
+	CheckForUpdate() (*UpdateInfo, error)
+
+	// ListResourceInstances returns the resource instances in the current
+	// account matching the query. All pages are fetched from resource
+	// controller.
+	ListResourceInstances(q ResourceInstanceQuery) ([]models.ServiceInstance, error)
+
+	// ResolveServiceInstance returns the service instance with the given name
+	// in the targeted resource group, or in the account if no resource group
+	// is targeted. A ServiceInstanceNotFoundError is returned if no instance
+	// is found, an AmbiguousServiceInstanceError if multiple are found.
+	ResolveServiceInstance(name string) (models.ServiceInstance, error)
+
+	// ResolveServiceInstanceInResourceGroup is the same as
+	// ResolveServiceInstance but searches the given resource group. If
+	// resourceGroupID is empty, it searches the account.
+	ResolveServiceInstanceInResourceGroup(name string, resourceGroupID string) (models.ServiceInstance, error)
+
+	// ResolveResourceGroup returns the resource group in the current account
+	// whose ID or name is nameOrID. The ID takes precedence over the name.
+	// A ResourceGroupNotFoundError is returned if no resource group matches,
+	// an AmbiguousResourceGroupError if multiple groups have the name.
+	ResolveResourceGroup(nameOrID string) (models.ResourceGroup, error)
+
+	// AccountRole returns the role of the user in the current account, which
+	// is one of AccountRoleOwner, AccountRoleAdmin or AccountRoleMember. A
+	// user is an admin if granted the Administrator role on all account
+	// management services, directly or via an access group. AccountRoleUnknown and an AccountRoleUnknownError
+	// are returned if the role can't be determined.
+	AccountRole() (string, error)
+
+	// DefaultResourceGroup returns the user's default resource group, which
+	// is used when no resource group is targeted. A zero value is returned
+	// if it is not set.
+	DefaultResourceGroup() models.ResourceGroup
+
+	// SetDefaultResourceGroup resolves the resource group whose ID or name
+	// is nameOrID as ResolveResourceGroup does and saves it as the user's
+	// default resource group in the CLI configuration. Nothing is written if
+	// the group can't be resolved. The default resource group is kept on logout.
+	SetDefaultResourceGroup(nameOrID string) (models.ResourceGroup, error)
+
+	// Reload re-reads the CLI configuration from disk to pick up changes made
+	// by the core CLI while the plugin is running, e.g. re-targeting a region.
+	// The configuration is only reloaded automatically when it is written:
+	// the configuration file is locked while it is reloaded and the change is
+	// saved, so that changes made by other processes are not lost.
+	// Long-running plugins should call it periodically. It is safe to call
+	// concurrently with other methods.
+	Reload() error
+
+	// ExportTarget returns the target of the CLI session, i.e. the region,
+	// account, resource group, and Cloud Foundry API endpoint, org and space,
+	// serialized as a Target, so that scripts can restore it with
+	// ImportTarget later, e.g. in another process, without running login and
+	// target again.
+	ExportTarget() ([]byte, error)
+
+	// ImportTarget restores the target exported by ExportTarget. The
+	// entities of the target are validated before any change is saved: an
+	// InvalidRegionError is returned for an unknown region, an
+	// authentication.AccountAccessError if the account is not accessible, a
+	// ResourceGroupNotFoundError if the resource group doesn't exist and a
+	// TargetNotFoundError if the Cloud Foundry org or space doesn't exist.
+	// The user must be logged in. If the Cloud Foundry API endpoint differs
+	// from the targeted one, it is logged in with the IAM token. Entities not
+	// in the target that belong to a changed one are untargeted, e.g. the
+	// resource group when the account changes.
+	ImportTarget(data []byte) error
+
+	// IsInteractive returns whether the plugin is run interactively so that
+	// the user can be prompted. It returns false if any of the following:
+	//   - the plugin is run by a CI system, see IsCI
+	//   - the plugin is run for shell completion, see IsCompletion
+	//   - stdin or stdout is not a terminal, e.g. input is piped or output is
+	//     redirected
+	//   - command line has flag -q or --quiet
+	//   - command line has flag --output json or --json
+	IsInteractive() bool
+
+	// IsCompletion returns whether the plugin is run to complete a command
+	// line in the shell rather than to run the command. In this case the
+	// plugin should not perform any side effect.
+	//
+	// The CLI requests completion by setting environment variable
+	// BLUEMIX_COMPLETION to "true" and running the plugin with the command
+	// line being completed, whose last argument is the partial word under
+	// the cursor (possibly empty). The plugin writes the candidates for the
+	// partial word to stdout, one per line, and exits with code 0.
+	IsCompletion() bool
+
+	// Stdout returns the writer for the standard output of the plugin, which
+	// is os.Stdout by default. Plugins should write output to it rather than
+	// os.Stdout so that the output can be captured, see WithOutput.
+	Stdout() io.Writer
+
+	// Stderr returns the writer for the error output of the plugin, which is
+	// os.Stderr by default.
+	Stderr() io.Writer
+
+	// Warn writes the formatted warning message prefixed with "WARNING:" to
+	// Stderr, so that it never mixes with the output of the command. The
+	// prefix is colored unless colors are disabled or the command line has
+	// flag --output json or --json. Warnings are written in quiet mode.
+	Warn(format string, args ...interface{})
+
+	// Info writes the formatted informational message prefixed with "INFO:"
+	// to Stderr as Warn does. Nothing is written if the command line has
+	// flag -q or --quiet.
+	Info(format string, args ...interface{})
+
+	// MCCPEndpoint returns the multi-cloud control proxy (MCCP) endpoint of
+	// the targeted CloudFoundry region, which is resolved from the CF API
+	// endpoint, e.g. https://mccp.us-south.cf.cloud.ibm.com for
+	// https://api.us-south.cf.cloud.ibm.com. It returns false if CF is not
+	// targeted or the endpoint can not be resolved.
+	MCCPEndpoint() (string, bool)
 }
 
 // CFContext is a context of the targeted CloudFoundry environment into plugin
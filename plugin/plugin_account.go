@@ -0,0 +1,159 @@
+package plugin
+
+import (
+	"errors"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/common/rest"
+	. "github.com/IBM-Cloud/ibm-cloud-cli-sdk/i18n"
+)
+
+// Roles of the user in the account returned by AccountRole
+const (
+	AccountRoleOwner   = "owner"
+	AccountRoleAdmin   = "admin"
+	AccountRoleMember  = "member"
+	AccountRoleUnknown = "unknown"
+)
+
+// administratorRole is the CRN of the IAM platform role Administrator
+const administratorRole = "crn:v1:bluemix:public:iam::::role:Administrator"
+
+// AccountRoleUnknownError means the role of the user in the account can't be
+// determined, e.g. no account is targeted or the account management API
+// can't be reached.
+type AccountRoleUnknownError struct {
+	Err error
+}
+
+func (e *AccountRoleUnknownError) Error() string {
+	return T("Unable to determine the role in the account: {{.Error}}", map[string]interface{}{"Error": e.Err.Error()})
+}
+
+type accountResponse struct {
+	OwnerIAMID string `json:"owner_iam_id"`
+}
+
+type policiesResponse struct {
+	Policies []struct {
+		Roles []struct {
+			RoleID string `json:"role_id"`
+		} `json:"roles"`
+		Resources []struct {
+			Attributes []struct {
+				Name  string `json:"name"`
+				Value string `json:"value"`
+			} `json:"attributes"`
+		} `json:"resources"`
+	} `json:"policies"`
+}
+
+// isAccountAdmin returns whether any of the policies grants the Administrator
+// role on all account management services.
+func (r policiesResponse) isAccountAdmin() bool {
+	for _, p := range r.Policies {
+		var admin bool
+		for _, role := range p.Roles {
+			if role.RoleID == administratorRole {
+				admin = true
+			}
+		}
+		if !admin {
+			continue
+		}
+
+		for _, res := range p.Resources {
+			for _, attr := range res.Attributes {
+				if attr.Name == "serviceType" && attr.Value == "platform_service" {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+func (c *pluginContext) AccountRole() (string, error) {
+	role, err := c.accountRole()
+	if err != nil {
+		return AccountRoleUnknown, &AccountRoleUnknownError{Err: err}
+	}
+	return role, nil
+}
+
+func (c *pluginContext) accountRole() (string, error) {
+	accountID := c.CurrentAccount().GUID
+	if accountID == "" {
+		return "", errors.New(T("No account targeted"))
+	}
+	iamID := c.IAMID()
+	if iamID == "" {
+		return "", errors.New(T("Not logged in"))
+	}
+
+	// both endpoints are resolved from the same IAM endpoint
+	iam, err := iamConfig(c)
+	if err != nil {
+		return "", err
+	}
+	endpoint, err := accountManagementEndpoint(iam.Endpoint)
+	if err != nil {
+		return "", err
+	}
+
+	client := NewClientFromContext(c)
+
+	var account accountResponse
+	req := rest.GetRequest(endpoint+"/v1/accounts/"+url.PathEscape(accountID)).
+		Set("Authorization", c.IAMToken())
+	if _, err := client.Do(req, &account, nil); err != nil {
+		return "", err
+	}
+	if account.OwnerIAMID == iamID {
+		return AccountRoleOwner, nil
+	}
+
+	// transitive includes the policies of the access groups of the user
+	var policies policiesResponse
+	req = rest.GetRequest(normalizeEndpoint(iam.Endpoint)+"/v1/policies").
+		Query("account_id", accountID).
+		Query("iam_id", iamID).
+		Query("type", "access").
+		Query("transitive", "true").
+		Set("Authorization", c.IAMToken())
+	if _, err := client.Do(req, &policies, nil); err != nil {
+		return "", err
+	}
+	if policies.isAccountAdmin() {
+		return AccountRoleAdmin, nil
+	}
+	return AccountRoleMember, nil
+}
+
+// accountManagementEndpoint returns the account management endpoint resolved
+// from the IAM endpoint, e.g. https://accounts.cloud.ibm.com for
+// https://iam.cloud.ibm.com. It can be overridden by environment variable
+// ACCOUNT_MANAGEMENT_ENDPOINT.
+func accountManagementEndpoint(iamEndpoint string) (string, error) {
+	if endpoint := os.Getenv("ACCOUNT_MANAGEMENT_ENDPOINT"); endpoint != "" {
+		return normalizeEndpoint(endpoint), nil
+	}
+
+	u, err := url.Parse(iamEndpoint)
+	if err != nil || u.Host == "" {
+		return "", errIAMEndpointNotSet()
+	}
+
+	switch {
+	case strings.HasPrefix(u.Host, "iam."):
+		u.Host = "accounts." + strings.TrimPrefix(u.Host, "iam.")
+	case strings.HasPrefix(u.Host, "private.iam."):
+		u.Host = "private.accounts." + strings.TrimPrefix(u.Host, "private.iam.")
+	default:
+		return "", errors.New(T("Unable to resolve account management endpoint from IAM endpoint '{{.Endpoint}}'", map[string]interface{}{"Endpoint": iamEndpoint}))
+	}
+	u.Path = ""
+	return u.String(), nil
+}
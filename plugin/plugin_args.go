@@ -0,0 +1,60 @@
+package plugin
+
+import (
+	"strconv"
+	"strings"
+)
+
+// hasFlag returns whether one of the given boolean flags is set in the
+// command line arguments, e.g. "--quiet" or "--quiet=true".
+func hasFlag(args []string, names ...string) bool {
+	for _, arg := range args {
+		if arg == "--" {
+			break
+		}
+		for _, name := range names {
+			if arg == name {
+				return true
+			}
+			if strings.HasPrefix(arg, name+"=") {
+				b, err := strconv.ParseBool(arg[len(name)+1:])
+				return err == nil && b
+			}
+		}
+	}
+	return false
+}
+
+// flagValue returns the value of the first of the given flags found in the
+// command line arguments. Both "--flag value" and "--flag=value" are
+// supported.
+func flagValue(args []string, names ...string) (string, bool) {
+	for i, arg := range args {
+		if arg == "--" {
+			break
+		}
+		for _, name := range names {
+			if arg == name && i+1 < len(args) {
+				return args[i+1], true
+			}
+			if strings.HasPrefix(arg, name+"=") {
+				return arg[len(name)+1:], true
+			}
+		}
+	}
+	return "", false
+}
+
+// isQuiet returns whether the command line has flag -q or --quiet.
+func isQuiet(args []string) bool {
+	return hasFlag(args, "-q", "--quiet")
+}
+
+// isJSONOutput returns whether the command line has flag --output json or
+// --json.
+func isJSONOutput(args []string) bool {
+	if output, ok := flagValue(args, "--output"); ok && strings.EqualFold(output, "json") {
+		return true
+	}
+	return hasFlag(args, "--json")
+}
@@ -0,0 +1,35 @@
+package plugin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHasFlag(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.True(hasFlag([]string{"list", "-q"}, "-q", "--quiet"))
+	assert.True(hasFlag([]string{"list", "--quiet=true"}, "-q", "--quiet"))
+	assert.False(hasFlag([]string{"list", "--quiet=false"}, "-q", "--quiet"))
+	assert.False(hasFlag([]string{"list", "--", "-q"}, "-q", "--quiet"))
+	assert.False(hasFlag([]string{"list", "--quiet-mode"}, "-q", "--quiet"))
+}
+
+func TestFlagValue(t *testing.T) {
+	assert := assert.New(t)
+
+	v, ok := flagValue([]string{"list", "--output", "json"}, "--output")
+	assert.True(ok)
+	assert.Equal("json", v)
+
+	v, ok = flagValue([]string{"list", "--output=JSON"}, "--output")
+	assert.True(ok)
+	assert.Equal("JSON", v)
+
+	_, ok = flagValue([]string{"list", "--output"}, "--output")
+	assert.False(ok)
+
+	_, ok = flagValue([]string{"list", "--", "--output", "json"}, "--output")
+	assert.False(ok)
+}
@@ -0,0 +1,28 @@
+package plugin
+
+import (
+	"errors"
+	"os"
+
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/bluemix/authentication"
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/bluemix/consts"
+	. "github.com/IBM-Cloud/ibm-cloud-cli-sdk/i18n"
+)
+
+// TokenFromEnvAPIKey authenticates with the API key set in environment
+// variable IBMCLOUD_API_KEY and returns the IAM token. It allows plugins to
+// run headless when the user has not logged in.
+func TokenFromEnvAPIKey(ctx PluginContext) (authentication.Token, error) {
+	apiKey := os.Getenv(consts.ENV_IBMCLOUD_API_KEY)
+	if apiKey == "" {
+		return authentication.Token{}, errors.New(T("Environment variable {{.Name}} is not set", map[string]interface{}{"Name": consts.ENV_IBMCLOUD_API_KEY}))
+	}
+
+	config, err := iamConfig(ctx)
+	if err != nil {
+		return authentication.Token{}, err
+	}
+
+	auth := authentication.NewIAMAuthRepository(config, NewClientFromContext(ctx))
+	return auth.AuthenticateAPIKey(apiKey)
+}
@@ -0,0 +1,58 @@
+package plugin
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/bluemix/authentication"
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/bluemix/consts"
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/testhelpers/configuration"
+)
+
+func TestTokenFromEnvAPIKey(t *testing.T) {
+	assert := assert.New(t)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.NotEmpty(r.Header.Get(CorrelationIDHeader))
+		assert.NoError(r.ParseForm())
+		assert.Equal("urn:ibm:params:oauth:grant-type:apikey", r.PostForm.Get("grant_type"))
+
+		if r.PostForm.Get("apikey") != "valid-key" {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"errorCode": "BXNIM0415E", "errorMessage": "Provided API key could not be found"}`)
+			return
+		}
+		fmt.Fprint(w, `{"access_token": "token", "refresh_token": "refresh", "token_type": "Bearer"}`)
+	}))
+	defer ts.Close()
+
+	os.Setenv("IAM_ENDPOINT", ts.URL)
+	defer os.Unsetenv("IAM_ENDPOINT")
+
+	c := createPluginContext("", configuration.NewFakeCoreConfig())
+
+	os.Unsetenv(consts.ENV_IBMCLOUD_API_KEY)
+	_, err := TokenFromEnvAPIKey(c)
+	assert.EqualError(err, "Environment variable IBMCLOUD_API_KEY is not set")
+
+	os.Setenv(consts.ENV_IBMCLOUD_API_KEY, "valid-key")
+	defer os.Unsetenv(consts.ENV_IBMCLOUD_API_KEY)
+	token, err := TokenFromEnvAPIKey(c)
+	assert.NoError(err)
+	assert.Equal("Bearer token", token.Token())
+	assert.Equal("refresh", token.RefreshToken)
+
+	os.Setenv(consts.ENV_IBMCLOUD_API_KEY, "unknown-key")
+	_, err = TokenFromEnvAPIKey(c)
+	var serverErr *authentication.ServerError
+	if assert.True(errors.As(err, &serverErr)) {
+		assert.Equal(http.StatusBadRequest, serverErr.StatusCode)
+		assert.Equal("BXNIM0415E", serverErr.ErrorCode)
+	}
+}
@@ -0,0 +1,203 @@
+package plugin
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/bluemix/authentication"
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/bluemix/configuration/config_helpers"
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/bluemix/endpoints"
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/bluemix/models"
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/common/rest"
+	. "github.com/IBM-Cloud/ibm-cloud-cli-sdk/i18n"
+)
+
+// cfEndpointsService is the name of the Cloud Foundry API in the endpoints
+// catalog
+const cfEndpointsService = "cloudfoundry"
+
+// NoCFEnvironmentError means no Cloud Foundry environment is configured,
+// i.e. no Cloud Foundry API endpoint is targeted or found in the endpoints
+// catalog.
+type NoCFEnvironmentError struct{}
+
+func (e *NoCFEnvironmentError) Error() string {
+	return T("No Cloud Foundry environment is configured")
+}
+
+// CFRegionOrgs are the Cloud Foundry organizations of the user in a region
+type CFRegionOrgs struct {
+	Region        string
+	APIEndpoint   string
+	Organizations []models.OrganizationFields
+	Err           error // error listing the organizations, nil if succeeded
+}
+
+type cfOrgsResponse struct {
+	NextURL   string `json:"next_url"`
+	Resources []struct {
+		Metadata struct {
+			GUID string `json:"guid"`
+		} `json:"metadata"`
+		Entity struct {
+			Name string `json:"name"`
+		} `json:"entity"`
+	} `json:"resources"`
+}
+
+// ListCFOrgsAcrossRegions lists the Cloud Foundry organizations the user
+// belongs to in each region having Cloud Foundry, not only the targeted one.
+// The Cloud Foundry API endpoints of the regions are read from the endpoints
+// catalog of the CLI, see package endpoints; the targeted Cloud Foundry API
+// endpoint is always included. Regions without Cloud Foundry are skipped.
+//
+// UAA tokens are scoped to a region: the stored UAA token is used for the
+// targeted Cloud Foundry API endpoint, and a token for each other region is
+// obtained from its UAA in exchange of the IAM token.
+//
+// The regions are listed concurrently and sorted by name. An error listing
+// the organizations of a region doesn't fail the others, it is set in Err
+// of the region instead. Regions not listed when ctx is cancelled have the
+// context error. A NoCFEnvironmentError is returned if no Cloud Foundry API
+// endpoint is found or the user is not logged in to Cloud Foundry.
+func ListCFOrgsAcrossRegions(ctx context.Context, c PluginContext) ([]CFRegionOrgs, error) {
+	regions := cfRegionEndpoints(c)
+	if len(regions) == 0 || c.CF().UAAToken() == "" {
+		return nil, &NoCFEnvironmentError{}
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	client := NewClientFromContext(c)
+	client.HTTPClient.Transport = &contextTransport{ctx: ctx, base: client.HTTPClient.Transport}
+
+	targeted := normalizeEndpoint(c.CF().APIEndpoint())
+	tasks := make([]func() error, len(regions))
+	for i := range regions {
+		r := &regions[i]
+		tasks[i] = func() error {
+			token := c.CF().UAAToken()
+			if normalizeEndpoint(r.APIEndpoint) != targeted {
+				token, r.Err = cfRegionToken(client, r.APIEndpoint, c.IAMToken())
+				if r.Err != nil {
+					return r.Err
+				}
+			}
+			r.Organizations, r.Err = listCFOrgs(client, r.APIEndpoint, token)
+			return r.Err
+		}
+	}
+	for i, err := range ParallelDo(ctx, 0, tasks) {
+		if err != nil && regions[i].Err == nil {
+			regions[i].Err = err
+		}
+	}
+
+	return regions, nil
+}
+
+// contextTransport sends the requests with ctx, so that they are aborted
+// when ctx is cancelled
+type contextTransport struct {
+	ctx  context.Context
+	base http.RoundTripper
+}
+
+func (t *contextTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return t.base.RoundTrip(req.WithContext(t.ctx))
+}
+
+// cfRegionToken returns a UAA token of the region having the Cloud Foundry
+// API endpoint in exchange of the IAM token
+func cfRegionToken(client *rest.Client, apiEndpoint string, iamToken string) (string, error) {
+	info, err := getCFInfo(client, apiEndpoint)
+	if err != nil {
+		return "", err
+	}
+
+	token, err := cfUAAToken(client, info, iamToken)
+	if err != nil {
+		return "", err
+	}
+	return token.Token(), nil
+}
+
+// cfInfo is the information of a Cloud Foundry API endpoint
+type cfInfo struct {
+	APIVersion            string `json:"api_version"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	DopplerEndpoint       string `json:"doppler_logging_endpoint"`
+	RoutingEndpoint       string `json:"routing_endpoint"`
+}
+
+func getCFInfo(client *rest.Client, apiEndpoint string) (cfInfo, error) {
+	var info cfInfo
+	if _, err := client.Do(rest.GetRequest(strings.TrimRight(apiEndpoint, "/")+"/v2/info"), &info, nil); err != nil {
+		return cfInfo{}, err
+	}
+	return info, nil
+}
+
+// cfUAAToken exchanges the IAM token for a UAA token of the Cloud Foundry
+// API endpoint
+func cfUAAToken(client *rest.Client, info cfInfo, iamToken string) (authentication.Token, error) {
+	auth := authentication.NewUAARepository(&authentication.UAAConfig{UAAEndpoint: info.AuthorizationEndpoint}, client)
+	return auth.AuthenticateWithIAMToken(strings.TrimPrefix(iamToken, "Bearer "))
+}
+
+// cfRegionEndpoints returns the regions and their Cloud Foundry API
+// endpoints, sorted by region name
+func cfRegionEndpoints(c PluginContext) []CFRegionOrgs {
+	var regions []CFRegionOrgs
+	seen := make(map[string]bool)
+
+	// an unavailable catalog is not an error, the targeted endpoint is used
+	if catalog, err := endpoints.Load(config_helpers.EndpointsFilePath()); err == nil {
+		for region, e := range catalog.Services[cfEndpointsService] {
+			if e.Public == "" {
+				continue
+			}
+			regions = append(regions, CFRegionOrgs{Region: region, APIEndpoint: e.Public})
+			seen[normalizeEndpoint(e.Public)] = true
+		}
+	}
+
+	if c.HasTargetedCF() {
+		endpoint := c.CF().APIEndpoint()
+		if !seen[normalizeEndpoint(endpoint)] {
+			regions = append(regions, CFRegionOrgs{Region: c.CurrentRegion().Name, APIEndpoint: endpoint})
+		}
+	}
+
+	sort.Slice(regions, func(i, j int) bool {
+		return regions[i].Region < regions[j].Region
+	})
+	return regions
+}
+
+// listCFOrgs lists the organizations of the user at the Cloud Foundry API
+// endpoint
+func listCFOrgs(client *rest.Client, apiEndpoint string, token string) ([]models.OrganizationFields, error) {
+	endpoint := strings.TrimRight(apiEndpoint, "/")
+	next := "/v2/organizations?results-per-page=100"
+
+	var orgs []models.OrganizationFields
+	for next != "" {
+		req := rest.GetRequest(endpoint+next).Set("Authorization", token)
+
+		var resp cfOrgsResponse
+		if _, err := client.Do(req, &resp, nil); err != nil {
+			return nil, err
+		}
+
+		for _, r := range resp.Resources {
+			orgs = append(orgs, models.OrganizationFields{GUID: r.Metadata.GUID, Name: r.Entity.Name})
+		}
+		next = resp.NextURL
+	}
+	return orgs, nil
+}
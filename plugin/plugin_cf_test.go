@@ -0,0 +1,178 @@
+package plugin
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/bluemix/configuration/core_config"
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/bluemix/models"
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/testhelpers/configuration"
+	"github.com/stretchr/testify/assert"
+)
+
+func setBluemixHome(t *testing.T, endpointsJSON string) func() {
+	home, err := ioutil.TempDir("", "bluemix_home")
+	assert.NoError(t, err)
+	if endpointsJSON != "" {
+		assert.NoError(t, os.MkdirAll(filepath.Join(home, ".bluemix"), 0700))
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(home, ".bluemix", "endpoints.json"), []byte(endpointsJSON), 0600))
+	}
+
+	old, set := os.LookupEnv("BLUEMIX_HOME")
+	os.Setenv("BLUEMIX_HOME", home)
+	return func() {
+		if set {
+			os.Setenv("BLUEMIX_HOME", old)
+		} else {
+			os.Unsetenv("BLUEMIX_HOME")
+		}
+		os.RemoveAll(home)
+	}
+}
+
+// cfOrgsServer serves the organizations of a Cloud Foundry API which
+// accepts the UAA token. The UAA endpoint is uaaURL.
+func cfOrgsServer(t *testing.T, token string, uaaURL string, orgs ...string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v2/info" {
+			fmt.Fprintf(w, `{"authorization_endpoint": "%s"}`, uaaURL)
+			return
+		}
+
+		assert.Equal(t, "/v2/organizations", r.URL.Path)
+		if r.Header.Get("Authorization") != token {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		page := r.URL.Query().Get("page")
+		if page == "" && len(orgs) > 1 {
+			fmt.Fprintf(w, `{"next_url": "/v2/organizations?page=2", "resources": [{"metadata": {"guid": "%s-guid"}, "entity": {"name": "%s"}}]}`, orgs[0], orgs[0])
+			return
+		}
+		fmt.Fprintf(w, `{"next_url": null, "resources": [{"metadata": {"guid": "%s-guid"}, "entity": {"name": "%s"}}]}`, orgs[len(orgs)-1], orgs[len(orgs)-1])
+	}))
+}
+
+// uaaServer issues the UAA token in exchange of the IAM token
+func uaaServer(t *testing.T, iamToken string, token string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/oauth/token", r.URL.Path)
+		assert.Equal(t, "iam_token", r.FormValue("grant_type"))
+		if r.FormValue("iam_token") != iamToken {
+			w.WriteHeader(http.StatusUnauthorized)
+			fmt.Fprint(w, `{"error": "invalid_token", "error_description": "invalid IAM token"}`)
+			return
+		}
+		fmt.Fprintf(w, `{"access_token": "%s", "token_type": "bearer"}`, token)
+	}))
+}
+
+func newCFTestConfig(apiEndpoint string) core_config.ReadWriter {
+	config := configuration.NewFakeCoreConfig()
+	config.SetRegion(models.Region{ID: "ibm:yp:eu-de", Name: "eu-de"})
+	config.SetIAMToken("Bearer iam-token")
+	config.CFConfig().SetAPIVersion("2.0")
+	config.CFConfig().SetAPIEndpoint(apiEndpoint)
+	config.CFConfig().SetUAAToken("bearer eu-de-token")
+	return config
+}
+
+func TestListCFOrgsAcrossRegions(t *testing.T) {
+	assert := assert.New(t)
+
+	usSouthUAA := uaaServer(t, "iam-token", "us-south-token")
+	defer usSouthUAA.Close()
+	usSouth := cfOrgsServer(t, "bearer us-south-token", usSouthUAA.URL, "org1", "org2")
+	defer usSouth.Close()
+	euDe := cfOrgsServer(t, "bearer eu-de-token", "", "org3")
+	defer euDe.Close()
+	euGbUAA := uaaServer(t, "other-iam-token", "eu-gb-token")
+	defer euGbUAA.Close()
+	euGb := cfOrgsServer(t, "bearer eu-gb-token", euGbUAA.URL, "org4")
+	defer euGb.Close()
+
+	defer setBluemixHome(t, fmt.Sprintf(`{"services": {"cloudfoundry": {
+		"us-south": {"public": "%s"},
+		"eu-gb": {"public": "%s"},
+		"jp-osa": {"public": ""}
+	}}}`, usSouth.URL, euGb.URL))()
+
+	c := createPluginContext("", newCFTestConfig(euDe.URL))
+
+	regions, err := ListCFOrgsAcrossRegions(context.Background(), c)
+	assert.NoError(err)
+	if !assert.Len(regions, 3) {
+		return
+	}
+
+	assert.Equal("eu-de", regions[0].Region)
+	assert.Equal(euDe.URL, regions[0].APIEndpoint)
+	assert.NoError(regions[0].Err)
+	assert.Equal([]models.OrganizationFields{{GUID: "org3-guid", Name: "org3"}}, regions[0].Organizations)
+
+	assert.Equal("eu-gb", regions[1].Region)
+	assert.Empty(regions[1].Organizations)
+	assert.Error(regions[1].Err)
+
+	assert.Equal("us-south", regions[2].Region)
+	assert.NoError(regions[2].Err)
+	assert.Equal([]models.OrganizationFields{{GUID: "org1-guid", Name: "org1"}, {GUID: "org2-guid", Name: "org2"}}, regions[2].Organizations)
+}
+
+func TestListCFOrgsAcrossRegions_TargetedEndpointInCatalog(t *testing.T) {
+	assert := assert.New(t)
+
+	euDe := cfOrgsServer(t, "bearer eu-de-token", "", "org1")
+	defer euDe.Close()
+
+	defer setBluemixHome(t, fmt.Sprintf(`{"services": {"cloudfoundry": {"eu-de": {"public": "%s"}}}}`, euDe.URL))()
+
+	regions, err := ListCFOrgsAcrossRegions(context.Background(), createPluginContext("", newCFTestConfig(euDe.URL+"/")))
+	assert.NoError(err)
+	if assert.Len(regions, 1) {
+		assert.NoError(regions[0].Err)
+	}
+}
+
+func TestListCFOrgsAcrossRegions_Cancelled(t *testing.T) {
+	assert := assert.New(t)
+
+	done := make(chan struct{})
+	hung := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-done
+	}))
+	defer hung.Close()
+	defer close(done)
+
+	defer setBluemixHome(t, "")()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	regions, err := ListCFOrgsAcrossRegions(ctx, createPluginContext("", newCFTestConfig(hung.URL)))
+	assert.NoError(err)
+	if assert.Len(regions, 1) {
+		assert.Error(regions[0].Err)
+	}
+}
+
+func TestListCFOrgsAcrossRegions_NoCFEnvironment(t *testing.T) {
+	defer setBluemixHome(t, "")()
+
+	c := createPluginContext("", configuration.NewFakeCoreConfig())
+	_, err := ListCFOrgsAcrossRegions(context.Background(), c)
+	assert.IsType(t, &NoCFEnvironmentError{}, err)
+
+	config := newCFTestConfig("https://api.example.com")
+	config.CFConfig().SetUAAToken("")
+	_, err = ListCFOrgsAcrossRegions(context.Background(), createPluginContext("", config))
+	assert.IsType(t, &NoCFEnvironmentError{}, err)
+}
@@ -0,0 +1,44 @@
+package plugin
+
+import (
+	"os"
+	"strings"
+)
+
+// CIEnvironmentVariables is the list of environment variables whose presence
+// indicates the plugin is run by a continuous integration system. Generic
+// names like BUILD_ID or RUN_ID are left out, as they are commonly set outside
+// CI too.
+var CIEnvironmentVariables = []string{
+	// set by most CI systems
+	"CI",
+	"CONTINUOUS_INTEGRATION",
+
+	"JENKINS_URL",
+	"GITHUB_ACTIONS",
+	"GITLAB_CI",
+	"TRAVIS",
+	"CIRCLECI",
+	"TEAMCITY_VERSION",
+	"TF_BUILD",
+	"BITBUCKET_BUILD_NUMBER",
+	"CODEBUILD_BUILD_ID",
+	"BUILDKITE",
+}
+
+// IsCI returns whether the plugin is run by a continuous integration system,
+// detected by the environment variables in CIEnvironmentVariables. A
+// variable set to "false" or "0" is ignored.
+func IsCI() bool {
+	for _, name := range CIEnvironmentVariables {
+		v, ok := os.LookupEnv(name)
+		if !ok {
+			continue
+		}
+		if v == "0" || strings.EqualFold(v, "false") {
+			continue
+		}
+		return true
+	}
+	return false
+}
@@ -0,0 +1,29 @@
+package plugin
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsCI(t *testing.T) {
+	defer func(vars []string) { CIEnvironmentVariables = vars }(CIEnvironmentVariables)
+	CIEnvironmentVariables = []string{"TEST_CI_VAR"}
+
+	os.Unsetenv("TEST_CI_VAR")
+	assert.False(t, IsCI())
+
+	os.Setenv("TEST_CI_VAR", "false")
+	assert.False(t, IsCI())
+
+	os.Setenv("TEST_CI_VAR", "true")
+	defer os.Unsetenv("TEST_CI_VAR")
+	assert.True(t, IsCI())
+}
+
+func TestIsCI_GenericVariables(t *testing.T) {
+	for _, name := range []string{"BUILD_ID", "BUILD_NUMBER", "RUN_ID", "PIPELINE_ID"} {
+		assert.NotContains(t, CIEnvironmentVariables, name)
+	}
+}
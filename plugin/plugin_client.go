@@ -0,0 +1,78 @@
+package plugin
+
+import (
+	"crypto/rand"
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"os"
+	"sync"
+
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/bluemix/consts"
+	bhttp "github.com/IBM-Cloud/ibm-cloud-cli-sdk/bluemix/http"
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/common/rest"
+)
+
+// Headers carrying the correlation ID of the CLI invocation. Both are set
+// since services differ in which one they log.
+const (
+	CorrelationIDHeader = "X-Correlation-ID"
+	RequestIDHeader     = "X-Request-ID"
+)
+
+// lazyCorrelationID is the correlation ID of a plugin context, set on first use
+type lazyCorrelationID struct {
+	once sync.Once
+	id   string
+}
+
+// CorrelationID returns the correlation ID set by the CLI, or generates one
+// the first time it is called. The generated ID is exported to the
+// environment so that CLI commands run by InvokePlugin share it.
+func (c *pluginContext) CorrelationID() string {
+	c.correlationID.once.Do(func() {
+		c.correlationID.id = os.Getenv(consts.ENV_IBMCLOUD_CORRELATION_ID)
+		if c.correlationID.id == "" {
+			c.correlationID.id = newCorrelationID()
+			os.Setenv(consts.ENV_IBMCLOUD_CORRELATION_ID, c.correlationID.id)
+		}
+	})
+	return c.correlationID.id
+}
+
+// newCorrelationID returns a random version 4 UUID.
+func newCorrelationID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
+
+// NewClientFromContext creates a REST client configured by the plugin
+// context: requests are traced as configured by Trace, the TLS certificate
+// is not verified if IsSSLDisabled, the timeout is HTTPTimeoutDuration, and
+// the correlation ID is sent in the X-Correlation-ID and X-Request-ID
+// headers.
+func NewClientFromContext(c PluginContext) *rest.Client {
+	transport := bhttp.NewTraceLoggingTransport(
+		&http.Transport{
+			Proxy: http.ProxyFromEnvironment,
+			TLSClientConfig: &tls.Config{
+				InsecureSkipVerify: c.IsSSLDisabled(),
+			},
+		})
+
+	client := rest.NewClient()
+	client.HTTPClient = &http.Client{
+		Transport: transport,
+		Timeout:   c.HTTPTimeoutDuration(),
+	}
+
+	id := c.CorrelationID()
+	client.DefaultHeader.Set(CorrelationIDHeader, id)
+	client.DefaultHeader.Set(RequestIDHeader, id)
+	return client
+}
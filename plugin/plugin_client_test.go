@@ -0,0 +1,65 @@
+package plugin
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/bluemix/consts"
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/common/rest"
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/testhelpers/configuration"
+	"github.com/stretchr/testify/assert"
+)
+
+// restoreCorrelationIDEnv restores the correlation ID environment variable
+// at the end of the test
+func restoreCorrelationIDEnv(t *testing.T) {
+	old, set := os.LookupEnv(consts.ENV_IBMCLOUD_CORRELATION_ID)
+	t.Cleanup(func() {
+		if set {
+			os.Setenv(consts.ENV_IBMCLOUD_CORRELATION_ID, old)
+		} else {
+			os.Unsetenv(consts.ENV_IBMCLOUD_CORRELATION_ID)
+		}
+	})
+}
+
+func TestCorrelationID_FromEnv(t *testing.T) {
+	restoreCorrelationIDEnv(t)
+	os.Setenv(consts.ENV_IBMCLOUD_CORRELATION_ID, "abc-123")
+
+	pc := createPluginContext("", configuration.NewFakeCoreConfig())
+	assert.Equal(t, "abc-123", pc.CorrelationID())
+}
+
+func TestCorrelationID_Generated(t *testing.T) {
+	restoreCorrelationIDEnv(t)
+	os.Unsetenv(consts.ENV_IBMCLOUD_CORRELATION_ID)
+
+	pc := createPluginContext("", configuration.NewFakeCoreConfig())
+	id := pc.CorrelationID()
+	assert.Regexp(t, "^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$", id)
+	assert.Equal(t, id, pc.CorrelationID())
+	assert.Equal(t, id, os.Getenv(consts.ENV_IBMCLOUD_CORRELATION_ID))
+}
+
+func TestNewClientFromContext(t *testing.T) {
+	restoreCorrelationIDEnv(t)
+	os.Setenv(consts.ENV_IBMCLOUD_CORRELATION_ID, "abc-123")
+
+	var header http.Header
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header
+	}))
+	defer ts.Close()
+
+	pc := createPluginContext("", configuration.NewFakeCoreConfig())
+	client := NewClientFromContext(pc)
+	assert.Equal(t, DefaultHTTPTimeout, client.HTTPClient.Timeout)
+
+	_, err := client.Do(rest.GetRequest(ts.URL), nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "abc-123", header.Get(CorrelationIDHeader))
+	assert.Equal(t, "abc-123", header.Get(RequestIDHeader))
+}
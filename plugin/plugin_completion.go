@@ -0,0 +1,53 @@
+package plugin
+
+import "strings"
+
+// CompleteFlagValue returns the completion candidates of a flag value if the
+// word before the partial word being completed is a flag of the command
+// that requires a value, e.g. for "--region us-" it returns the candidates
+// of flag "region" that start with "us-". args is the command line after
+// the command name whose last element is the partial word.
+//
+// The second return value is false if the partial word is not a flag value.
+func CompleteFlagValue(c PluginContext, cmd Command, args []string) ([]string, bool) {
+	if len(args) < 2 {
+		return nil, false
+	}
+
+	partial := args[len(args)-1]
+	flag, found := findFlag(cmd.Flags, args[len(args)-2])
+	if !found || !flag.HasValue {
+		return nil, false
+	}
+	if flag.Completion == nil {
+		return nil, true
+	}
+
+	if flag.Completion.Func != nil {
+		return flag.Completion.Func(c, partial), true
+	}
+
+	var candidates []string
+	for _, v := range flag.Completion.Candidates {
+		if strings.HasPrefix(v, partial) {
+			candidates = append(candidates, v)
+		}
+	}
+	return candidates, true
+}
+
+// findFlag returns the flag of the command line argument, e.g. "-q" or
+// "--output"
+func findFlag(flags []Flag, arg string) (Flag, bool) {
+	if !strings.HasPrefix(arg, "-") {
+		return Flag{}, false
+	}
+
+	name := strings.TrimLeft(arg, "-")
+	for _, f := range flags {
+		if f.Name == name {
+			return f, true
+		}
+	}
+	return Flag{}, false
+}
@@ -0,0 +1,51 @@
+package plugin
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var completionCommand = Command{
+	Name: "create",
+	Flags: []Flag{
+		{Name: "region", HasValue: true, Completion: &FlagCompletion{Candidates: []string{"us-south", "us-east", "eu-de"}}},
+		{Name: "g", HasValue: true, Completion: &FlagCompletion{Func: func(c PluginContext, partial string) []string {
+			return []string{partial + "-group"}
+		}}},
+		{Name: "f"},
+	},
+}
+
+func TestCompleteFlagValue(t *testing.T) {
+	assert := assert.New(t)
+
+	candidates, ok := CompleteFlagValue(nil, completionCommand, []string{"--region", "us-"})
+	assert.True(ok)
+	assert.Equal([]string{"us-south", "us-east"}, candidates)
+
+	candidates, ok = CompleteFlagValue(nil, completionCommand, []string{"name", "-g", "dev"})
+	assert.True(ok)
+	assert.Equal([]string{"dev-group"}, candidates)
+
+	_, ok = CompleteFlagValue(nil, completionCommand, []string{"-f", ""})
+	assert.False(ok)
+
+	_, ok = CompleteFlagValue(nil, completionCommand, []string{"name"})
+	assert.False(ok)
+}
+
+func TestFlagCompletionFuncNotSerialized(t *testing.T) {
+	bytes, err := json.Marshal(completionCommand)
+	assert.NoError(t, err)
+	assert.False(t, strings.Contains(string(bytes), "Func"))
+	assert.True(t, strings.Contains(string(bytes), `"Candidates":["us-south","us-east","eu-de"]`))
+}
+
+func TestFlagComparable(t *testing.T) {
+	flag := completionCommand.Flags[1]
+	assert.True(t, flag == completionCommand.Flags[1])
+	assert.False(t, flag == completionCommand.Flags[0])
+}
@@ -84,6 +84,14 @@ type PluginConfig interface {
 	// GetStringMap return map[string]string for a given key.
 	GetStringMapString(key string) (map[string]string, error)
 
+	// GetStruct decodes the value for a given key into the struct pointed to
+	// by v via JSON. v is unchanged if the key not exist.
+	GetStruct(key string, v interface{}) error
+
+	// SetStruct sets the value for a given key to the JSON representation of
+	// v.
+	SetStruct(key string, v interface{}) error
+
 	// Exists checks whether the value for a given key exists or not.
 	Exists(key string) bool
 
@@ -94,6 +102,26 @@ type PluginConfig interface {
 	Erase(key string) error
 }
 
+// ConfigSchemaVersionKey is the key in plugin configuration storing the
+// schema version, i.e. the number of migrations applied.
+const ConfigSchemaVersionKey = "SchemaVersion"
+
+// ConfigMigration migrates the plugin configuration data from a schema
+// version to the next one.
+type ConfigMigration func(data map[string]interface{}) error
+
+var configMigrations []ConfigMigration
+
+// RegisterConfigMigrations registers the migrations of the plugin
+// configuration. The n-th migration migrates the configuration from schema
+// version n-1 to n. It should be called before the plugin starts, e.g. in
+// main function. When the configuration is loaded, migrations which are not
+// applied yet are applied in order and the configuration is saved with the
+// new schema version.
+func RegisterConfigMigrations(migrations ...ConfigMigration) {
+	configMigrations = append(configMigrations, migrations...)
+}
+
 type pd map[string]interface{}
 
 func (data pd) Marshal() ([]byte, error) {
@@ -106,35 +134,118 @@ func (data pd) Unmarshal(bytes []byte) error {
 
 type pluginConfig struct {
 	initOnce  *sync.Once
+	initErr   error
 	lock      sync.RWMutex
 	data      pd
 	persistor configuration.Persistor
+
+	// apply the registered migrations when loaded
+	migrate bool
 }
 
+// loadPluginConfigFromPath returns the plugin config of the plugin stored at
+// the given path, to which the registered migrations are applied.
 func loadPluginConfigFromPath(path string) PluginConfig {
 	return &pluginConfig{
 		initOnce:  new(sync.Once),
 		data:      make(map[string]interface{}),
 		persistor: configuration.NewDiskPersistor(path),
+		migrate:   true,
 	}
 }
 
-func (c *pluginConfig) init() {
+// init loads the config and applies the migrations if needed. It returns the
+// error of the migrations, if any.
+func (c *pluginConfig) init() error {
 	c.initOnce.Do(func() {
+		exists := c.persistor.Exists()
+		if !exists && !c.migrate {
+			// don't create a read-only config, e.g. defaults
+			return
+		}
+
 		err := c.persistor.Load(c.data)
 		if err != nil {
 			panic(err)
 		}
+
+		if !c.migrate {
+			return
+		}
+		if exists {
+			c.initErr = c.applyMigrations()
+		} else if len(configMigrations) > 0 {
+			// a new config is created with the latest schema
+			c.data[ConfigSchemaVersionKey] = len(configMigrations)
+			c.initErr = c.persistor.Save(c.data)
+		}
 	})
+	return c.initErr
+}
+
+// applyMigrations applies the migrations to a copy of the data, which
+// replaces the data only if all of them succeed.
+func (c *pluginConfig) applyMigrations() error {
+	version, _ := toInt(c.data[ConfigSchemaVersionKey])
+	if version >= len(configMigrations) {
+		return nil
+	}
+
+	data := pd(copyConfigValue(map[string]interface{}(c.data)).(map[string]interface{}))
+	for _, migration := range configMigrations[version:] {
+		if err := migration(data); err != nil {
+			return fmt.Errorf("plugin config: failed to migrate: %v", err)
+		}
+	}
+
+	data[ConfigSchemaVersionKey] = len(configMigrations)
+	if err := c.persistor.Save(data); err != nil {
+		return err
+	}
+	c.data = data
+	return nil
 }
 
+// copyConfigValue returns a deep copy of the maps and slices of a config
+// value.
+func copyConfigValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, e := range v {
+			m[k] = copyConfigValue(e)
+		}
+		return m
+	case []interface{}:
+		s := make([]interface{}, len(v))
+		for i, e := range v {
+			s[i] = copyConfigValue(e)
+		}
+		return s
+	}
+	return v
+}
+
+// Get returns the value for a given key, or nil if the config failed to
+// load or migrate.
 func (c *pluginConfig) Get(key string) interface{} {
+	v, err := c.value(key)
+	if err != nil {
+		return nil
+	}
+	return v
+}
+
+// value returns the value for a given key, or the error loading the config.
+func (c *pluginConfig) value(key string) (interface{}, error) {
 	c.lock.RLock()
 	defer c.lock.RUnlock()
 
-	c.init()
+	if err := c.init(); err != nil {
+		return nil, err
+	}
 
-	return c.data[key]
+	return c.data[key], nil
 }
 
 func (c *pluginConfig) GetWithDefault(key string, defaultVal interface{}) interface{} {
@@ -150,9 +261,9 @@ func (c *pluginConfig) GetString(key string) (string, error) {
 }
 
 func (c *pluginConfig) GetStringWithDefault(key string, defaultVal string) (string, error) {
-	v := c.Get(key)
-	if v == nil {
-		return defaultVal, nil
+	v, err := c.value(key)
+	if err != nil || v == nil {
+		return defaultVal, err
 	}
 	if s, ok := toString(v); ok {
 		return s, nil
@@ -179,9 +290,9 @@ func (c *pluginConfig) GetBool(key string) (bool, error) {
 }
 
 func (c *pluginConfig) GetBoolWithDefault(key string, defaultVal bool) (bool, error) {
-	v := c.Get(key)
-	if v == nil {
-		return defaultVal, nil
+	v, err := c.value(key)
+	if err != nil || v == nil {
+		return defaultVal, err
 	}
 	if b, ok := toBool(v); ok {
 		return b, nil
@@ -207,9 +318,9 @@ func (c *pluginConfig) GetInt(key string) (int, error) {
 }
 
 func (c *pluginConfig) GetIntWithDefault(key string, defaultVal int) (int, error) {
-	v := c.Get(key)
-	if v == nil {
-		return defaultVal, nil
+	v, err := c.value(key)
+	if err != nil || v == nil {
+		return defaultVal, err
 	}
 	if i, ok := toInt(v); ok {
 		return i, nil
@@ -235,9 +346,9 @@ func (c *pluginConfig) GetFloat(key string) (float64, error) {
 }
 
 func (c *pluginConfig) GetFloatWithDefault(key string, defaultVal float64) (float64, error) {
-	v := c.Get(key)
-	if v == nil {
-		return defaultVal, nil
+	v, err := c.value(key)
+	if err != nil || v == nil {
+		return defaultVal, err
 	}
 	if f, ok := toFloat(v); ok {
 		return f, nil
@@ -259,9 +370,9 @@ func toFloat(v interface{}) (float64, bool) {
 }
 
 func (c *pluginConfig) GetSlice(key string) ([]interface{}, error) {
-	v := c.Get(key)
-	if v == nil {
-		return []interface{}{}, nil
+	v, err := c.value(key)
+	if err != nil || v == nil {
+		return []interface{}{}, err
 	}
 	if s, ok := v.([]interface{}); ok {
 		return s, nil
@@ -270,9 +381,9 @@ func (c *pluginConfig) GetSlice(key string) ([]interface{}, error) {
 }
 
 func (c *pluginConfig) GetStringSlice(key string) ([]string, error) {
-	v := c.Get(key)
-	if v == nil {
-		return []string{}, nil
+	v, err := c.value(key)
+	if err != nil || v == nil {
+		return []string{}, err
 	}
 	if ss, ok := toStringSlice(v); ok {
 		return ss, nil
@@ -298,9 +409,9 @@ func toStringSlice(v interface{}) ([]string, bool) {
 }
 
 func (c *pluginConfig) GetIntSlice(key string) ([]int, error) {
-	v := c.Get(key)
-	if v == nil {
-		return []int{}, nil
+	v, err := c.value(key)
+	if err != nil || v == nil {
+		return []int{}, err
 	}
 	if is, ok := toIntSlice(v); ok {
 		return is, nil
@@ -326,9 +437,9 @@ func toIntSlice(v interface{}) ([]int, bool) {
 }
 
 func (c *pluginConfig) GetFloatSlice(key string) ([]float64, error) {
-	v := c.Get(key)
-	if v == nil {
-		return []float64{}, nil
+	v, err := c.value(key)
+	if err != nil || v == nil {
+		return []float64{}, err
 	}
 	if fs, ok := toFloatSlice(v); ok {
 		return fs, nil
@@ -354,9 +465,9 @@ func toFloatSlice(v interface{}) ([]float64, bool) {
 }
 
 func (c *pluginConfig) GetStringMap(key string) (map[string]interface{}, error) {
-	v := c.Get(key)
-	if v == nil {
-		return map[string]interface{}{}, nil
+	v, err := c.value(key)
+	if err != nil || v == nil {
+		return map[string]interface{}{}, err
 	}
 	if sm, ok := v.(map[string]interface{}); ok {
 		return sm, nil
@@ -365,9 +476,9 @@ func (c *pluginConfig) GetStringMap(key string) (map[string]interface{}, error)
 }
 
 func (c *pluginConfig) GetStringMapString(key string) (map[string]string, error) {
-	v := c.Get(key)
-	if v == nil {
-		return map[string]string{}, nil
+	v, err := c.value(key)
+	if err != nil || v == nil {
+		return map[string]string{}, err
 	}
 	if sms, ok := toMapStringMapString(v); ok {
 		return sms, nil
@@ -393,6 +504,36 @@ func toMapStringMapString(v interface{}) (map[string]string, bool) {
 	return sms, true
 }
 
+func (c *pluginConfig) GetStruct(key string, v interface{}) error {
+	value, err := c.value(key)
+	if err != nil || value == nil {
+		return err
+	}
+
+	bytes, err := json.Marshal(value)
+	if err == nil {
+		err = json.Unmarshal(bytes, v)
+	}
+	if err != nil {
+		return PluginConfigInvalidTypeError{Key: key, ExpectedType: fmt.Sprintf("%T", v), Value: value}
+	}
+	return nil
+}
+
+func (c *pluginConfig) SetStruct(key string, v interface{}) error {
+	bytes, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	var value interface{}
+	err = json.Unmarshal(bytes, &value)
+	if err != nil {
+		return err
+	}
+	return c.Set(key, value)
+}
+
 func (c *pluginConfig) Exists(key string) bool {
 	return c.Get(key) != nil
 }
@@ -413,7 +554,9 @@ func (c *pluginConfig) write(cb func()) error {
 	c.lock.Lock()
 	defer c.lock.Unlock()
 
-	c.init()
+	if err := c.init(); err != nil {
+		return err
+	}
 
 	cb()
 
@@ -0,0 +1,134 @@
+package plugin
+
+import (
+	"sync"
+
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/bluemix/configuration"
+)
+
+// layeredConfig is a PluginConfig that reads from the user layer first and
+// falls through to the defaults layer. Writes only go to the user layer.
+type layeredConfig struct {
+	defaults PluginConfig
+	user     PluginConfig
+}
+
+// NewLayeredConfig returns a PluginConfig combining the default config and
+// the user config, for example defaults shipped in the plugin directory and
+// user overrides in the plugin config:
+//
+//	defaults := plugin.LoadPluginConfig(filepath.Join(pluginDir, "defaults.json"))
+//	config := plugin.NewLayeredConfig(defaults, context.PluginConfig())
+//
+// A key is read from the user config if it exists there, otherwise from the
+// default config. Keys are resolved at the top level only: if the user config
+// has a key whose value is a map, the whole map replaces the default one and
+// nested keys are not merged.
+//
+// Set and Erase only change the user config, so erasing a key reverts it to
+// the default value.
+func NewLayeredConfig(defaults, user PluginConfig) PluginConfig {
+	return &layeredConfig{
+		defaults: defaults,
+		user:     user,
+	}
+}
+
+// LoadPluginConfig returns the plugin config stored in the JSON file at the
+// given path, e.g. defaults shipped with the plugin. Unlike the plugin config
+// of the context, the migrations registered with RegisterConfigMigrations
+// are not applied, so the file is not changed unless written to.
+func LoadPluginConfig(path string) PluginConfig {
+	return &pluginConfig{
+		initOnce:  new(sync.Once),
+		data:      make(map[string]interface{}),
+		persistor: configuration.NewDiskPersistor(path),
+	}
+}
+
+func (c *layeredConfig) layer(key string) PluginConfig {
+	if c.user.Exists(key) {
+		return c.user
+	}
+	return c.defaults
+}
+
+func (c *layeredConfig) Get(key string) interface{} {
+	return c.layer(key).Get(key)
+}
+
+func (c *layeredConfig) GetWithDefault(key string, defaultVal interface{}) interface{} {
+	return c.layer(key).GetWithDefault(key, defaultVal)
+}
+
+func (c *layeredConfig) GetString(key string) (string, error) {
+	return c.layer(key).GetString(key)
+}
+
+func (c *layeredConfig) GetStringWithDefault(key string, defaultVal string) (string, error) {
+	return c.layer(key).GetStringWithDefault(key, defaultVal)
+}
+
+func (c *layeredConfig) GetBool(key string) (bool, error) {
+	return c.layer(key).GetBool(key)
+}
+
+func (c *layeredConfig) GetBoolWithDefault(key string, defaultVal bool) (bool, error) {
+	return c.layer(key).GetBoolWithDefault(key, defaultVal)
+}
+
+func (c *layeredConfig) GetInt(key string) (int, error) {
+	return c.layer(key).GetInt(key)
+}
+
+func (c *layeredConfig) GetIntWithDefault(key string, defaultVal int) (int, error) {
+	return c.layer(key).GetIntWithDefault(key, defaultVal)
+}
+
+func (c *layeredConfig) GetFloat(key string) (float64, error) {
+	return c.layer(key).GetFloat(key)
+}
+
+func (c *layeredConfig) GetFloatWithDefault(key string, defaultVal float64) (float64, error) {
+	return c.layer(key).GetFloatWithDefault(key, defaultVal)
+}
+
+func (c *layeredConfig) GetStringSlice(key string) ([]string, error) {
+	return c.layer(key).GetStringSlice(key)
+}
+
+func (c *layeredConfig) GetIntSlice(key string) ([]int, error) {
+	return c.layer(key).GetIntSlice(key)
+}
+
+func (c *layeredConfig) GetFloatSlice(key string) ([]float64, error) {
+	return c.layer(key).GetFloatSlice(key)
+}
+
+func (c *layeredConfig) GetStringMap(key string) (map[string]interface{}, error) {
+	return c.layer(key).GetStringMap(key)
+}
+
+func (c *layeredConfig) GetStringMapString(key string) (map[string]string, error) {
+	return c.layer(key).GetStringMapString(key)
+}
+
+func (c *layeredConfig) GetStruct(key string, v interface{}) error {
+	return c.layer(key).GetStruct(key, v)
+}
+
+func (c *layeredConfig) SetStruct(key string, v interface{}) error {
+	return c.user.SetStruct(key, v)
+}
+
+func (c *layeredConfig) Exists(key string) bool {
+	return c.user.Exists(key) || c.defaults.Exists(key)
+}
+
+func (c *layeredConfig) Set(key string, v interface{}) error {
+	return c.user.Set(key, v)
+}
+
+func (c *layeredConfig) Erase(key string) error {
+	return c.user.Erase(key)
+}
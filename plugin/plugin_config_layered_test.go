@@ -0,0 +1,70 @@
+package plugin
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadPluginConfig_NoMigration(t *testing.T) {
+	assert := assert.New(t)
+
+	path := prepareConfigFile()
+	defer os.RemoveAll(filepath.Dir(path))
+
+	defer func() { configMigrations = nil }()
+	RegisterConfigMigrations(func(data map[string]interface{}) error {
+		data["name"] = "migrated"
+		return nil
+	})
+
+	config := LoadPluginConfig(path)
+	assert.Equal("joe", config.Get("name"))
+	assert.False(config.Exists(ConfigSchemaVersionKey))
+
+	raw, err := ioutil.ReadFile(path)
+	assert.NoError(err)
+	assert.Equal(config_string, string(raw))
+
+	notExist := filepath.Join(filepath.Dir(path), "defaults.json")
+	assert.Nil(LoadPluginConfig(notExist).Get("name"))
+	assert.False(fileExists(notExist))
+}
+
+func TestNewLayeredConfig(t *testing.T) {
+	assert := assert.New(t)
+
+	defaultsPath := prepareConfigFile()
+	defer os.RemoveAll(filepath.Dir(defaultsPath))
+	userPath := filepath.Join(filepath.Dir(defaultsPath), "config.json")
+
+	defer func() { configMigrations = nil }()
+	RegisterConfigMigrations(func(data map[string]interface{}) error {
+		return nil
+	})
+
+	config := NewLayeredConfig(LoadPluginConfig(defaultsPath), loadPluginConfigFromPath(userPath))
+
+	name, err := config.GetString("name")
+	assert.NoError(err)
+	assert.Equal("joe", name)
+	assert.True(config.Exists("age"))
+	assert.False(config.Exists("unknown"))
+
+	assert.NoError(config.Set("name", "jane"))
+	name, _ = config.GetString("name")
+	assert.Equal("jane", name)
+	assert.Equal("jane", loadPluginConfigFromPath(userPath).Get("name"))
+	assert.Equal(float64(1), loadPluginConfigFromPath(userPath).Get(ConfigSchemaVersionKey))
+
+	assert.NoError(config.Erase("name"))
+	name, _ = config.GetString("name")
+	assert.Equal("joe", name)
+
+	raw, err := ioutil.ReadFile(defaultsPath)
+	assert.NoError(err)
+	assert.Equal(config_string, string(raw))
+}
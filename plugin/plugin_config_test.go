@@ -1,6 +1,7 @@
 package plugin
 
 import (
+	"errors"
 	"io/ioutil"
 	"os"
 	"path/filepath"
@@ -329,6 +330,115 @@ func TestPluginConfigSet_AddNewKey(t *testing.T) {
 	assert.Equal("something new", config.Get("new"))
 }
 
+func TestPluginConfigStruct(t *testing.T) {
+	assert := assert.New(t)
+
+	path := prepareConfigFile()
+	defer os.RemoveAll(filepath.Dir(path))
+
+	config := loadPluginConfigFromPath(path)
+
+	type person struct {
+		Name string   `json:"name"`
+		Tags []string `json:"tags"`
+	}
+
+	err := config.SetStruct("person", person{Name: "joe", Tags: []string{"a", "b"}})
+	assert.NoError(err)
+
+	var p person
+	err = loadPluginConfigFromPath(path).GetStruct("person", &p)
+	assert.NoError(err)
+	assert.Equal(person{Name: "joe", Tags: []string{"a", "b"}}, p)
+
+	err = config.GetStruct("name", &p)
+	assert.Error(err)
+	assert.IsType(PluginConfigInvalidTypeError{}, err)
+}
+
+func TestPluginConfigMigration(t *testing.T) {
+	assert := assert.New(t)
+
+	path := prepareConfigFile()
+	defer os.RemoveAll(filepath.Dir(path))
+
+	defer func() { configMigrations = nil }()
+	RegisterConfigMigrations(
+		func(data map[string]interface{}) error {
+			data["username"] = data["name"]
+			delete(data, "name")
+			return nil
+		},
+		func(data map[string]interface{}) error {
+			data["age"] = data["age"].(float64) + 1
+			return nil
+		},
+	)
+
+	config := loadPluginConfigFromPath(path)
+	assert.Equal("joe", config.Get("username"))
+	assert.False(config.Exists("name"))
+	assert.Equal(float64(31), config.Get("age"))
+
+	config = loadPluginConfigFromPath(path)
+	assert.Equal(float64(2), config.Get(ConfigSchemaVersionKey))
+	assert.Equal(float64(31), config.Get("age"))
+}
+
+func TestPluginConfigMigration_Error(t *testing.T) {
+	assert := assert.New(t)
+
+	path := prepareConfigFile()
+	defer os.RemoveAll(filepath.Dir(path))
+
+	defer func() { configMigrations = nil }()
+	RegisterConfigMigrations(func(data map[string]interface{}) error {
+		return errors.New("bad data")
+	})
+
+	config := loadPluginConfigFromPath(path)
+	assert.NotPanics(func() {
+		_, err := config.GetString("name")
+		if assert.Error(err) {
+			assert.Contains(err.Error(), "bad data")
+		}
+		assert.Error(config.Set("name", "jane"))
+		assert.Nil(config.Get("name"))
+	})
+
+	raw, err := ioutil.ReadFile(path)
+	assert.NoError(err)
+	assert.Equal(config_string, string(raw))
+}
+
+func TestPluginConfigMigration_PartialFailure(t *testing.T) {
+	assert := assert.New(t)
+
+	path := prepareConfigFile()
+	defer os.RemoveAll(filepath.Dir(path))
+
+	defer func() { configMigrations = nil }()
+	RegisterConfigMigrations(
+		func(data map[string]interface{}) error {
+			data["username"] = data["name"]
+			delete(data, "name")
+			return nil
+		},
+		func(data map[string]interface{}) error {
+			return errors.New("bad data")
+		},
+	)
+
+	config := loadPluginConfigFromPath(path)
+	assert.Nil(config.Get("username"))
+	assert.Nil(config.Get("name"))
+
+	// the data is left as loaded
+	data := config.(*pluginConfig).data
+	assert.Equal("joe", data["name"])
+	assert.NotContains(data, "username")
+}
+
 func prepareConfigFile() string {
 	tmpDir, err := ioutil.TempDir("", "plugin_config_test")
 	if err != nil {
@@ -0,0 +1,26 @@
+package plugin
+
+import (
+	"errors"
+
+	. "github.com/IBM-Cloud/ibm-cloud-cli-sdk/i18n"
+)
+
+// ConfirmOrForce asks the user to confirm a destructive action with the
+// given message, e.g. "Are you sure you want to delete instance 'foo'?".
+//
+// It returns true without prompting if the command line has flag -f or
+// --force. Otherwise, if the plugin is not run interactively (see
+// IsInteractive), it returns an error instead of waiting for input that
+// never comes.
+func ConfirmOrForce(c PluginContext, message string) (bool, error) {
+	if hasFlag(contextArgs(c), "-f", "--force") {
+		return true, nil
+	}
+
+	if !c.IsInteractive() {
+		return false, errors.New(T("Confirmation is required in non-interactive mode, use --force to skip it"))
+	}
+
+	return NewUI(c).Confirm("%s", message)
+}
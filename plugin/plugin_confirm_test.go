@@ -0,0 +1,31 @@
+package plugin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/testhelpers/configuration"
+)
+
+func TestConfirmOrForce(t *testing.T) {
+	assert := assert.New(t)
+
+	c := createPluginContext("", configuration.NewFakeCoreConfig())
+	c.args = []string{"instance", "--force"}
+
+	confirmed, err := ConfirmOrForce(c, "Delete instance?")
+	assert.NoError(err)
+	assert.True(confirmed)
+}
+
+func TestConfirmOrForce_NonInteractive(t *testing.T) {
+	assert := assert.New(t)
+
+	c := createPluginContext("", configuration.NewFakeCoreConfig())
+	c.args = []string{"instance"}
+
+	confirmed, err := ConfirmOrForce(c, "Delete instance?")
+	assert.Error(err)
+	assert.False(confirmed)
+}
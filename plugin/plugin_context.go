@@ -1,23 +1,39 @@
 package plugin
 
 import (
-	"fmt"
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/url"
 	"os"
 	"path/filepath"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/bluemix/authentication"
 	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/bluemix/configuration/core_config"
 	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/bluemix/consts"
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/bluemix/models"
 	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/common/rest"
+	. "github.com/IBM-Cloud/ibm-cloud-cli-sdk/i18n"
+	"golang.org/x/crypto/ssh/terminal"
 )
 
+// DefaultHTTPTimeout is the timeout for HTTP Client if it is not configured
+const DefaultHTTPTimeout = 60 * time.Second
+
 type pluginContext struct {
 	core_config.ReadWriter
 	cfConfig     cfConfigWrapper
 	pluginConfig PluginConfig
 	pluginPath   string
+	metadata     PluginMetadata
+	args         []string
+
+	correlationID lazyCorrelationID
+	iamRefresh    refreshGroup
 }
 
 type cfConfigWrapper struct {
@@ -26,7 +42,7 @@ type cfConfigWrapper struct {
 
 func (c cfConfigWrapper) RefreshUAAToken() (string, error) {
 	if !c.HasAPIEndpoint() {
-		return "", fmt.Errorf("CloudFoundry API endpoint is not set")
+		return "", errors.New(T("CloudFoundry API endpoint is not set"))
 	}
 
 	config := &authentication.UAAConfig{UAAEndpoint: c.AuthenticationEndpoint()}
@@ -52,9 +68,57 @@ func createPluginContext(pluginPath string, coreConfig core_config.ReadWriter) *
 
 func (c *pluginContext) APIEndpoint() string {
 	if compareVersion(c.SDKVersion(), "0.1.1") < 0 {
-		return c.ReadWriter.CFConfig().APIEndpoint()
+		return normalizeEndpoint(c.ReadWriter.CFConfig().APIEndpoint())
+	}
+	return normalizeEndpoint(c.ReadWriter.APIEndpoint())
+}
+
+func (c *pluginContext) ConsoleEndpoint() string {
+	return normalizeEndpoint(c.ReadWriter.ConsoleEndpoint())
+}
+
+func (c *pluginContext) IAMEndpoint() string {
+	return normalizeEndpoint(c.ReadWriter.IAMEndpoint())
+}
+
+// normalizeEndpoint returns the endpoint in canonical form: with scheme
+// (https if missing) and without trailing slash.
+func normalizeEndpoint(endpoint string) string {
+	endpoint = strings.TrimSpace(endpoint)
+	if endpoint == "" {
+		return ""
 	}
-	return c.ReadWriter.APIEndpoint()
+	if !strings.Contains(endpoint, "://") {
+		endpoint = "https://" + endpoint
+	}
+	return strings.TrimRight(endpoint, "/")
+}
+
+func (c *pluginContext) PasscodeEndpoint() string {
+	endpoint := os.Getenv("IAM_ENDPOINT")
+	if endpoint == "" {
+		endpoint = c.IAMEndpoint()
+	}
+	return publicURL(endpoint, "/identity/passcode")
+}
+
+func (c *pluginContext) LoginURL() string {
+	return publicURL(c.ConsoleEndpoint(), "/login")
+}
+
+// publicURL returns the URL of the path under the public variant of the
+// endpoint, or empty if the endpoint is empty or invalid.
+func publicURL(endpoint string, path string) string {
+	if endpoint == "" {
+		return ""
+	}
+	u, err := url.Parse(endpoint)
+	if err != nil || u.Host == "" {
+		return ""
+	}
+	u.Host = strings.TrimPrefix(u.Host, "private.")
+	u.Path = strings.TrimRight(u.Path, "/") + path
+	return u.String()
 }
 
 func compareVersion(v1, v2 string) int {
@@ -97,33 +161,181 @@ func (c *pluginContext) PluginConfig() PluginConfig {
 }
 
 func (c *pluginContext) RefreshIAMToken() (string, error) {
-	endpoint := os.Getenv("IAM_ENDPOINT")
-	if endpoint == "" {
-		endpoint = c.IAMEndpoint()
-	}
-	if endpoint == "" {
-		return "", fmt.Errorf("IAM endpoint is not set")
+	return c.iamRefresh.do(c.refreshIAMToken)
+}
+
+func (c *pluginContext) refreshIAMToken() (string, error) {
+	config, err := iamConfig(c)
+	if err != nil {
+		return "", err
 	}
 
-	config := &authentication.IAMConfig{TokenEndpoint: endpoint + "/identity/token"}
-	auth := authentication.NewIAMAuthRepository(config, rest.NewClient())
+	recorder := &errorResponseRecorder{rt: http.DefaultTransport}
+	client := rest.NewClient()
+	client.HTTPClient = &http.Client{Transport: recorder}
+
+	auth := authentication.NewIAMAuthRepository(config, client)
 	iamToken, err := auth.RefreshToken(c.IAMRefreshToken())
 	if err != nil {
-		return "", err
+		return "", recorder.wrap(err)
 	}
 
 	c.SetIAMToken(iamToken.Token())
-	c.SetIAMRefreshToken(iamToken.RefreshToken)
+	c.SetIAMRefreshTokenWithExpiry(iamToken.RefreshToken, iamToken.RefreshTokenExpiration)
 
 	return iamToken.Token(), nil
 }
 
+// errorResponseRecorder is a RoundTripper recording the last error response
+type errorResponseRecorder struct {
+	rt         http.RoundTripper
+	statusCode int
+	header     http.Header
+	body       []byte
+}
+
+func (r *errorResponseRecorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := r.rt.RoundTrip(req)
+	if err != nil || resp.StatusCode < 400 {
+		return resp, err
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	r.statusCode = resp.StatusCode
+	r.header = resp.Header
+	r.body = body
+	return resp, nil
+}
+
+// wrap returns an IAMTokenRefreshError wrapping err if an error response was
+// recorded, otherwise err itself.
+func (r *errorResponseRecorder) wrap(err error) error {
+	if r.statusCode == 0 {
+		return err
+	}
+	return &IAMTokenRefreshError{
+		StatusCode: r.statusCode,
+		Header:     r.header,
+		Body:       string(r.body),
+		Err:        err,
+	}
+}
+
+func (c *pluginContext) RefreshTokenExpiresAt() (time.Time, error) {
+	if c.IAMRefreshToken() == "" {
+		return time.Time{}, errors.New(T("Not logged in"))
+	}
+
+	expiry := c.IAMRefreshTokenExpiry()
+	if expiry == 0 {
+		return time.Time{}, errors.New(T("Expiry of the refresh token is unknown"))
+	}
+	return time.Unix(expiry, 0), nil
+}
+
+func (c *pluginContext) RefreshAllTokens() error {
+	var err TokenRefreshError
+	if _, e := c.RefreshIAMToken(); e != nil {
+		err.IAMError = e
+	}
+	if c.HasTargetedCF() {
+		if _, e := c.CF().RefreshUAAToken(); e != nil {
+			err.UAAError = e
+		}
+	}
+
+	if err.IAMError != nil || err.UAAError != nil {
+		return &err
+	}
+	return nil
+}
+
+func (c *pluginContext) RefreshIAMTokenForAccount(accountID string) (string, error) {
+	iamToken, err := c.refreshIAMTokenForAccount(accountID, func(description string) error {
+		return authentication.NewAccountAccessError(accountID, description)
+	})
+	if err != nil {
+		return "", err
+	}
+	return iamToken.Token(), nil
+}
+
+func (c *pluginContext) IAMTokenForChildAccount(accountID string) (string, error) {
+	iamToken, err := c.refreshIAMTokenForAccount(accountID, func(description string) error {
+		return authentication.NewEnterpriseAccessError(accountID, description)
+	})
+	if err != nil {
+		return "", err
+	}
+	return iamToken.Token(), nil
+}
+
+// refreshIAMTokenForAccount exchanges the refresh token of the session for an
+// IAM access token scoped to the account. If IAM denies the access, the
+// error returned by accessError with the IAM error description is returned.
+func (c *pluginContext) refreshIAMTokenForAccount(accountID string, accessError func(description string) error) (authentication.Token, error) {
+	config, err := iamConfig(c)
+	if err != nil {
+		return authentication.Token{}, err
+	}
+
+	auth := authentication.NewIAMAuthRepository(config, NewClientFromContext(c))
+	iamToken, err := auth.RefreshTokenToLinkAccounts(c.IAMRefreshToken(), core_config.AccountsInfo{AccountID: accountID})
+	if err != nil {
+		var serverErr *authentication.ServerError
+		if errors.As(err, &serverErr) && serverErr.StatusCode == http.StatusForbidden {
+			return authentication.Token{}, accessError(serverErr.Description)
+		}
+		return authentication.Token{}, err
+	}
+
+	return iamToken, nil
+}
+
+// iamConfig returns the IAM configuration for the endpoint of the given
+// context. The endpoint and the path of token API can be overridden by
+// environment variable IAM_ENDPOINT and IAM_TOKEN_PATH.
+func iamConfig(c PluginContext) (*authentication.IAMConfig, error) {
+	endpoint := os.Getenv("IAM_ENDPOINT")
+	if endpoint == "" {
+		endpoint = c.IAMEndpoint()
+	}
+	if endpoint == "" {
+		return nil, errIAMEndpointNotSet()
+	}
+	return &authentication.IAMConfig{
+		Endpoint:  endpoint,
+		TokenPath: os.Getenv("IAM_TOKEN_PATH"),
+	}, nil
+}
+
 func (c *pluginContext) Trace() string {
 	return getFromEnvOrConfig(consts.ENV_BLUEMIX_TRACE, c.ReadWriter.Trace())
 }
 
 func (c *pluginContext) ColorEnabled() string {
-	return getFromEnvOrConfig(consts.ENV_BLUEMIX_COLOR, c.ReadWriter.ColorEnabled())
+	if hasFlag(c.args, "--no-color") {
+		return "false"
+	}
+	enabled := getFromEnvOrConfig(consts.ENV_BLUEMIX_COLOR, c.ReadWriter.ColorEnabled())
+	if enabled != "true" && os.Getenv(consts.ENV_NO_COLOR) != "" {
+		return "false"
+	}
+	return enabled
+}
+
+func (c *pluginContext) HTTPTimeoutDuration() time.Duration {
+	timeout := c.HTTPTimeout()
+	if timeout <= 0 {
+		return DefaultHTTPTimeout
+	}
+	return time.Duration(timeout) * time.Second
 }
 
 func (c *pluginContext) VersionCheckEnabled() bool {
@@ -150,9 +362,84 @@ func (c *pluginContext) CommandNamespace() string {
 }
 
 func (c *pluginContext) CLIName() string {
-	cliName := os.Getenv(consts.ENV_BLUEMIX_CLI)
-	if cliName == "" {
-		cliName = "bx"
+	return cliName()
+}
+
+func cliName() string {
+	name := os.Getenv(consts.ENV_BLUEMIX_CLI)
+	if name == "" {
+		name = "bx"
+	}
+	return name
+}
+
+func (c *pluginContext) MCCPEndpoint() (string, bool) {
+	if !c.HasTargetedCF() {
+		return "", false
+	}
+
+	u, err := url.Parse(c.CF().APIEndpoint())
+	if err != nil || !strings.HasPrefix(u.Host, "api.") {
+		return "", false
+	}
+	u.Host = "mccp." + strings.TrimPrefix(u.Host, "api.")
+	return u.String(), true
+}
+
+func (c *pluginContext) DefaultRegion() (models.Region, error) {
+	region := c.ReadWriter.DefaultRegion()
+	if region.ID == "" && region.Name == "" {
+		return models.Region{}, errors.New(T("Default region of the account is not set"))
+	}
+	return region, nil
+}
+
+// RegionFromArgs returns the region given by flag -r or --region in the
+// command line arguments, so that commands can override the targeted region
+// per invocation. If the flag is absent, the targeted region is returned. An
+// InvalidRegionError is returned if the region given by the flag is unknown,
+// see models.KnownRegions.
+func RegionFromArgs(c PluginContext, args []string) (models.Region, error) {
+	name, ok := flagValue(args, "-r", "--region")
+	if !ok {
+		return c.CurrentRegion(), nil
+	}
+
+	region, ok := models.RegionByName(name)
+	if !ok {
+		return models.Region{}, &InvalidRegionError{Name: name, ValidRegions: models.KnownRegions()}
+	}
+	return region, nil
+}
+
+func (c *pluginContext) IsInteractive() bool {
+	if IsCI() || c.IsCompletion() {
+		return false
 	}
-	return cliName
+	if !terminal.IsTerminal(int(os.Stdin.Fd())) || !terminal.IsTerminal(int(os.Stdout.Fd())) {
+		return false
+	}
+	return !isQuiet(c.args) && !isJSONOutput(c.args)
+}
+
+func (c *pluginContext) IsCompletion() bool {
+	b, _ := strconv.ParseBool(os.Getenv(consts.ENV_BLUEMIX_COMPLETION))
+	return b
+}
+
+func (c *pluginContext) IsClassicCLI() bool {
+	name := cliBinaryName(c.CLIName())
+	return name == "bx" || name == "bluemix"
+}
+
+func (c *pluginContext) IsIBMCloudCLI() bool {
+	return cliBinaryName(c.CLIName()) == "ibmcloud"
+}
+
+func cliBinaryName(cliName string) string {
+	return strings.TrimSuffix(strings.ToLower(filepath.Base(cliName)), ".exe")
+}
+
+func (c *pluginContext) CLIVersion() string {
+	return os.Getenv(consts.ENV_BLUEMIX_CLI_VERSION)
 }
@@ -0,0 +1,627 @@
+package plugin
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/bluemix/authentication"
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/bluemix/models"
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/testhelpers/configuration"
+)
+
+func TestMCCPEndpoint(t *testing.T) {
+	assert := assert.New(t)
+
+	config := configuration.NewFakeCoreConfig()
+	c := createPluginContext("", config)
+
+	_, ok := c.MCCPEndpoint()
+	assert.False(ok)
+
+	config.CFConfig().SetAPIEndpoint("https://api.us-south.cf.cloud.ibm.com")
+	endpoint, ok := c.MCCPEndpoint()
+	assert.True(ok)
+	assert.Equal("https://mccp.us-south.cf.cloud.ibm.com", endpoint)
+
+	config.CFConfig().SetAPIEndpoint("https://cf.example.com")
+	_, ok = c.MCCPEndpoint()
+	assert.False(ok)
+}
+
+func TestPasscodeEndpointAndLoginURL(t *testing.T) {
+	assert := assert.New(t)
+
+	config := configuration.NewFakeCoreConfig()
+	c := createPluginContext("", config)
+	assert.Equal("", c.PasscodeEndpoint())
+	assert.Equal("", c.LoginURL())
+
+	config.SetIAMEndpoint("https://iam.cloud.ibm.com")
+	config.SetConsoleEndpoint("https://cloud.ibm.com/")
+	assert.Equal("https://iam.cloud.ibm.com/identity/passcode", c.PasscodeEndpoint())
+	assert.Equal("https://cloud.ibm.com/login", c.LoginURL())
+
+	config.SetIAMEndpoint("https://private.iam.cloud.ibm.com")
+	assert.Equal("https://iam.cloud.ibm.com/identity/passcode", c.PasscodeEndpoint())
+}
+
+func TestEndpointNormalization(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"", ""},
+		{"https://cloud.ibm.com", "https://cloud.ibm.com"},
+		{"https://cloud.ibm.com/", "https://cloud.ibm.com"},
+		{"cloud.ibm.com", "https://cloud.ibm.com"},
+		{"cloud.ibm.com//", "https://cloud.ibm.com"},
+		{"http://localhost:8080/", "http://localhost:8080"},
+	}
+
+	config := configuration.NewFakeCoreConfig()
+	c := createPluginContext("", config)
+	for _, test := range tests {
+		config.SetAPIEndpoint(test.input)
+		config.SetIAMEndpoint(test.input)
+		config.SetConsoleEndpoint(test.input)
+
+		assert.Equal(test.expected, c.APIEndpoint())
+		assert.Equal(test.expected, c.IAMEndpoint())
+		assert.Equal(test.expected, c.ConsoleEndpoint())
+	}
+}
+
+func TestListResourceInstances(t *testing.T) {
+	assert := assert.New(t)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal("/v2/resource_instances", r.URL.Path)
+		assert.NotEmpty(r.Header.Get(CorrelationIDHeader))
+		assert.Equal("rg1", r.URL.Query().Get("resource_group_id"))
+		if r.URL.Query().Get("next_docid") == "" {
+			fmt.Fprint(w, `{"next_url": "/v2/resource_instances?resource_group_id=rg1&next_docid=2", "resources": [{"guid": "1", "name": "db1"}]}`)
+			return
+		}
+		fmt.Fprint(w, `{"next_url": null, "resources": [{"guid": "2", "name": "db2"}]}`)
+	}))
+	defer ts.Close()
+
+	os.Setenv("RESOURCE_CONTROLLER_ENDPOINT", ts.URL)
+	defer os.Unsetenv("RESOURCE_CONTROLLER_ENDPOINT")
+
+	c := createPluginContext("", configuration.NewFakeCoreConfig())
+	instances, err := c.ListResourceInstances(ResourceInstanceQuery{ResourceGroupID: "rg1"})
+	assert.NoError(err)
+	assert.Equal([]models.ServiceInstance{{GUID: "1", Name: "db1"}, {GUID: "2", Name: "db2"}}, instances)
+}
+
+func TestResolveServiceInstance(t *testing.T) {
+	assert := assert.New(t)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal("/v2/resource_instances", r.URL.Path)
+		assert.NotEmpty(r.Header.Get(CorrelationIDHeader))
+		q := r.URL.Query()
+
+		var instances []string
+		switch {
+		case q.Get("name") == "db" && q.Get("resource_group_id") == "rg1":
+			instances = []string{`{"guid": "1", "name": "db", "resource_group_id": "rg1"}`}
+		case q.Get("name") == "db" && q.Get("resource_group_id") == "":
+			instances = []string{
+				`{"guid": "1", "name": "db", "resource_group_id": "rg1"}`,
+				`{"guid": "2", "name": "db", "resource_group_id": "rg2"}`,
+			}
+		}
+		fmt.Fprintf(w, `{"resources": [%s]}`, strings.Join(instances, ","))
+	}))
+	defer ts.Close()
+
+	os.Setenv("RESOURCE_CONTROLLER_ENDPOINT", ts.URL)
+	defer os.Unsetenv("RESOURCE_CONTROLLER_ENDPOINT")
+
+	config := configuration.NewFakeCoreConfig()
+	c := createPluginContext("", config)
+
+	// instances with the name in multiple resource groups
+	_, err := c.ResolveServiceInstance("db")
+	if assert.IsType(&AmbiguousServiceInstanceError{}, err) {
+		assert.Len(err.(*AmbiguousServiceInstanceError).Instances, 2)
+	}
+
+	instance, err := c.ResolveServiceInstanceInResourceGroup("db", "rg1")
+	assert.NoError(err)
+	assert.Equal("1", instance.GUID)
+
+	_, err = c.ResolveServiceInstanceInResourceGroup("db", "rg3")
+	assert.Equal(&ServiceInstanceNotFoundError{Name: "db"}, err)
+
+	config.SetResourceGroup(models.ResourceGroup{GUID: "rg1", Name: "default"})
+	instance, err = c.ResolveServiceInstance("db")
+	assert.NoError(err)
+	assert.Equal("1", instance.GUID)
+
+	_, err = c.ResolveServiceInstance("cache")
+	assert.Equal(&ServiceInstanceNotFoundError{Name: "cache"}, err)
+}
+
+func TestResolveResourceGroup(t *testing.T) {
+	assert := assert.New(t)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal("/v2/resource_groups", r.URL.Path)
+		assert.NotEmpty(r.Header.Get(CorrelationIDHeader))
+		fmt.Fprint(w, `{"resources": [
+			{"id": "rg1", "name": "default", "default": true},
+			{"id": "rg2", "name": "rg1"},
+			{"id": "rg3", "name": "dev"},
+			{"id": "rg4", "name": "dev"}
+		]}`)
+	}))
+	defer ts.Close()
+
+	os.Setenv("RESOURCE_CONTROLLER_ENDPOINT", ts.URL)
+	defer os.Unsetenv("RESOURCE_CONTROLLER_ENDPOINT")
+
+	c := createPluginContext("", configuration.NewFakeCoreConfig())
+
+	group, err := c.ResolveResourceGroup("default")
+	assert.NoError(err)
+	assert.Equal(models.ResourceGroup{GUID: "rg1", Name: "default", Default: true}, group)
+
+	group, err = c.ResolveResourceGroup("rg1")
+	assert.NoError(err)
+	assert.Equal("rg1", group.GUID)
+
+	_, err = c.ResolveResourceGroup("dev")
+	assert.IsType(&AmbiguousResourceGroupError{}, err)
+
+	_, err = c.ResolveResourceGroup("prod")
+	assert.IsType(&ResourceGroupNotFoundError{}, err)
+}
+
+func TestResourceGroupFromArgs(t *testing.T) {
+	assert := assert.New(t)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"resources": [{"id": "rg1", "name": "default", "default": true}, {"id": "rg2", "name": "dev"}]}`)
+	}))
+	defer ts.Close()
+
+	os.Setenv("RESOURCE_CONTROLLER_ENDPOINT", ts.URL)
+	defer os.Unsetenv("RESOURCE_CONTROLLER_ENDPOINT")
+
+	config := configuration.NewFakeCoreConfig()
+	c := createPluginContext("", config)
+
+	group, err := ResourceGroupFromArgs(c, []string{"list"})
+	assert.NoError(err)
+	assert.Equal(models.ResourceGroup{}, group)
+
+	config.SetResourceGroup(models.ResourceGroup{GUID: "rg1", Name: "default"})
+	group, err = ResourceGroupFromArgs(c, []string{"list"})
+	assert.NoError(err)
+	assert.Equal("rg1", group.GUID)
+
+	group, err = ResourceGroupFromArgs(c, []string{"list", "-g", "dev"})
+	assert.NoError(err)
+	assert.Equal("rg2", group.GUID)
+
+	group, err = ResourceGroupFromArgs(c, []string{"list", "--resource-group=rg2"})
+	assert.NoError(err)
+	assert.Equal("dev", group.Name)
+
+	_, err = ResourceGroupFromArgs(c, []string{"list", "--resource-group", "prod"})
+	assert.IsType(&ResourceGroupNotFoundError{}, err)
+}
+
+func TestSetDefaultResourceGroup(t *testing.T) {
+	assert := assert.New(t)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"resources": [{"id": "rg1", "name": "default", "default": true}, {"id": "rg2", "name": "dev"}]}`)
+	}))
+	defer ts.Close()
+
+	os.Setenv("RESOURCE_CONTROLLER_ENDPOINT", ts.URL)
+	defer os.Unsetenv("RESOURCE_CONTROLLER_ENDPOINT")
+
+	c := createPluginContext("", configuration.NewFakeCoreConfig())
+	assert.Equal(models.ResourceGroup{}, c.DefaultResourceGroup())
+
+	group, err := c.SetDefaultResourceGroup("dev")
+	assert.NoError(err)
+	assert.Equal(models.ResourceGroup{GUID: "rg2", Name: "dev"}, group)
+	assert.Equal(group, c.DefaultResourceGroup())
+
+	_, err = c.SetDefaultResourceGroup("prod")
+	assert.IsType(&ResourceGroupNotFoundError{}, err)
+	assert.Equal(group, c.DefaultResourceGroup())
+}
+
+func TestRegionFromArgs(t *testing.T) {
+	assert := assert.New(t)
+
+	config := configuration.NewFakeCoreConfig()
+	config.SetRegion(models.Region{ID: "ibm:yp:us-south", Name: "us-south"})
+	c := createPluginContext("", config)
+
+	region, err := RegionFromArgs(c, []string{"list"})
+	assert.NoError(err)
+	assert.Equal("us-south", region.Name)
+
+	region, err = RegionFromArgs(c, []string{"list", "-r", "eu-de"})
+	assert.NoError(err)
+	assert.Equal("eu-de", region.Name)
+
+	_, err = RegionFromArgs(c, []string{"list", "--region=mars-1"})
+	assert.IsType(&InvalidRegionError{}, err)
+	assert.Contains(err.Error(), "us-south")
+}
+
+func TestDefaultRegion(t *testing.T) {
+	assert := assert.New(t)
+
+	config := configuration.NewFakeCoreConfig()
+	c := createPluginContext("", config)
+
+	_, err := c.DefaultRegion()
+	assert.Error(err)
+
+	config.SetDefaultRegion(models.Region{ID: "ibm:yp:us-south", Name: "us-south"})
+	config.SetRegion(models.Region{ID: "ibm:yp:eu-de", Name: "eu-de"})
+
+	region, err := c.DefaultRegion()
+	assert.NoError(err)
+	assert.Equal("us-south", region.Name)
+	assert.Equal("eu-de", c.CurrentRegion().Name)
+}
+
+func TestColorEnabled_NoColorFlag(t *testing.T) {
+	os.Setenv("BLUEMIX_COLOR", "true")
+	defer os.Unsetenv("BLUEMIX_COLOR")
+
+	c := createPluginContext("", configuration.NewFakeCoreConfig())
+	assert.Equal(t, "true", c.ColorEnabled())
+
+	c.args = []string{"list", "--no-color"}
+	assert.Equal(t, "false", c.ColorEnabled())
+}
+
+func TestColorEnabled_NoColorEnv(t *testing.T) {
+	os.Setenv("NO_COLOR", "1")
+	defer os.Unsetenv("NO_COLOR")
+
+	config := configuration.NewFakeCoreConfig()
+	c := createPluginContext("", config)
+	assert.Equal(t, "false", c.ColorEnabled())
+
+	config.SetColorEnabled("true")
+	assert.Equal(t, "true", c.ColorEnabled())
+}
+
+func TestIsCompletion(t *testing.T) {
+	c := createPluginContext("", configuration.NewFakeCoreConfig())
+	assert.False(t, c.IsCompletion())
+
+	os.Setenv("BLUEMIX_COMPLETION", "true")
+	defer os.Unsetenv("BLUEMIX_COMPLETION")
+	assert.True(t, c.IsCompletion())
+	assert.False(t, c.IsInteractive())
+}
+
+func TestAccountRole(t *testing.T) {
+	assert := assert.New(t)
+
+	var adminPolicy, policiesFailed bool
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.NotEmpty(r.Header.Get(CorrelationIDHeader))
+		switch r.URL.Path {
+		case "/v1/accounts/account-id":
+			fmt.Fprint(w, `{"owner_iam_id": "IBMid-owner"}`)
+		case "/v1/policies":
+			assert.Equal("account-id", r.URL.Query().Get("account_id"))
+			if policiesFailed {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			// the Administrator policy is granted via an access group
+			if !adminPolicy || r.URL.Query().Get("transitive") != "true" {
+				fmt.Fprint(w, `{"policies": []}`)
+				return
+			}
+			fmt.Fprint(w, `{"policies": [{
+				"roles": [{"role_id": "crn:v1:bluemix:public:iam::::role:Administrator"}],
+				"resources": [{"attributes": [
+					{"name": "accountId", "value": "account-id"},
+					{"name": "serviceType", "value": "platform_service"}
+				]}]
+			}]}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	os.Setenv("ACCOUNT_MANAGEMENT_ENDPOINT", ts.URL)
+	defer os.Unsetenv("ACCOUNT_MANAGEMENT_ENDPOINT")
+	os.Setenv("IAM_ENDPOINT", ts.URL)
+	defer os.Unsetenv("IAM_ENDPOINT")
+
+	config := configuration.NewFakeCoreConfig()
+	c := createPluginContext("", config)
+
+	role, err := c.AccountRole()
+	assert.Equal(AccountRoleUnknown, role)
+	assert.IsType(&AccountRoleUnknownError{}, err)
+
+	config.SetAccount(models.Account{GUID: "account-id"})
+	config.SetIAMToken(testIAMToken(time.Now().Add(time.Hour)))
+
+	role, err = c.AccountRole()
+	assert.NoError(err)
+	assert.Equal(AccountRoleMember, role)
+
+	adminPolicy = true
+	role, err = c.AccountRole()
+	assert.NoError(err)
+	assert.Equal(AccountRoleAdmin, role)
+
+	policiesFailed = true
+	role, err = c.AccountRole()
+	assert.Equal(AccountRoleUnknown, role)
+	assert.IsType(&AccountRoleUnknownError{}, err)
+}
+
+func TestAccountManagementEndpoint(t *testing.T) {
+	assert := assert.New(t)
+
+	endpoint, err := accountManagementEndpoint("https://iam.cloud.ibm.com")
+	assert.NoError(err)
+	assert.Equal("https://accounts.cloud.ibm.com", endpoint)
+
+	endpoint, err = accountManagementEndpoint("https://private.iam.cloud.ibm.com/")
+	assert.NoError(err)
+	assert.Equal("https://private.accounts.cloud.ibm.com", endpoint)
+
+	_, err = accountManagementEndpoint("https://example.com")
+	assert.Error(err)
+
+	_, err = accountManagementEndpoint("")
+	assert.Error(err)
+
+	os.Setenv("ACCOUNT_MANAGEMENT_ENDPOINT", "https://accounts.test.cloud.ibm.com/")
+	defer os.Unsetenv("ACCOUNT_MANAGEMENT_ENDPOINT")
+	endpoint, err = accountManagementEndpoint("")
+	assert.NoError(err)
+	assert.Equal("https://accounts.test.cloud.ibm.com", endpoint)
+}
+
+func TestRefreshIAMToken_ErrorResponse(t *testing.T) {
+	assert := assert.New(t)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Transaction-Id", "tx-1")
+		w.WriteHeader(http.StatusServiceUnavailable)
+		fmt.Fprint(w, `{"errorCode": "BXNIM0999E", "errorMessage": "IAM is unavailable"}`)
+	}))
+	defer ts.Close()
+
+	os.Setenv("IAM_ENDPOINT", ts.URL)
+	defer os.Unsetenv("IAM_ENDPOINT")
+
+	c := createPluginContext("", configuration.NewFakeCoreConfig())
+
+	_, err := c.RefreshIAMToken()
+	assert.IsType(&IAMTokenRefreshError{}, err)
+
+	refreshErr := err.(*IAMTokenRefreshError)
+	assert.Equal(http.StatusServiceUnavailable, refreshErr.StatusCode)
+	assert.Equal("tx-1", refreshErr.Header.Get("Transaction-Id"))
+	assert.Contains(refreshErr.Body, "BXNIM0999E")
+	assert.IsType(&authentication.ServiceUnavailableError{}, refreshErr.Err)
+	assert.IsType(&authentication.ServerError{}, refreshErr.Err.(*authentication.ServiceUnavailableError).Err)
+
+	var iamErr *authentication.IAMError
+	assert.True(errors.As(err, &iamErr))
+	assert.Equal("BXNIM0999E", iamErr.ErrorCode)
+	assert.Equal(refreshErr.Err.Error(), err.Error())
+}
+
+func TestHTTPTimeoutDuration(t *testing.T) {
+	assert := assert.New(t)
+
+	config := configuration.NewFakeCoreConfig()
+	c := createPluginContext("", config)
+
+	for timeout, expected := range map[int]time.Duration{
+		30: 30 * time.Second,
+		0:  DefaultHTTPTimeout,
+		-5: DefaultHTTPTimeout,
+	} {
+		config.SetHTTPTimeout(timeout)
+		assert.Equal(expected, c.HTTPTimeoutDuration(), "timeout %d", timeout)
+	}
+}
+
+func TestIsClassicCLIAndIsIBMCloudCLI(t *testing.T) {
+	assert := assert.New(t)
+
+	defer os.Unsetenv("BLUEMIX_CLI")
+	c := createPluginContext("", configuration.NewFakeCoreConfig())
+
+	tests := []struct {
+		cliName  string
+		classic  bool
+		ibmcloud bool
+	}{
+		{"", true, false},
+		{"bx", true, false},
+		{"bluemix", true, false},
+		{"ibmcloud", false, true},
+		{"IBMCloud", false, true},
+		{"ibmcloud.exe", false, true},
+		{"IBMCLOUD.EXE", false, true},
+		{"Bluemix.exe", true, false},
+		{"/usr/local/bin/ibmcloud", false, true},
+		{"/usr/local/bin/bx", true, false},
+		{"ibmcloud-dev", false, false},
+	}
+	for _, test := range tests {
+		os.Setenv("BLUEMIX_CLI", test.cliName)
+		assert.Equal(test.classic, c.IsClassicCLI(), test.cliName)
+		assert.Equal(test.ibmcloud, c.IsIBMCloudCLI(), test.cliName)
+	}
+}
+
+func TestRefreshTokenExpiresAt(t *testing.T) {
+	assert := assert.New(t)
+
+	config := configuration.NewFakeCoreConfig()
+	c := createPluginContext("", config)
+
+	_, err := c.RefreshTokenExpiresAt()
+	assert.Error(err)
+
+	config.SetIAMRefreshToken("refresh")
+	_, err = c.RefreshTokenExpiresAt()
+	assert.Error(err)
+
+	config.SetIAMRefreshTokenWithExpiry("refresh", 1702592000)
+	expiry, err := c.RefreshTokenExpiresAt()
+	assert.NoError(err)
+	assert.Equal(int64(1702592000), expiry.Unix())
+
+	config.SetIAMRefreshToken("new-refresh")
+	_, err = c.RefreshTokenExpiresAt()
+	assert.Error(err)
+}
+
+func TestRefreshAllTokens(t *testing.T) {
+	assert := assert.New(t)
+
+	var iamFails, uaaFails bool
+	iam := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if iamFails {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"errorCode": "BXNIM0408E", "errorMessage": "Refresh token is expired"}`)
+			return
+		}
+		fmt.Fprint(w, `{"access_token": "iam-token", "refresh_token": "iam-refresh", "token_type": "Bearer"}`)
+	}))
+	defer iam.Close()
+	uaa := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal("refresh_token", r.FormValue("grant_type"))
+		if uaaFails {
+			w.WriteHeader(http.StatusUnauthorized)
+			fmt.Fprint(w, `{"error": "invalid_token", "error_description": "invalid refresh token"}`)
+			return
+		}
+		fmt.Fprint(w, `{"access_token": "uaa-token", "refresh_token": "uaa-refresh", "token_type": "bearer"}`)
+	}))
+	defer uaa.Close()
+
+	os.Setenv("IAM_ENDPOINT", iam.URL)
+	defer os.Unsetenv("IAM_ENDPOINT")
+
+	config := newCFTestConfig("https://api.example.com")
+	config.CFConfig().SetAuthenticationEndpoint(uaa.URL)
+	config.CFConfig().SetUAARefreshToken("uaa-refresh")
+	c := createPluginContext("", config)
+
+	assert.NoError(c.RefreshAllTokens())
+	assert.Equal("Bearer iam-token", config.IAMToken())
+	assert.Equal("bearer uaa-token", config.CFConfig().UAAToken())
+
+	iamFails = true
+	err := c.RefreshAllTokens()
+	var refreshErr *TokenRefreshError
+	if assert.True(errors.As(err, &refreshErr)) {
+		assert.Error(refreshErr.IAMError)
+		assert.NoError(refreshErr.UAAError)
+	}
+
+	iamFails, uaaFails = false, true
+	err = c.RefreshAllTokens()
+	if assert.True(errors.As(err, &refreshErr)) {
+		assert.NoError(refreshErr.IAMError)
+		assert.Error(refreshErr.UAAError)
+	}
+
+	// UAA is not refreshed without a CF target
+	config.CFConfig().SetAPIEndpoint("")
+	assert.NoError(c.RefreshAllTokens())
+}
+
+func TestRefreshIAMTokenForAccount(t *testing.T) {
+	assert := assert.New(t)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.NotEmpty(r.Header.Get(CorrelationIDHeader))
+		assert.NoError(r.ParseForm())
+		assert.Equal("refresh", r.PostForm.Get("refresh_token"))
+		if r.PostForm.Get("bss_account") != "account-id" {
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(w, `{"errorCode": "BXNIM0513E", "errorMessage": "You are not authorized to use this account"}`)
+			return
+		}
+		fmt.Fprint(w, `{"access_token": "account-token", "refresh_token": "refresh", "token_type": "Bearer"}`)
+	}))
+	defer ts.Close()
+
+	os.Setenv("IAM_ENDPOINT", ts.URL)
+	defer os.Unsetenv("IAM_ENDPOINT")
+
+	config := configuration.NewFakeCoreConfig()
+	config.SetIAMRefreshToken("refresh")
+	c := createPluginContext("", config)
+
+	token, err := c.RefreshIAMTokenForAccount("account-id")
+	assert.NoError(err)
+	assert.Equal("Bearer account-token", token)
+
+	_, err = c.RefreshIAMTokenForAccount("other-account")
+	var accessErr *authentication.AccountAccessError
+	if assert.True(errors.As(err, &accessErr)) {
+		assert.Equal("other-account", accessErr.AccountID)
+		assert.Contains(accessErr.Error(), "You are not authorized to use this account")
+	}
+}
+
+func TestIAMTokenForChildAccount(t *testing.T) {
+	assert := assert.New(t)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.NoError(r.ParseForm())
+		if r.PostForm.Get("bss_account") != "child-account" {
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(w, `{"errorCode": "BXNIM0513E", "errorMessage": "You are not authorized to use this account"}`)
+			return
+		}
+		fmt.Fprint(w, `{"access_token": "child-token", "refresh_token": "refresh", "token_type": "Bearer"}`)
+	}))
+	defer ts.Close()
+
+	os.Setenv("IAM_ENDPOINT", ts.URL)
+	defer os.Unsetenv("IAM_ENDPOINT")
+
+	c := createPluginContext("", configuration.NewFakeCoreConfig())
+
+	token, err := c.IAMTokenForChildAccount("child-account")
+	assert.NoError(err)
+	assert.Equal("Bearer child-token", token)
+
+	_, err = c.IAMTokenForChildAccount("other-account")
+	assert.IsType(&authentication.EnterpriseAccessError{}, err)
+	assert.Equal("other-account", err.(*authentication.EnterpriseAccessError).AccountID)
+}
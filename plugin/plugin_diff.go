@@ -0,0 +1,107 @@
+package plugin
+
+// MetadataDiff is the difference of the commands between two versions of
+// plugin metadata, see DiffMetadata.
+type MetadataDiff struct {
+	AddedCommands   []string      // full names of commands only in the new version
+	RemovedCommands []string      // full names of commands only in the old version
+	ChangedCommands []CommandDiff // commands whose flags changed
+}
+
+// CommandDiff is the difference of the flags between two versions of a
+// command.
+type CommandDiff struct {
+	Command      string   // full name of the command
+	AddedFlags   []string // names of flags only in the new version
+	RemovedFlags []string // names of flags only in the old version
+	ChangedFlags []string // names of flags whose HasValue changed
+}
+
+// IsBreaking returns whether the flags of the command changed in a backward
+// incompatible way, i.e. flags were removed or their HasValue changed.
+func (d CommandDiff) IsBreaking() bool {
+	return len(d.RemovedFlags) > 0 || len(d.ChangedFlags) > 0
+}
+
+// IsBreaking returns whether the new version is backward incompatible with
+// the old one, i.e. commands were removed or flags were removed or changed.
+// Plugin release pipelines can fail the build if it returns true.
+func (d MetadataDiff) IsBreaking() bool {
+	if len(d.RemovedCommands) > 0 {
+		return true
+	}
+	for _, c := range d.ChangedCommands {
+		if c.IsBreaking() {
+			return true
+		}
+	}
+	return false
+}
+
+// DiffMetadata compares the commands and flags of the old and new versions of
+// the plugin metadata. Commands are matched by full name and flags by name.
+// Names are listed in the order they appear in the metadata.
+func DiffMetadata(old, new PluginMetadata) MetadataDiff {
+	var diff MetadataDiff
+
+	newCommands := make(map[string]Command)
+	for _, c := range new.Commands {
+		newCommands[c.FullName()] = c
+	}
+
+	oldCommands := make(map[string]Command)
+	for _, oldCmd := range old.Commands {
+		name := oldCmd.FullName()
+		oldCommands[name] = oldCmd
+
+		newCmd, ok := newCommands[name]
+		if !ok {
+			diff.RemovedCommands = append(diff.RemovedCommands, name)
+			continue
+		}
+
+		cmdDiff := diffFlags(oldCmd.Flags, newCmd.Flags)
+		if len(cmdDiff.AddedFlags) > 0 || len(cmdDiff.RemovedFlags) > 0 || len(cmdDiff.ChangedFlags) > 0 {
+			cmdDiff.Command = name
+			diff.ChangedCommands = append(diff.ChangedCommands, cmdDiff)
+		}
+	}
+
+	for _, c := range new.Commands {
+		if _, ok := oldCommands[c.FullName()]; !ok {
+			diff.AddedCommands = append(diff.AddedCommands, c.FullName())
+		}
+	}
+
+	return diff
+}
+
+func diffFlags(old, new []Flag) CommandDiff {
+	var diff CommandDiff
+
+	newFlags := make(map[string]Flag)
+	for _, f := range new {
+		newFlags[f.Name] = f
+	}
+
+	oldFlags := make(map[string]Flag)
+	for _, oldFlag := range old {
+		oldFlags[oldFlag.Name] = oldFlag
+
+		newFlag, ok := newFlags[oldFlag.Name]
+		switch {
+		case !ok:
+			diff.RemovedFlags = append(diff.RemovedFlags, oldFlag.Name)
+		case oldFlag.HasValue != newFlag.HasValue:
+			diff.ChangedFlags = append(diff.ChangedFlags, oldFlag.Name)
+		}
+	}
+
+	for _, f := range new {
+		if _, ok := oldFlags[f.Name]; !ok {
+			diff.AddedFlags = append(diff.AddedFlags, f.Name)
+		}
+	}
+
+	return diff
+}
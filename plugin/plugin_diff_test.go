@@ -0,0 +1,57 @@
+package plugin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffMetadata(t *testing.T) {
+	assert := assert.New(t)
+
+	old := PluginMetadata{
+		Commands: []Command{
+			{Namespace: "demo", Name: "list", Flags: []Flag{{Name: "json"}, {Name: "limit", HasValue: true}}},
+			{Namespace: "demo", Name: "delete", Flags: []Flag{{Name: "force"}}},
+			{Namespace: "demo", Name: "show", Flags: []Flag{{Name: "output", HasValue: true}}},
+		},
+	}
+	new := PluginMetadata{
+		Commands: []Command{
+			{Namespace: "demo", Name: "list", Flags: []Flag{{Name: "json"}, {Name: "limit"}, {Name: "all"}}},
+			{Namespace: "demo", Name: "show", Flags: []Flag{{Name: "output", HasValue: true}}},
+			{Namespace: "demo", Name: "create"},
+		},
+	}
+
+	diff := DiffMetadata(old, new)
+	assert.Equal([]string{"demo create"}, diff.AddedCommands)
+	assert.Equal([]string{"demo delete"}, diff.RemovedCommands)
+	assert.Equal([]CommandDiff{{
+		Command:      "demo list",
+		AddedFlags:   []string{"all"},
+		ChangedFlags: []string{"limit"},
+	}}, diff.ChangedCommands)
+	assert.True(diff.IsBreaking())
+}
+
+func TestDiffMetadata_NonBreaking(t *testing.T) {
+	assert := assert.New(t)
+
+	old := PluginMetadata{
+		Commands: []Command{{Name: "list", Flags: []Flag{{Name: "json"}}}},
+	}
+	new := PluginMetadata{
+		Commands: []Command{
+			{Name: "list", Flags: []Flag{{Name: "json"}, {Name: "limit", HasValue: true}}},
+			{Name: "create"},
+		},
+	}
+
+	diff := DiffMetadata(old, new)
+	assert.Equal([]string{"create"}, diff.AddedCommands)
+	assert.Empty(diff.RemovedCommands)
+	assert.False(diff.IsBreaking())
+
+	assert.Equal(MetadataDiff{}, DiffMetadata(old, old))
+}
@@ -0,0 +1,180 @@
+package plugin
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/bluemix/authentication"
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/common/rest"
+	. "github.com/IBM-Cloud/ibm-cloud-cli-sdk/i18n"
+)
+
+// TokenRefreshError is returned by RefreshAllTokens if the IAM token or the
+// UAA token, or both, failed to refresh.
+type TokenRefreshError struct {
+	IAMError error // error refreshing IAM token, nil if succeeded
+	UAAError error // error refreshing UAA token, nil if succeeded or skipped
+}
+
+func (e *TokenRefreshError) Error() string {
+	var msgs []string
+	if e.IAMError != nil {
+		msgs = append(msgs, T("Failed to refresh IAM token: {{.Error}}", map[string]interface{}{"Error": e.IAMError.Error()}))
+	}
+	if e.UAAError != nil {
+		msgs = append(msgs, T("Failed to refresh UAA token: {{.Error}}", map[string]interface{}{"Error": e.UAAError.Error()}))
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// IAMTokenRefreshError is returned by RefreshIAMToken if IAM responds with an
+// error. It keeps the raw HTTP response for diagnosis, e.g. to tell an IAM
+// outage (5xx) from invalid credentials (4xx). Err is the error parsed from
+// the response, e.g. *authentication.ServerError or
+// *authentication.InvalidTokenError, whose message is returned by Error.
+type IAMTokenRefreshError struct {
+	StatusCode int
+	Header     http.Header
+	Body       string
+	Err        error
+}
+
+func (e *IAMTokenRefreshError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *IAMTokenRefreshError) Unwrap() error {
+	return e.Err
+}
+
+// InvalidRegionError means the region name is unknown
+type InvalidRegionError struct {
+	Name         string
+	ValidRegions []string
+}
+
+func (e *InvalidRegionError) Error() string {
+	return T("Region '{{.Name}}' is not valid. Valid regions are: {{.Regions}}",
+		map[string]interface{}{"Name": e.Name, "Regions": strings.Join(e.ValidRegions, ", ")})
+}
+
+// errIAMEndpointNotSet returns the error for IAM endpoint not configured
+func errIAMEndpointNotSet() error {
+	return errors.New(T("IAM endpoint is not set"))
+}
+
+// ErrorJSON is the JSON representation of an error returned by MarshalError.
+type ErrorJSON struct {
+	Error      string `json:"error"`
+	Code       string `json:"code,omitempty"`
+	StatusCode int    `json:"status_code,omitempty"`
+}
+
+// MarshalError serializes the error to JSON, e.g.
+//
+//	{"error": "Token is expired", "code": "invalid_token"}
+//
+// SDK error types are converted to a structured object with error code and
+// status code; the message of an error response is the description returned
+// by the server. Other errors only contain the error message. A nil error is
+// serialized to null.
+// It is intended for plugins to report errors in JSON output mode.
+func MarshalError(err error) ([]byte, error) {
+	if err == nil {
+		return json.Marshal(nil)
+	}
+	return json.Marshal(newErrorJSON(err))
+}
+
+func newErrorJSON(err error) ErrorJSON {
+	var (
+		unavailableErr *authentication.ServiceUnavailableError
+		scopeErr       *authentication.InsufficientScopeError
+		tokenErr       *authentication.InvalidTokenError
+		serverErr      *authentication.ServerError
+		iamErr         *authentication.IAMError
+		responseErr    *rest.ErrorResponse
+		skewErr        *authentication.ClockSkewError
+	)
+
+	var j ErrorJSON
+	switch {
+	case errors.As(err, &unavailableErr):
+		j = ErrorJSON{Error: unavailableErr.Error(), Code: "service_unavailable", StatusCode: http.StatusServiceUnavailable}
+	case errors.As(err, &scopeErr):
+		j = ErrorJSON{Error: scopeErr.Error(), Code: "insufficient_scope", StatusCode: http.StatusForbidden}
+	case errors.As(err, &tokenErr):
+		j = ErrorJSON{Error: tokenErr.Description, Code: "invalid_token"}
+	case errors.As(err, &serverErr):
+		j = ErrorJSON{Error: serverErr.Description, Code: serverErr.ErrorCode, StatusCode: serverErr.StatusCode}
+	case errors.As(err, &iamErr):
+		j = ErrorJSON{Error: iamErr.Description(), Code: iamErr.ErrorCode, StatusCode: iamErr.StatusCode}
+	case errors.As(err, &responseErr):
+		j = ErrorJSON{Error: responseErr.Message, Code: "server_error", StatusCode: responseErr.StatusCode}
+	default:
+		return ErrorJSON{Error: err.Error()}
+	}
+
+	// the clock skew is the cause to fix, keep its message
+	if errors.As(err, &skewErr) {
+		j.Error = skewErr.Error()
+	}
+	return j
+}
+
+// FormatUserError returns the error message followed by a suggestion of the
+// command to resolve it if the cause is recognized, e.g.
+//
+//	Invalid token: token is expired
+//	Try: ibmcloud login
+//
+// For unknown errors, only the error message is returned.
+func FormatUserError(err error) string {
+	if err == nil {
+		return ""
+	}
+
+	command := suggestedCommand(err)
+	if command == "" {
+		return err.Error()
+	}
+	return err.Error() + "\n" + T("Try: {{.Command}}", map[string]interface{}{"Command": cliName() + " " + command})
+}
+
+func suggestedCommand(err error) string {
+	var (
+		tokenErr    *authentication.InvalidTokenError
+		accountErr  *authentication.AccountAccessError
+		serverErr   *authentication.ServerError
+		iamErr      *authentication.IAMError
+		responseErr *rest.ErrorResponse
+	)
+
+	switch {
+	case errors.As(err, &tokenErr):
+		return "login"
+	case errors.As(err, &accountErr):
+		return "target -c ACCOUNT_ID"
+	case errors.As(err, &serverErr):
+		if serverErr.StatusCode == http.StatusUnauthorized {
+			return "login"
+		}
+	case errors.As(err, &iamErr):
+		if iamErr.StatusCode == http.StatusUnauthorized {
+			return "login"
+		}
+	case errors.As(err, &responseErr):
+		if responseErr.StatusCode == http.StatusUnauthorized {
+			return "login"
+		}
+	case errors.As(err, new(*ServiceInstanceNotFoundError)), errors.As(err, new(*AmbiguousServiceInstanceError)):
+		return "resource service-instances"
+	case errors.As(err, new(*ResourceGroupNotFoundError)), errors.As(err, new(*AmbiguousResourceGroupError)):
+		return "resource groups"
+	case errors.As(err, new(*NoCFEnvironmentError)):
+		return "target --cf"
+	}
+	return ""
+}
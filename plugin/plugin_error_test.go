@@ -0,0 +1,63 @@
+package plugin
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/bluemix/authentication"
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/common/rest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMarshalError(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		err      error
+		expected string
+	}{
+		{nil, `null`},
+		{errors.New("oops"), `{"error":"oops"}`},
+		{authentication.NewInvalidTokenError("Token is expired"), `{"error":"Token is expired","code":"invalid_token"}`},
+		{&rest.ErrorResponse{StatusCode: 404, Message: "not found"}, `{"error":"not found","code":"server_error","status_code":404}`},
+		{authentication.NewServerError(500, "BXNIM0001E", "internal error"), `{"error":"internal error","code":"BXNIM0001E","status_code":500}`},
+		{&authentication.IAMError{StatusCode: 400, ErrorCode: "BXNIM0408E", ErrorMessage: "API key not found"}, `{"error":"API key not found","code":"BXNIM0408E","status_code":400}`},
+		{&IAMTokenRefreshError{StatusCode: 503, Err: &authentication.ServiceUnavailableError{}}, `{"error":"The service is temporarily unavailable. Try again later.","code":"service_unavailable","status_code":503}`},
+		{fmt.Errorf("refresh: %w", authentication.NewServerError(401, "BXNIM0418E", "expired")), `{"error":"expired","code":"BXNIM0418E","status_code":401}`},
+		{&authentication.ClockSkewError{Offset: time.Hour, Err: authentication.NewServerError(400, "BXNIM0418E", "expired")}, `{"error":"The local clock is off by 1h0m0s from the server clock, which invalidates tokens. Synchronize the system clock and try again.\nRemote server error. Status code: 400, error code: BXNIM0418E, message: expired","code":"BXNIM0418E","status_code":400}`},
+	}
+
+	for _, test := range tests {
+		b, err := MarshalError(test.err)
+		assert.NoError(err)
+		assert.Equal(test.expected, string(b))
+	}
+}
+
+func TestFormatUserError(t *testing.T) {
+	assert := assert.New(t)
+
+	os.Setenv("BLUEMIX_CLI", "ibmcloud")
+	defer os.Unsetenv("BLUEMIX_CLI")
+
+	assert.Equal("oops", FormatUserError(errors.New("oops")))
+	assert.Equal("Invalid token: expired\nTry: ibmcloud login", FormatUserError(authentication.NewInvalidTokenError("expired")))
+	assert.Equal("Service instance 'db' was not found\nTry: ibmcloud resource service-instances", FormatUserError(&ServiceInstanceNotFoundError{Name: "db"}))
+	assert.Equal("No Cloud Foundry environment is configured\nTry: ibmcloud target --cf", FormatUserError(&NoCFEnvironmentError{}))
+
+	skewErr := &authentication.ClockSkewError{Offset: time.Hour, Err: authentication.NewInvalidTokenError("expired")}
+	assert.True(strings.HasSuffix(FormatUserError(skewErr), "Invalid token: expired\nTry: ibmcloud login"))
+	assert.True(strings.HasSuffix(FormatUserError(&IAMTokenRefreshError{Err: skewErr}), "\nTry: ibmcloud login"))
+}
+
+func TestTokenRefreshError(t *testing.T) {
+	err := &TokenRefreshError{IAMError: errors.New("iam down"), UAAError: errors.New("uaa down")}
+	assert.Equal(t, "Failed to refresh IAM token: iam down; Failed to refresh UAA token: uaa down", err.Error())
+
+	err = &TokenRefreshError{UAAError: errors.New("uaa down")}
+	assert.Equal(t, "Failed to refresh UAA token: uaa down", err.Error())
+}
@@ -0,0 +1,30 @@
+package plugin
+
+import "sync"
+
+var exitHandlers struct {
+	sync.Mutex
+	handlers []func()
+}
+
+// OnExit registers a function to be called after the plugin's Run method
+// returns, e.g. to remove temporary files or stop background goroutines.
+// Handlers are called in reverse order of registration (last in, first out),
+// also if Run panics, before the plugin process exits.
+func OnExit(handler func()) {
+	exitHandlers.Lock()
+	defer exitHandlers.Unlock()
+	exitHandlers.handlers = append(exitHandlers.handlers, handler)
+}
+
+// runExitHandlers calls and unregisters the handlers registered by OnExit.
+func runExitHandlers() {
+	exitHandlers.Lock()
+	handlers := exitHandlers.handlers
+	exitHandlers.handlers = nil
+	exitHandlers.Unlock()
+
+	for i := len(handlers) - 1; i >= 0; i-- {
+		handlers[i]()
+	}
+}
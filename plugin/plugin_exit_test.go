@@ -0,0 +1,19 @@
+package plugin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOnExit(t *testing.T) {
+	var calls []int
+	OnExit(func() { calls = append(calls, 1) })
+	OnExit(func() { calls = append(calls, 2) })
+
+	runExitHandlers()
+	assert.Equal(t, []int{2, 1}, calls)
+
+	runExitHandlers()
+	assert.Equal(t, []int{2, 1}, calls)
+}
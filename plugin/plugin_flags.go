@@ -0,0 +1,25 @@
+package plugin
+
+// MergeFlags returns the flags of base followed by the extra flags. Flags
+// are de-duplicated by Name: a later flag replaces an earlier flag of the
+// same name but keeps its position. It allows flags shared by several
+// commands to be defined once, for example:
+//
+//	commonFlags := []Flag{{Name: "output", HasValue: true}}
+//	cmd.Flags = MergeFlags(commonFlags, Flag{Name: "force"})
+func MergeFlags(base []Flag, extra ...Flag) []Flag {
+	merged := make([]Flag, 0, len(base)+len(extra))
+	index := make(map[string]int)
+
+	for _, flags := range [][]Flag{base, extra} {
+		for _, f := range flags {
+			if i, found := index[f.Name]; found {
+				merged[i] = f
+				continue
+			}
+			index[f.Name] = len(merged)
+			merged = append(merged, f)
+		}
+	}
+	return merged
+}
@@ -0,0 +1,29 @@
+package plugin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMergeFlags(t *testing.T) {
+	base := []Flag{
+		{Name: "output", Description: "output format", HasValue: true},
+		{Name: "q", Description: "quiet"},
+	}
+
+	merged := MergeFlags(base,
+		Flag{Name: "force", Description: "force"},
+		Flag{Name: "output", Description: "JSON only", HasValue: true})
+
+	assert.Equal(t, []Flag{
+		{Name: "output", Description: "JSON only", HasValue: true},
+		{Name: "q", Description: "quiet"},
+		{Name: "force", Description: "force"},
+	}, merged)
+	assert.Equal(t, "output format", base[0].Description)
+}
+
+func TestMergeFlags_Empty(t *testing.T) {
+	assert.Empty(t, MergeFlags(nil))
+}
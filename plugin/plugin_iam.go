@@ -0,0 +1,242 @@
+package plugin
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/bluemix/configuration/core_config"
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/bluemix/models"
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/common/rest"
+	. "github.com/IBM-Cloud/ibm-cloud-cli-sdk/i18n"
+)
+
+const apiKeysPageSize = 100
+
+// ConflictError means the IAM resource to be created conflicts with an
+// existing one.
+type ConflictError struct {
+	Resource string // type of the resource, e.g. "API key"
+	Name     string // name of the resource
+	Message  string // message from server
+}
+
+func (e *ConflictError) Error() string {
+	return T("{{.Resource}} '{{.Name}}' conflicts with an existing one: {{.Message}}",
+		map[string]interface{}{"Resource": e.Resource, "Name": e.Name, "Message": e.Message})
+}
+
+// AccessGroupMemberFailure is a member failed to be added to an access group
+type AccessGroupMemberFailure struct {
+	IAMID      string
+	StatusCode int
+	Message    string
+}
+
+// AccessGroupMembersError means some members failed to be added to an access
+// group while the others were added.
+type AccessGroupMembersError struct {
+	GroupID  string
+	Failures []AccessGroupMemberFailure
+}
+
+func (e *AccessGroupMembersError) Error() string {
+	var ids []string
+	for _, f := range e.Failures {
+		ids = append(ids, f.IAMID)
+	}
+	return T("Failed to add members to access group '{{.GroupID}}': {{.Members}}",
+		map[string]interface{}{"GroupID": e.GroupID, "Members": strings.Join(ids, ", ")})
+}
+
+type addMembersResponse struct {
+	Members []struct {
+		IAMID      string `json:"iam_id"`
+		StatusCode int    `json:"status_code"`
+		Errors     []struct {
+			Code    string `json:"code"`
+			Message string `json:"message"`
+		} `json:"errors"`
+	} `json:"members"`
+}
+
+type apiKeysResponse struct {
+	Next    string          `json:"next"`
+	APIKeys []models.APIKey `json:"apikeys"`
+}
+
+// ListAPIKeys returns the API keys of the logged in user in the current
+// account. It calls the IAM identity API with the IAM token of the context
+// and fetches all pages.
+func ListAPIKeys(ctx PluginContext) ([]models.APIKey, error) {
+	if ctx.IAMEndpoint() == "" {
+		return nil, errIAMEndpointNotSet()
+	}
+
+	query := url.Values{}
+	query.Set("account_id", ctx.CurrentAccount().GUID)
+	query.Set("iam_id", core_config.NewIAMTokenInfo(ctx.IAMToken()).IAMID)
+	query.Set("pagesize", fmt.Sprint(apiKeysPageSize))
+	next := ctx.IAMEndpoint() + "/v1/apikeys?" + query.Encode()
+
+	client := NewClientFromContext(ctx)
+	var apiKeys []models.APIKey
+	for next != "" {
+		req := rest.GetRequest(next).Set("Authorization", ctx.IAMToken())
+
+		var resp apiKeysResponse
+		if _, err := client.Do(req, &resp, nil); err != nil {
+			return nil, err
+		}
+
+		apiKeys = append(apiKeys, resp.APIKeys...)
+		next = resp.Next
+	}
+	return apiKeys, nil
+}
+
+// CreateServiceIDAPIKey creates an API key named keyName for the service ID
+// named serviceIDName in the current account. The service ID is created if
+// it doesn't exist. The API key secret is only available in the Value field
+// of the returned API key. A ConflictError is returned if IAM reports a
+// conflict.
+func CreateServiceIDAPIKey(ctx PluginContext, serviceIDName, keyName string) (models.APIKey, error) {
+	if ctx.IAMEndpoint() == "" {
+		return models.APIKey{}, errIAMEndpointNotSet()
+	}
+
+	client := NewClientFromContext(ctx)
+	accountID := ctx.CurrentAccount().GUID
+
+	serviceID, err := findOrCreateServiceID(ctx, client, accountID, serviceIDName)
+	if err != nil {
+		return models.APIKey{}, err
+	}
+
+	req := rest.PostRequest(ctx.IAMEndpoint()+"/v1/apikeys").
+		Set("Authorization", ctx.IAMToken()).
+		Body(map[string]interface{}{
+			"name":        keyName,
+			"iam_id":      serviceID.IAMID,
+			"account_id":  accountID,
+			"store_value": false,
+		})
+
+	var apiKey models.APIKey
+	if _, err := client.Do(req, &apiKey, nil); err != nil {
+		return models.APIKey{}, conflictError(err, "API key", keyName)
+	}
+	return apiKey, nil
+}
+
+func findOrCreateServiceID(ctx PluginContext, client *rest.Client, accountID, name string) (models.ServiceID, error) {
+	req := rest.GetRequest(ctx.IAMEndpoint()+"/v1/serviceids").
+		Set("Authorization", ctx.IAMToken()).
+		Query("account_id", accountID).
+		Query("name", name)
+
+	var list struct {
+		ServiceIDs []models.ServiceID `json:"serviceids"`
+	}
+	if _, err := client.Do(req, &list, nil); err != nil {
+		return models.ServiceID{}, err
+	}
+	for _, s := range list.ServiceIDs {
+		if s.Name == name {
+			return s, nil
+		}
+	}
+
+	req = rest.PostRequest(ctx.IAMEndpoint()+"/v1/serviceids").
+		Set("Authorization", ctx.IAMToken()).
+		Body(map[string]string{
+			"account_id": accountID,
+			"name":       name,
+		})
+
+	var serviceID models.ServiceID
+	if _, err := client.Do(req, &serviceID, nil); err != nil {
+		return models.ServiceID{}, conflictError(err, "Service ID", name)
+	}
+	return serviceID, nil
+}
+
+// CreateAccessGroup creates an access group in the current account. A
+// ConflictError is returned if an access group has the name.
+func CreateAccessGroup(ctx PluginContext, name string, description string) (models.AccessGroup, error) {
+	if ctx.IAMEndpoint() == "" {
+		return models.AccessGroup{}, errIAMEndpointNotSet()
+	}
+
+	req := rest.PostRequest(ctx.IAMEndpoint()+"/v2/groups").
+		Set("Authorization", ctx.IAMToken()).
+		Query("account_id", ctx.CurrentAccount().GUID).
+		Body(map[string]string{
+			"name":        name,
+			"description": description,
+		})
+
+	var group models.AccessGroup
+	if _, err := NewClientFromContext(ctx).Do(req, &group, nil); err != nil {
+		return models.AccessGroup{}, conflictError(err, "Access group", name)
+	}
+	return group, nil
+}
+
+// AddAccessGroupMembers adds users or service IDs to the access group. If
+// some members failed to be added, an AccessGroupMembersError is returned
+// listing them while the others are added.
+func AddAccessGroupMembers(ctx PluginContext, groupID string, members ...models.AccessGroupMember) error {
+	if ctx.IAMEndpoint() == "" {
+		return errIAMEndpointNotSet()
+	}
+
+	req := rest.PutRequest(ctx.IAMEndpoint()+"/v2/groups/"+url.PathEscape(groupID)+"/members").
+		Set("Authorization", ctx.IAMToken()).
+		Body(map[string]interface{}{
+			"members": members,
+		})
+
+	var resp addMembersResponse
+	if _, err := NewClientFromContext(ctx).Do(req, &resp, nil); err != nil {
+		return err
+	}
+
+	var failures []AccessGroupMemberFailure
+	for _, m := range resp.Members {
+		if m.StatusCode >= 200 && m.StatusCode <= 299 {
+			continue
+		}
+		f := AccessGroupMemberFailure{IAMID: m.IAMID, StatusCode: m.StatusCode}
+		if len(m.Errors) > 0 {
+			f.Message = m.Errors[0].Message
+		}
+		failures = append(failures, f)
+	}
+	if len(failures) > 0 {
+		return &AccessGroupMembersError{GroupID: groupID, Failures: failures}
+	}
+	return nil
+}
+
+// RemoveAccessGroupMember removes the user or service ID whose IAM ID is
+// iamID from the access group.
+func RemoveAccessGroupMember(ctx PluginContext, groupID string, iamID string) error {
+	if ctx.IAMEndpoint() == "" {
+		return errIAMEndpointNotSet()
+	}
+
+	req := rest.DeleteRequest(ctx.IAMEndpoint()+"/v2/groups/"+url.PathEscape(groupID)+"/members/"+url.PathEscape(iamID)).
+		Set("Authorization", ctx.IAMToken())
+
+	_, err := NewClientFromContext(ctx).Do(req, nil, nil)
+	return err
+}
+
+func conflictError(err error, resource string, name string) error {
+	if e, ok := err.(*rest.ErrorResponse); ok && e.StatusCode == http.StatusConflict {
+		return &ConflictError{Resource: resource, Name: name, Message: e.Message}
+	}
+	return err
+}
@@ -0,0 +1,142 @@
+package plugin
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/bluemix/models"
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/testhelpers/configuration"
+)
+
+func TestListAPIKeys(t *testing.T) {
+	assert := assert.New(t)
+
+	var ts *httptest.Server
+	ts = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal("/v1/apikeys", r.URL.Path)
+		assert.Equal("account-id", r.URL.Query().Get("account_id"))
+		assert.Equal("test", r.URL.Query().Get("iam_id"))
+		assert.NotEmpty(r.Header.Get(CorrelationIDHeader))
+
+		if r.URL.Query().Get("pagetoken") == "" {
+			fmt.Fprintf(w, `{"next": "%s/v1/apikeys?account_id=account-id&iam_id=test&pagetoken=2", "apikeys": [{"id": "ApiKey-1", "name": "key1", "created_at": "2019-10-23T20:35+0000"}]}`, ts.URL)
+			return
+		}
+		fmt.Fprint(w, `{"apikeys": [{"id": "ApiKey-2", "name": "key2", "created_at": "2019-10-24T08:00+0000"}]}`)
+	}))
+	defer ts.Close()
+
+	config := configuration.NewFakeCoreConfig()
+	config.SetIAMEndpoint(ts.URL)
+	config.SetIAMToken(testIAMToken(time.Now().Add(time.Hour)))
+	config.SetAccount(models.Account{GUID: "account-id"})
+
+	apiKeys, err := ListAPIKeys(createPluginContext("", config))
+	assert.NoError(err)
+	if assert.Len(apiKeys, 2) {
+		assert.Equal("ApiKey-1", apiKeys[0].ID)
+		assert.True(time.Date(2019, 10, 23, 20, 35, 0, 0, time.UTC).Equal(apiKeys[0].CreatedAt.Time))
+		assert.Equal("ApiKey-2", apiKeys[1].ID)
+	}
+}
+
+func TestCreateServiceIDAPIKey(t *testing.T) {
+	assert := assert.New(t)
+
+	var serviceIDCreated bool
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.NotEmpty(r.Header.Get(CorrelationIDHeader))
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/serviceids":
+			assert.Equal("automation", r.URL.Query().Get("name"))
+			fmt.Fprint(w, `{"serviceids": []}`)
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/serviceids":
+			serviceIDCreated = true
+			fmt.Fprint(w, `{"id": "ServiceId-1", "name": "automation", "iam_id": "iam-ServiceId-1", "created_at": "2019-10-23T20:35+0000"}`)
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/apikeys":
+			var body map[string]interface{}
+			assert.NoError(json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal("iam-ServiceId-1", body["iam_id"])
+			assert.Equal("account-id", body["account_id"])
+
+			if body["name"] == "existing" {
+				w.WriteHeader(http.StatusConflict)
+				fmt.Fprint(w, `{"errorMessage": "API key already exists"}`)
+				return
+			}
+			fmt.Fprint(w, `{"id": "ApiKey-1", "name": "key", "iam_id": "iam-ServiceId-1", "apikey": "secret", "created_at": "2019-10-23T20:35+0000"}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	config := configuration.NewFakeCoreConfig()
+	config.SetIAMEndpoint(ts.URL)
+	config.SetAccount(models.Account{GUID: "account-id"})
+	c := createPluginContext("", config)
+
+	apiKey, err := CreateServiceIDAPIKey(c, "automation", "key")
+	assert.NoError(err)
+	assert.True(serviceIDCreated)
+	assert.Equal("ApiKey-1", apiKey.ID)
+	assert.Equal("secret", apiKey.Value)
+
+	_, err = CreateServiceIDAPIKey(c, "automation", "existing")
+	assert.IsType(&ConflictError{}, err)
+}
+
+func TestAccessGroups(t *testing.T) {
+	assert := assert.New(t)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/v2/groups":
+			assert.Equal("account-id", r.URL.Query().Get("account_id"))
+			fmt.Fprint(w, `{"id": "AccessGroupId-1", "name": "admins", "account_id": "account-id", "created_at": "2019-10-23T20:35+0000"}`)
+		case r.Method == http.MethodPut && r.URL.Path == "/v2/groups/AccessGroupId-1/members":
+			var body struct {
+				Members []models.AccessGroupMember `json:"members"`
+			}
+			assert.NoError(json.NewDecoder(r.Body).Decode(&body))
+			assert.Len(body.Members, 2)
+
+			w.WriteHeader(http.StatusMultiStatus)
+			fmt.Fprint(w, `{"members": [
+				{"iam_id": "IBMid-1", "type": "user", "status_code": 200},
+				{"iam_id": "iam-ServiceId-1", "type": "service", "status_code": 404, "errors": [{"code": "not_found", "message": "Service ID not found"}]}
+			]}`)
+		case r.Method == http.MethodDelete && r.URL.Path == "/v2/groups/AccessGroupId-1/members/IBMid-1":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	config := configuration.NewFakeCoreConfig()
+	config.SetIAMEndpoint(ts.URL)
+	config.SetAccount(models.Account{GUID: "account-id"})
+	c := createPluginContext("", config)
+
+	group, err := CreateAccessGroup(c, "admins", "")
+	assert.NoError(err)
+	assert.Equal("AccessGroupId-1", group.ID)
+	assert.True(time.Date(2019, 10, 23, 20, 35, 0, 0, time.UTC).Equal(group.CreatedAt.Time))
+
+	err = AddAccessGroupMembers(c, group.ID,
+		models.AccessGroupMember{IAMID: "IBMid-1", Type: models.AccessGroupMemberUser},
+		models.AccessGroupMember{IAMID: "iam-ServiceId-1", Type: models.AccessGroupMemberService})
+	assert.IsType(&AccessGroupMembersError{}, err)
+	assert.Equal([]AccessGroupMemberFailure{{IAMID: "iam-ServiceId-1", StatusCode: 404, Message: "Service ID not found"}},
+		err.(*AccessGroupMembersError).Failures)
+
+	assert.NoError(RemoveAccessGroupMember(c, group.ID, "IBMid-1"))
+}
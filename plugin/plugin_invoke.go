@@ -0,0 +1,34 @@
+package plugin
+
+import (
+	"bytes"
+	"os"
+	"os/exec"
+)
+
+// InvokePlugin runs a CLI command, e.g. a command of another installed
+// plugin, and returns its output and exit code:
+//
+//	stdout, _, code, err := plugin.InvokePlugin(c, []string{"cs", "clusters", "--json"})
+//
+// The CLI is run with the environment of the current process and shares the
+// CLI configuration, so the current target (account, region, resource
+// group) applies unless overridden by args. err is only returned if the CLI
+// could not be run; a command failure is reported by a non-zero exitCode.
+func InvokePlugin(c PluginContext, args []string) (stdout []byte, stderr []byte, exitCode int, err error) {
+	var outBuf, errBuf bytes.Buffer
+
+	cmd := exec.Command(c.CLIName(), args...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = &outBuf
+	cmd.Stderr = &errBuf
+
+	err = cmd.Run()
+	if exitErr, ok := err.(*exec.ExitError); ok {
+		return outBuf.Bytes(), errBuf.Bytes(), exitErr.ExitCode(), nil
+	}
+	if err != nil {
+		return nil, nil, -1, err
+	}
+	return outBuf.Bytes(), errBuf.Bytes(), 0, nil
+}
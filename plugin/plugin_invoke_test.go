@@ -0,0 +1,38 @@
+package plugin
+
+import (
+	"os"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/testhelpers/configuration"
+)
+
+func TestInvokePlugin(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("requires sh")
+	}
+	assert := assert.New(t)
+
+	os.Setenv("BLUEMIX_CLI", "sh")
+	defer os.Unsetenv("BLUEMIX_CLI")
+
+	c := createPluginContext("", configuration.NewFakeCoreConfig())
+	stdout, stderr, code, err := InvokePlugin(c, []string{"-c", "echo out; echo err >&2; exit 3"})
+	assert.NoError(err)
+	assert.Equal("out\n", string(stdout))
+	assert.Equal("err\n", string(stderr))
+	assert.Equal(3, code)
+}
+
+func TestInvokePlugin_CLINotFound(t *testing.T) {
+	os.Setenv("BLUEMIX_CLI", "no-such-cli-binary")
+	defer os.Unsetenv("BLUEMIX_CLI")
+
+	c := createPluginContext("", configuration.NewFakeCoreConfig())
+	_, _, code, err := InvokePlugin(c, []string{"version"})
+	assert.Error(t, err)
+	assert.Equal(t, -1, code)
+}
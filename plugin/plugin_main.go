@@ -0,0 +1,106 @@
+package plugin
+
+import (
+	"errors"
+	"fmt"
+	"os"
+)
+
+// RunEPlugin is implemented by a plugin whose Run method returns an error
+// instead of exiting the process. See Main.
+type RunEPlugin interface {
+	RunE(c PluginContext, args []string) error
+}
+
+// ExitError is an error with the exit code of the plugin process, see
+// ExitCode. If Err is nil, the plugin exits with Code without reporting an
+// error.
+type ExitError struct {
+	Code int
+	Err  error
+}
+
+func (e *ExitError) Error() string {
+	if e.Err == nil {
+		return fmt.Sprintf("exit status %d", e.Code)
+	}
+	return e.Err.Error()
+}
+
+func (e *ExitError) Unwrap() error {
+	return e.Err
+}
+
+// ExitCode returns the exit code of the plugin process for the error
+// returned by RunE: 0 if err is nil, the code of an ExitError, or 1 for
+// other errors.
+func ExitCode(err error) int {
+	if err == nil {
+		return 0
+	}
+
+	var exitErr *ExitError
+	if errors.As(err, &exitErr) {
+		return exitErr.Code
+	}
+	return 1
+}
+
+// Main runs the plugin and exits the process, replacing the boilerplate in
+// the plugin's main function:
+//
+//	func main() {
+//		plugin.Main(new(MyPlugin))
+//	}
+//
+// If the plugin implements RunEPlugin, RunE is called instead of Run. The
+// returned error is reported to the user, see FormatUserError, or as JSON in
+// JSON output mode, see MarshalError, and the process exits with
+// ExitCode(err). A panic is recovered as by Start.
+//
+// Use Start or StartWithArgs for more control, e.g. to run code after the
+// plugin returns.
+func Main(p Plugin) {
+	m := &mainPlugin{Plugin: p}
+	Start(m)
+	os.Exit(m.exitCode)
+}
+
+// mainPlugin runs RunE of the wrapped plugin if implemented and records the
+// exit code.
+type mainPlugin struct {
+	Plugin
+	exitCode int
+}
+
+func (m *mainPlugin) Run(c PluginContext, args []string) {
+	p, ok := m.Plugin.(RunEPlugin)
+	if !ok {
+		m.Plugin.Run(c, args)
+		return
+	}
+
+	err := p.RunE(c, args)
+	m.exitCode = ExitCode(err)
+	if err != nil {
+		reportError(c, err)
+	}
+}
+
+// reportError writes the error to the output of the plugin context. An
+// ExitError without an error is not reported.
+func reportError(c PluginContext, err error) {
+	var exitErr *ExitError
+	if errors.As(err, &exitErr) && exitErr.Err == nil {
+		return
+	}
+
+	if isJSONOutput(contextArgs(c)) {
+		b, jsonErr := MarshalError(err)
+		if jsonErr == nil {
+			fmt.Fprintln(c.Stdout(), string(b))
+			return
+		}
+	}
+	NewUI(c).Failed("%s", FormatUserError(err))
+}
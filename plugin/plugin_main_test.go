@@ -0,0 +1,90 @@
+package plugin
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/testhelpers/configuration"
+	"github.com/stretchr/testify/assert"
+)
+
+type runEPlugin struct {
+	err error
+}
+
+func (p *runEPlugin) GetMetadata() PluginMetadata { return PluginMetadata{Name: "test"} }
+func (p *runEPlugin) Run(c PluginContext, args []string) {
+	panic("Run must not be called")
+}
+func (p *runEPlugin) RunE(c PluginContext, args []string) error {
+	fmt.Fprint(c.Stdout(), "output")
+	return p.err
+}
+
+type runPlugin struct {
+	ran bool
+}
+
+func (p *runPlugin) GetMetadata() PluginMetadata { return PluginMetadata{Name: "test"} }
+func (p *runPlugin) Run(c PluginContext, args []string) {
+	p.ran = true
+}
+
+func TestExitCode(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal(0, ExitCode(nil))
+	assert.Equal(1, ExitCode(errors.New("failed")))
+	assert.Equal(3, ExitCode(&ExitError{Code: 3}))
+	assert.Equal(4, ExitCode(fmt.Errorf("wrapped: %w", &ExitError{Code: 4, Err: errors.New("failed")})))
+}
+
+func TestMainPlugin_RunE(t *testing.T) {
+	assert := assert.New(t)
+
+	var out, errOut bytes.Buffer
+	pc := createPluginContext("", configuration.NewFakeCoreConfig())
+	c := WithOutput(pc, &out, &errOut)
+
+	m := &mainPlugin{Plugin: &runEPlugin{}}
+	m.Run(c, nil)
+	assert.Equal(0, m.exitCode)
+	assert.Equal("output", out.String())
+
+	out.Reset()
+	m = &mainPlugin{Plugin: &runEPlugin{err: errors.New("quota 100%s exceeded")}}
+	m.Run(c, nil)
+	assert.Equal(1, m.exitCode)
+	assert.Contains(out.String(), "FAILED")
+	assert.Contains(out.String(), "quota 100%s exceeded")
+
+	out.Reset()
+	m = &mainPlugin{Plugin: &runEPlugin{err: &ExitError{Code: 5}}}
+	m.Run(c, nil)
+	assert.Equal(5, m.exitCode)
+	assert.Equal("output", out.String())
+}
+
+func TestMainPlugin_RunE_JSONOutput(t *testing.T) {
+	assert := assert.New(t)
+
+	var out bytes.Buffer
+	pc := createPluginContext("", configuration.NewFakeCoreConfig())
+	pc.args = []string{"list", "--output", "json"}
+	c := WithOutput(pc, &out, &out)
+
+	m := &mainPlugin{Plugin: &runEPlugin{err: errors.New("something went wrong")}}
+	m.Run(c, pc.args)
+	assert.Equal(1, m.exitCode)
+	assert.Equal("output{\"error\":\"something went wrong\"}\n", out.String())
+}
+
+func TestMainPlugin_Run(t *testing.T) {
+	p := &runPlugin{}
+	m := &mainPlugin{Plugin: p}
+	m.Run(createPluginContext("", configuration.NewFakeCoreConfig()), nil)
+	assert.True(t, p.ran)
+	assert.Equal(t, 0, m.exitCode)
+}
@@ -0,0 +1,103 @@
+package plugin
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/mattn/go-colorable"
+
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/bluemix/terminal"
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/i18n"
+)
+
+func (c *pluginContext) Stdout() io.Writer {
+	return colorable.NewColorableStdout()
+}
+
+func (c *pluginContext) Stderr() io.Writer {
+	return colorable.NewColorableStderr()
+}
+
+func (c *pluginContext) Warn(format string, args ...interface{}) {
+	printWarning(c, c.Stderr(), format, args...)
+}
+
+func (c *pluginContext) Info(format string, args ...interface{}) {
+	printInfo(c, c.Stderr(), format, args...)
+}
+
+type outputContext struct {
+	PluginContext
+	stdout io.Writer
+	stderr io.Writer
+}
+
+func (c outputContext) Stdout() io.Writer { return c.stdout }
+func (c outputContext) Stderr() io.Writer { return c.stderr }
+
+func (c outputContext) Warn(format string, args ...interface{}) {
+	printWarning(c, c.stderr, format, args...)
+}
+
+func (c outputContext) Info(format string, args ...interface{}) {
+	printInfo(c, c.stderr, format, args...)
+}
+
+// WithOutput returns a copy of the plugin context whose Stdout and Stderr
+// return the given writers, e.g. to capture the output of a command in
+// tests.
+func WithOutput(ctx PluginContext, stdout io.Writer, stderr io.Writer) PluginContext {
+	return outputContext{PluginContext: ctx, stdout: stdout, stderr: stderr}
+}
+
+// NewUI creates a terminal UI reading from stdin and writing to the standard
+// output of the plugin context.
+func NewUI(ctx PluginContext) terminal.UI {
+	return terminal.NewUI(os.Stdin, ctx.Stdout())
+}
+
+// PrintKeyValue writes the key/value sections to the standard output of the
+// plugin context with the values aligned, see terminal.PrintKeyValueSections.
+// In quiet mode (flag -q or --quiet) the section headers and the blank lines
+// between sections are left out, so that only the pairs are written.
+func PrintKeyValue(ctx PluginContext, sections ...terminal.KeyValueSection) {
+	if isQuiet(contextArgs(ctx)) {
+		var pairs [][2]string
+		for _, s := range sections {
+			pairs = append(pairs, s.Pairs...)
+		}
+		sections = []terminal.KeyValueSection{{Pairs: pairs}}
+	}
+	terminal.PrintKeyValueSections(ctx.Stdout(), sections)
+}
+
+// printWarning writes the warning message prefixed with "WARNING:" to w. The
+// prefix is not colored in JSON output mode.
+func printWarning(ctx PluginContext, w io.Writer, format string, args ...interface{}) {
+	prefix := i18n.T("WARNING:")
+	if !isJSONOutput(contextArgs(ctx)) {
+		prefix = terminal.WarningColor(prefix)
+	}
+	fmt.Fprintln(w, prefix, fmt.Sprintf(format, args...))
+}
+
+// printInfo writes the informational message prefixed with "INFO:" to w.
+// Nothing is written in quiet mode.
+func printInfo(ctx PluginContext, w io.Writer, format string, args ...interface{}) {
+	if isQuiet(contextArgs(ctx)) {
+		return
+	}
+	fmt.Fprintln(w, i18n.T("INFO:"), fmt.Sprintf(format, args...))
+}
+
+// contextArgs returns the command line arguments of the plugin context.
+func contextArgs(ctx PluginContext) []string {
+	switch c := ctx.(type) {
+	case *pluginContext:
+		return c.args
+	case outputContext:
+		return contextArgs(c.PluginContext)
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package plugin
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/bluemix/terminal"
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/testhelpers/configuration"
+)
+
+func TestWithOutput(t *testing.T) {
+	assert := assert.New(t)
+
+	stdout, stderr := new(bytes.Buffer), new(bytes.Buffer)
+	c := WithOutput(createPluginContext("", configuration.NewFakeCoreConfig()), stdout, stderr)
+
+	NewUI(c).Say("hello")
+	c.Stderr().Write([]byte("oops"))
+
+	assert.Equal("hello\n", stdout.String())
+	assert.Equal("oops", stderr.String())
+}
+
+func TestWarnAndInfo(t *testing.T) {
+	assert := assert.New(t)
+
+	stdout, stderr := new(bytes.Buffer), new(bytes.Buffer)
+	pc := createPluginContext("", configuration.NewFakeCoreConfig())
+	pc.args = []string{"--output", "json"}
+	c := WithOutput(pc, stdout, stderr)
+
+	c.Warn("instance %s is deprecated", "foo")
+	c.Info("using region %s", "us-south")
+
+	assert.Empty(stdout.String())
+	assert.Equal("WARNING: instance foo is deprecated\nINFO: using region us-south\n", stderr.String())
+}
+
+func TestWarnAndInfo_Quiet(t *testing.T) {
+	assert := assert.New(t)
+
+	stdout, stderr := new(bytes.Buffer), new(bytes.Buffer)
+	pc := createPluginContext("", configuration.NewFakeCoreConfig())
+	pc.args = []string{"-q"}
+	c := WithOutput(pc, stdout, stderr)
+
+	c.Info("using region %s", "us-south")
+	c.Warn("instance %s is deprecated", "foo")
+
+	assert.Empty(stdout.String())
+	assert.Equal("WARNING: instance foo is deprecated\n", terminal.Decolorize(stderr.String()))
+}
+
+func TestPrintKeyValue(t *testing.T) {
+	assert := assert.New(t)
+
+	sections := []terminal.KeyValueSection{
+		{Pairs: [][2]string{{"Name", "my-db"}}},
+		{Header: "Last Operation", Pairs: [][2]string{{"Status", "succeeded"}}},
+	}
+
+	stdout := new(bytes.Buffer)
+	pc := createPluginContext("", configuration.NewFakeCoreConfig())
+	PrintKeyValue(WithOutput(pc, stdout, new(bytes.Buffer)), sections...)
+	assert.Equal("Name:     my-db\n\nLast Operation\nStatus:   succeeded\n", terminal.Decolorize(stdout.String()))
+
+	stdout.Reset()
+	pc.args = []string{"--quiet"}
+	PrintKeyValue(WithOutput(pc, stdout, new(bytes.Buffer)), sections...)
+	assert.Equal("Name:     my-db\nStatus:   succeeded\n", terminal.Decolorize(stdout.String()))
+}
@@ -0,0 +1,68 @@
+package plugin
+
+import (
+	"fmt"
+	"os"
+	"runtime/debug"
+	"sync"
+
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/bluemix/trace"
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/i18n"
+)
+
+// PanicExitCode is the exit code of the plugin process if the plugin panics
+const PanicExitCode = 2
+
+// PanicHandler is called with the recovered value when the plugin panics.
+// The returned message, if not empty, is shown to the user in addition to
+// the error, e.g. the operation in progress or how to recover.
+type PanicHandler func(recovered interface{}) string
+
+var panicHandler struct {
+	sync.RWMutex
+	handler PanicHandler
+}
+
+// SetPanicHandler sets the handler called when the plugin panics. By
+// default, only the error is shown to the user.
+func SetPanicHandler(handler PanicHandler) {
+	panicHandler.Lock()
+	defer panicHandler.Unlock()
+	panicHandler.handler = handler
+}
+
+// recoverPanic recovers from a panic of the plugin, shows a concise error to
+// the user and exits with PanicExitCode. The stack trace is written to the
+// trace log only, i.e. if trace is enabled.
+func recoverPanic(context PluginContext) {
+	r := recover()
+	if r == nil {
+		return
+	}
+
+	trace.NewLogger(context.Trace()).Printf("%v\n%s", r, trace.Sanitize(string(debug.Stack())))
+
+	reportPanic(context, r)
+	os.Exit(PanicExitCode)
+}
+
+// reportPanic writes the error of the recovered value to the output of the
+// plugin context.
+func reportPanic(context PluginContext, r interface{}) {
+	NewUI(context).Failed("%s", panicMessage(r))
+}
+
+func panicMessage(r interface{}) string {
+	message := i18n.T("An unexpected error occurred: {{.Error}}", map[string]interface{}{"Error": trace.Sanitize(fmt.Sprint(r))})
+
+	panicHandler.RLock()
+	handler := panicHandler.handler
+	panicHandler.RUnlock()
+
+	if handler != nil {
+		if extra := handler(r); extra != "" {
+			message += "\n" + extra
+		}
+	}
+	return message
+}
@@ -0,0 +1,39 @@
+package plugin
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/testhelpers/configuration"
+)
+
+func TestPanicMessage(t *testing.T) {
+	assert.Equal(t, "An unexpected error occurred: boom", panicMessage(errors.New("boom")))
+}
+
+func TestPanicMessage_Handler(t *testing.T) {
+	SetPanicHandler(func(r interface{}) string {
+		return fmt.Sprintf("while processing %v", r)
+	})
+	defer SetPanicHandler(nil)
+
+	assert.Equal(t, "An unexpected error occurred: boom\nwhile processing boom", panicMessage("boom"))
+}
+
+func TestReportPanic(t *testing.T) {
+	SetPanicHandler(func(r interface{}) string {
+		return "retry with 100% of the quota"
+	})
+	defer SetPanicHandler(nil)
+
+	var out bytes.Buffer
+	c := WithOutput(createPluginContext("", configuration.NewFakeCoreConfig()), &out, new(bytes.Buffer))
+
+	reportPanic(c, "index %d out of range")
+	assert.Contains(t, out.String(), "FAILED")
+	assert.Contains(t, out.String(), "An unexpected error occurred: index %d out of range\nretry with 100% of the quota")
+}
@@ -0,0 +1,48 @@
+package plugin
+
+import (
+	"context"
+	"sync"
+)
+
+// ParallelDo runs the tasks with at most concurrency tasks at a time and
+// returns the error of each task in the same order as tasks. If concurrency
+// is not positive, all tasks run at once. When ctx is cancelled, tasks not
+// yet started are not run and their error is the context error.
+func ParallelDo(ctx context.Context, concurrency int, tasks []func() error) []error {
+	errs := make([]error, len(tasks))
+	if concurrency <= 0 || concurrency > len(tasks) {
+		concurrency = len(tasks)
+	}
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, concurrency)
+
+	for i, task := range tasks {
+		select {
+		case <-ctx.Done():
+			errs[i] = ctx.Err()
+			continue
+		case sem <- struct{}{}:
+		}
+
+		// the context may be cancelled while waiting for a worker
+		if err := ctx.Err(); err != nil {
+			<-sem
+			errs[i] = err
+			continue
+		}
+
+		wg.Add(1)
+		go func(i int, task func() error) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			errs[i] = task()
+		}(i, task)
+	}
+
+	wg.Wait()
+	return errs
+}
@@ -0,0 +1,48 @@
+package plugin
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParallelDo(t *testing.T) {
+	assert := assert.New(t)
+
+	var running, maxRunning int32
+	task := func(err error) func() error {
+		return func() error {
+			n := atomic.AddInt32(&running, 1)
+			for {
+				m := atomic.LoadInt32(&maxRunning)
+				if n <= m || atomic.CompareAndSwapInt32(&maxRunning, m, n) {
+					break
+				}
+			}
+			time.Sleep(5 * time.Millisecond)
+			atomic.AddInt32(&running, -1)
+			return err
+		}
+	}
+
+	failure := errors.New("failed")
+	errs := ParallelDo(context.Background(), 2, []func() error{task(nil), task(failure), task(nil), task(nil)})
+	assert.Equal([]error{nil, failure, nil, nil}, errs)
+	assert.True(maxRunning <= 2)
+}
+
+func TestParallelDo_Cancelled(t *testing.T) {
+	assert := assert.New(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	called := false
+	errs := ParallelDo(ctx, 1, []func() error{func() error { called = true; return nil }})
+	assert.False(called)
+	assert.Equal([]error{context.Canceled}, errs)
+}
@@ -0,0 +1,39 @@
+package plugin
+
+import (
+	"context"
+	"time"
+
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/common/wait"
+)
+
+// defaultPollInterval is the interval of PollUntil if the given one is not
+// positive
+const defaultPollInterval = time.Second
+
+// PollUntil calls check every interval until it returns done, for example to
+// wait until a provisioned resource becomes active. An interval <= 0 is
+// replaced with one second, so that the service is not polled without pause.
+// Errors returned by check are treated as transient and polling continues.
+// When ctx is cancelled or times out, PollUntil returns the last error
+// returned by check, or the context error if check never failed.
+func PollUntil(ctx context.Context, interval time.Duration, check func() (done bool, err error)) error {
+	if interval <= 0 {
+		interval = defaultPollInterval
+	}
+
+	var lastErr error
+	err := wait.NewBackoff(interval, interval, 1).Retry(ctx, func() (bool, error) {
+		done, err := check()
+		if err != nil {
+			lastErr = err
+			return false, nil
+		}
+		return done, nil
+	})
+
+	if err != nil && lastErr != nil {
+		return lastErr
+	}
+	return err
+}
@@ -0,0 +1,61 @@
+package plugin
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPollUntil(t *testing.T) {
+	assert := assert.New(t)
+
+	n := 0
+	err := PollUntil(context.Background(), time.Millisecond, func() (bool, error) {
+		n++
+		if n == 2 {
+			return false, errors.New("temporary error")
+		}
+		return n == 5, nil
+	})
+	assert.NoError(err)
+	assert.Equal(5, n)
+}
+
+func TestPollUntil_Timeout(t *testing.T) {
+	assert := assert.New(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	err := PollUntil(ctx, time.Millisecond, func() (bool, error) {
+		return false, nil
+	})
+	assert.Equal(context.DeadlineExceeded, err)
+
+	ctx, cancel = context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	checkErr := errors.New("resource is not ready")
+	err = PollUntil(ctx, time.Millisecond, func() (bool, error) {
+		return false, checkErr
+	})
+	assert.Equal(checkErr, err)
+}
+
+func TestPollUntil_InvalidInterval(t *testing.T) {
+	assert := assert.New(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	n := 0
+	err := PollUntil(ctx, 0, func() (bool, error) {
+		n++
+		return false, nil
+	})
+	assert.Equal(context.DeadlineExceeded, err)
+	assert.Equal(1, n)
+}
@@ -0,0 +1,87 @@
+package plugin
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+)
+
+// ProgressEvent is a progress update of a long operation, written by
+// ProgressReporter to stderr as a line of JSON (NDJSON) in JSON output mode:
+//
+//	{"type":"progress","time":"2026-01-02T15:04:05Z","message":"Uploading image","current":50,"total":100}
+//
+// Type is always "progress". Current and Total are omitted if the progress
+// is not measurable, e.g. for a status update like "Waiting for instance".
+type ProgressEvent struct {
+	Type    string    `json:"type"`
+	Time    time.Time `json:"time"`
+	Message string    `json:"message"`
+	Current int64     `json:"current,omitempty"`
+	Total   int64     `json:"total,omitempty"`
+}
+
+// ProgressReporter reports the progress of a long operation to Stderr of the
+// plugin context, so that the output of the command on stdout is not
+// corrupted:
+//   - in JSON output mode (flag --output json or --json), a ProgressEvent is
+//     written per update if JSONEvents is true, otherwise nothing is written
+//   - in quiet mode (flag -q or --quiet), nothing is written
+//   - otherwise, the message is written as plain text, e.g.
+//     "Uploading image (50/100)"
+//
+// It is safe for concurrent use.
+type ProgressReporter struct {
+	// JSONEvents enables writing ProgressEvent in JSON output mode
+	JSONEvents bool
+
+	w     io.Writer
+	json  bool
+	quiet bool
+	lock  sync.Mutex
+}
+
+// NewProgressReporter creates a ProgressReporter for the plugin context.
+func NewProgressReporter(c PluginContext) *ProgressReporter {
+	args := contextArgs(c)
+	return &ProgressReporter{
+		w:     c.Stderr(),
+		json:  isJSONOutput(args),
+		quiet: isQuiet(args),
+	}
+}
+
+// Status reports the formatted status message of the operation.
+func (p *ProgressReporter) Status(format string, args ...interface{}) {
+	p.report(ProgressEvent{Message: fmt.Sprintf(format, args...)})
+}
+
+// Progress reports that current out of total units of work are done, with
+// the formatted message.
+func (p *ProgressReporter) Progress(current int64, total int64, format string, args ...interface{}) {
+	p.report(ProgressEvent{Message: fmt.Sprintf(format, args...), Current: current, Total: total})
+}
+
+func (p *ProgressReporter) report(e ProgressEvent) {
+	if p.quiet || (p.json && !p.JSONEvents) {
+		return
+	}
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if p.json {
+		e.Type = "progress"
+		e.Time = time.Now().UTC()
+		json.NewEncoder(p.w).Encode(e)
+		return
+	}
+
+	if e.Total > 0 {
+		fmt.Fprintf(p.w, "%s (%d/%d)\n", e.Message, e.Current, e.Total)
+	} else {
+		fmt.Fprintln(p.w, e.Message)
+	}
+}
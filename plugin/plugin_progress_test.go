@@ -0,0 +1,66 @@
+package plugin
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/testhelpers/configuration"
+)
+
+func newProgressTestContext(args ...string) (PluginContext, *bytes.Buffer, *bytes.Buffer) {
+	pc := createPluginContext("", configuration.NewFakeCoreConfig())
+	pc.args = args
+
+	stdout, stderr := new(bytes.Buffer), new(bytes.Buffer)
+	return WithOutput(pc, stdout, stderr), stdout, stderr
+}
+
+func TestProgressReporter(t *testing.T) {
+	assert := assert.New(t)
+
+	c, stdout, stderr := newProgressTestContext()
+	p := NewProgressReporter(c)
+	p.Status("Waiting for instance %s", "foo")
+	p.Progress(50, 100, "Uploading image")
+
+	assert.Empty(stdout.String())
+	assert.Equal("Waiting for instance foo\nUploading image (50/100)\n", stderr.String())
+}
+
+func TestProgressReporter_JSON(t *testing.T) {
+	assert := assert.New(t)
+
+	c, stdout, stderr := newProgressTestContext("--output", "json")
+	p := NewProgressReporter(c)
+	p.Status("ignored")
+	assert.Empty(stderr.String())
+
+	p.JSONEvents = true
+	p.Status("Waiting for instance")
+	p.Progress(50, 100, "Uploading image")
+	assert.Empty(stdout.String())
+
+	lines := strings.Split(strings.TrimSpace(stderr.String()), "\n")
+	assert.Len(lines, 2)
+
+	var e ProgressEvent
+	assert.NoError(json.Unmarshal([]byte(lines[0]), &e))
+	assert.Equal("progress", e.Type)
+	assert.Equal("Waiting for instance", e.Message)
+	assert.NotContains(lines[0], "current")
+
+	assert.NoError(json.Unmarshal([]byte(lines[1]), &e))
+	assert.Equal(int64(50), e.Current)
+	assert.Equal(int64(100), e.Total)
+	assert.False(e.Time.IsZero())
+}
+
+func TestProgressReporter_Quiet(t *testing.T) {
+	c, _, stderr := newProgressTestContext("-q")
+	NewProgressReporter(c).Status("Waiting for instance")
+	assert.Empty(t, stderr.String())
+}
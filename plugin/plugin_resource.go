@@ -0,0 +1,271 @@
+package plugin
+
+import (
+	"errors"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/bluemix/models"
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/common/rest"
+	. "github.com/IBM-Cloud/ibm-cloud-cli-sdk/i18n"
+)
+
+const defaultResourceInstancesPageSize = 100
+
+// ResourceInstanceQuery is the filter of listing resource instances. Empty
+// fields are not used for filtering.
+type ResourceInstanceQuery struct {
+	ResourceGroupID string // ID of the resource group
+	ResourceID      string // ID of the service in the global catalog
+	Name            string // name of the instance
+	Limit           int    // page size of each request, default is 100
+}
+
+func (q ResourceInstanceQuery) values() url.Values {
+	v := url.Values{}
+	if q.ResourceGroupID != "" {
+		v.Set("resource_group_id", q.ResourceGroupID)
+	}
+	if q.ResourceID != "" {
+		v.Set("resource_id", q.ResourceID)
+	}
+	if q.Name != "" {
+		v.Set("name", q.Name)
+	}
+
+	limit := q.Limit
+	if limit <= 0 {
+		limit = defaultResourceInstancesPageSize
+	}
+	v.Set("limit", strconv.Itoa(limit))
+	return v
+}
+
+// ServiceInstanceNotFoundError means no service instance has the given name
+type ServiceInstanceNotFoundError struct {
+	Name string
+}
+
+func (e *ServiceInstanceNotFoundError) Error() string {
+	return T("Service instance '{{.Name}}' was not found", map[string]interface{}{"Name": e.Name})
+}
+
+// AmbiguousServiceInstanceError means multiple service instances have the
+// given name
+type AmbiguousServiceInstanceError struct {
+	Name      string
+	Instances []models.ServiceInstance
+}
+
+func (e *AmbiguousServiceInstanceError) Error() string {
+	return T("Multiple service instances named '{{.Name}}' were found, specify the resource group or use the CRN instead", map[string]interface{}{"Name": e.Name})
+}
+
+// ResourceGroupNotFoundError means no resource group has the given name or
+// ID
+type ResourceGroupNotFoundError struct {
+	NameOrID string
+}
+
+func (e *ResourceGroupNotFoundError) Error() string {
+	return T("Resource group '{{.Name}}' was not found", map[string]interface{}{"Name": e.NameOrID})
+}
+
+// AmbiguousResourceGroupError means multiple resource groups have the given
+// name
+type AmbiguousResourceGroupError struct {
+	Name   string
+	Groups []models.ResourceGroup
+}
+
+func (e *AmbiguousResourceGroupError) Error() string {
+	return T("Multiple resource groups named '{{.Name}}' were found, use the resource group ID instead", map[string]interface{}{"Name": e.Name})
+}
+
+type resourceGroupsResponse struct {
+	Resources []struct {
+		ID      string `json:"id"`
+		Name    string `json:"name"`
+		State   string `json:"state"`
+		Default bool   `json:"default"`
+		QuotaID string `json:"quota_id"`
+	} `json:"resources"`
+}
+
+type resourceInstancesResponse struct {
+	NextURL   string                   `json:"next_url"`
+	Resources []models.ServiceInstance `json:"resources"`
+}
+
+func (c *pluginContext) ListResourceInstances(q ResourceInstanceQuery) ([]models.ServiceInstance, error) {
+	endpoint, err := resourceControllerEndpoint(c)
+	if err != nil {
+		return nil, err
+	}
+
+	client := NewClientFromContext(c)
+	next := "/v2/resource_instances?" + q.values().Encode()
+
+	var instances []models.ServiceInstance
+	for next != "" {
+		req := rest.GetRequest(endpoint+next).Set("Authorization", c.IAMToken())
+
+		var resp resourceInstancesResponse
+		if _, err := client.Do(req, &resp, nil); err != nil {
+			return nil, err
+		}
+
+		instances = append(instances, resp.Resources...)
+		next = resp.NextURL
+	}
+	return instances, nil
+}
+
+func (c *pluginContext) ResolveServiceInstance(name string) (models.ServiceInstance, error) {
+	var resourceGroupID string
+	if c.HasTargetedResourceGroup() {
+		resourceGroupID = c.CurrentResourceGroup().GUID
+	}
+	return c.ResolveServiceInstanceInResourceGroup(name, resourceGroupID)
+}
+
+func (c *pluginContext) ResolveServiceInstanceInResourceGroup(name string, resourceGroupID string) (models.ServiceInstance, error) {
+	instances, err := c.ListResourceInstances(ResourceInstanceQuery{
+		ResourceGroupID: resourceGroupID,
+		Name:            name,
+	})
+	if err != nil {
+		return models.ServiceInstance{}, err
+	}
+
+	switch len(instances) {
+	case 0:
+		return models.ServiceInstance{}, &ServiceInstanceNotFoundError{Name: name}
+	case 1:
+		return instances[0], nil
+	default:
+		return models.ServiceInstance{}, &AmbiguousServiceInstanceError{Name: name, Instances: instances}
+	}
+}
+
+func (c *pluginContext) ResolveResourceGroup(nameOrID string) (models.ResourceGroup, error) {
+	return c.resolveResourceGroup(c.CurrentAccount().GUID, c.IAMToken(), nameOrID)
+}
+
+// resolveResourceGroup resolves the resource group in the account using the
+// IAM token.
+func (c *pluginContext) resolveResourceGroup(accountID string, iamToken string, nameOrID string) (models.ResourceGroup, error) {
+	groups, err := c.listResourceGroups(accountID, iamToken)
+	if err != nil {
+		return models.ResourceGroup{}, err
+	}
+
+	for _, g := range groups {
+		if g.GUID == nameOrID {
+			return g, nil
+		}
+	}
+
+	var matches []models.ResourceGroup
+	for _, g := range groups {
+		if g.Name == nameOrID {
+			matches = append(matches, g)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return models.ResourceGroup{}, &ResourceGroupNotFoundError{NameOrID: nameOrID}
+	case 1:
+		return matches[0], nil
+	default:
+		return models.ResourceGroup{}, &AmbiguousResourceGroupError{Name: nameOrID, Groups: matches}
+	}
+}
+
+// ResourceGroupFromArgs returns the resource group given by flag -g or
+// --resource-group in the command line arguments, resolved by ID or name as
+// ResolveResourceGroup does, so that commands can override the targeted
+// resource group per invocation. If the flag is absent, the targeted resource
+// group is returned, or a zero value if none is targeted.
+func ResourceGroupFromArgs(c PluginContext, args []string) (models.ResourceGroup, error) {
+	if nameOrID, ok := flagValue(args, "-g", "--resource-group"); ok {
+		return c.ResolveResourceGroup(nameOrID)
+	}
+	if c.HasTargetedResourceGroup() {
+		return c.CurrentResourceGroup(), nil
+	}
+	return models.ResourceGroup{}, nil
+}
+
+func (c *pluginContext) DefaultResourceGroup() models.ResourceGroup {
+	return c.ReadWriter.DefaultResourceGroup()
+}
+
+func (c *pluginContext) SetDefaultResourceGroup(nameOrID string) (models.ResourceGroup, error) {
+	group, err := c.ResolveResourceGroup(nameOrID)
+	if err != nil {
+		return models.ResourceGroup{}, err
+	}
+
+	c.ReadWriter.SetDefaultResourceGroup(group)
+	return group, nil
+}
+
+// listResourceGroups returns the resource groups in the account using the
+// IAM token
+func (c *pluginContext) listResourceGroups(accountID string, iamToken string) ([]models.ResourceGroup, error) {
+	endpoint, err := resourceControllerEndpoint(c)
+	if err != nil {
+		return nil, err
+	}
+
+	req := rest.GetRequest(endpoint+"/v2/resource_groups").
+		Query("account_id", accountID).
+		Set("Authorization", iamToken)
+
+	var resp resourceGroupsResponse
+	if _, err := NewClientFromContext(c).Do(req, &resp, nil); err != nil {
+		return nil, err
+	}
+
+	groups := make([]models.ResourceGroup, 0, len(resp.Resources))
+	for _, r := range resp.Resources {
+		groups = append(groups, models.ResourceGroup{
+			GUID:    r.ID,
+			Name:    r.Name,
+			State:   r.State,
+			Default: r.Default,
+			QuotaID: r.QuotaID,
+		})
+	}
+	return groups, nil
+}
+
+// resourceControllerEndpoint returns the resource controller endpoint
+// resolved from the IAM endpoint, e.g.
+// https://resource-controller.cloud.ibm.com for https://iam.cloud.ibm.com.
+// It can be overridden by environment variable RESOURCE_CONTROLLER_ENDPOINT.
+func resourceControllerEndpoint(c PluginContext) (string, error) {
+	if endpoint := os.Getenv("RESOURCE_CONTROLLER_ENDPOINT"); endpoint != "" {
+		return normalizeEndpoint(endpoint), nil
+	}
+
+	u, err := url.Parse(c.IAMEndpoint())
+	if err != nil || u.Host == "" {
+		return "", errIAMEndpointNotSet()
+	}
+
+	switch {
+	case strings.HasPrefix(u.Host, "iam."):
+		u.Host = "resource-controller." + strings.TrimPrefix(u.Host, "iam.")
+	case strings.HasPrefix(u.Host, "private.iam."):
+		u.Host = "private.resource-controller." + strings.TrimPrefix(u.Host, "private.iam.")
+	default:
+		return "", errors.New(T("Unable to resolve resource controller endpoint from IAM endpoint '{{.Endpoint}}'", map[string]interface{}{"Endpoint": c.IAMEndpoint()}))
+	}
+	u.Path = ""
+	return u.String(), nil
+}
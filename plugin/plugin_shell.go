@@ -0,0 +1,67 @@
+package plugin
+
+import (
+	"regexp"
+	"strings"
+)
+
+// safeShellArg matches arguments that need no quoting in POSIX shells
+var safeShellArg = regexp.MustCompile(`^[A-Za-z0-9_@%+=:,./-]+$`)
+
+// ShellQuote returns the command line of the arguments quoted by POSIX
+// shell rules, so that it can be copied and pasted into a shell, e.g.
+//
+//	ShellQuote([]string{"ibmcloud", "resource", "service-instance", "my db"})
+//	// ibmcloud resource service-instance 'my db'
+func ShellQuote(args []string) string {
+	quoted := make([]string, len(args))
+	for i, arg := range args {
+		quoted[i] = shellQuoteArg(arg)
+	}
+	return strings.Join(quoted, " ")
+}
+
+func shellQuoteArg(arg string) string {
+	if safeShellArg.MatchString(arg) {
+		return arg
+	}
+	return "'" + strings.Replace(arg, "'", `'\''`, -1) + "'"
+}
+
+// ShellQuoteWindows is the same as ShellQuote but quotes the arguments by
+// the rules of Windows command line parsing (CommandLineToArgvW).
+func ShellQuoteWindows(args []string) string {
+	quoted := make([]string, len(args))
+	for i, arg := range args {
+		quoted[i] = windowsQuoteArg(arg)
+	}
+	return strings.Join(quoted, " ")
+}
+
+func windowsQuoteArg(arg string) string {
+	if arg != "" && !strings.ContainsAny(arg, " \t\n\v\"") {
+		return arg
+	}
+
+	var b strings.Builder
+	b.WriteByte('"')
+	backslashes := 0
+	for _, r := range arg {
+		switch r {
+		case '\\':
+			backslashes++
+			continue
+		case '"':
+			// backslashes preceding a quote and the quote are escaped
+			b.WriteString(strings.Repeat(`\`, backslashes*2+1))
+		default:
+			b.WriteString(strings.Repeat(`\`, backslashes))
+		}
+		backslashes = 0
+		b.WriteRune(r)
+	}
+	// backslashes preceding the closing quote are escaped
+	b.WriteString(strings.Repeat(`\`, backslashes*2))
+	b.WriteByte('"')
+	return b.String()
+}
@@ -0,0 +1,26 @@
+package plugin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShellQuote(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal("ibmcloud login --sso", ShellQuote([]string{"ibmcloud", "login", "--sso"}))
+	assert.Equal("ibmcloud target -g 'my group'", ShellQuote([]string{"ibmcloud", "target", "-g", "my group"}))
+	assert.Equal(`echo 'it'\''s'`, ShellQuote([]string{"echo", "it's"}))
+	assert.Equal(`echo '"quoted"' '$HOME' 'a;b' '*' ''`, ShellQuote([]string{"echo", `"quoted"`, "$HOME", "a;b", "*", ""}))
+	assert.Equal("--name=db-1 key:value", ShellQuote([]string{"--name=db-1", "key:value"}))
+}
+
+func TestShellQuoteWindows(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal("ibmcloud login --sso", ShellQuoteWindows([]string{"ibmcloud", "login", "--sso"}))
+	assert.Equal(`ibmcloud target -g "my group"`, ShellQuoteWindows([]string{"ibmcloud", "target", "-g", "my group"}))
+	assert.Equal(`echo "say \"hi\"" ""`, ShellQuoteWindows([]string{"echo", `say "hi"`, ""}))
+	assert.Equal(`C:\dir\file "C:\my dir\\" "a\\\"b"`, ShellQuoteWindows([]string{`C:\dir\file`, `C:\my dir\`, `a\"b`}))
+}
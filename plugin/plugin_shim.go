@@ -2,11 +2,18 @@ package plugin
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
 	"os"
+	"strconv"
+	"strings"
 
 	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/bluemix"
 	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/bluemix/configuration/config_helpers"
 	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/bluemix/configuration/core_config"
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/bluemix/consts"
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/bluemix/terminal"
 	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/i18n"
 )
 
@@ -18,23 +25,117 @@ func Start(plugin Plugin) {
 // StartWithArgs starts the plugin with the given arguments.
 func StartWithArgs(plugin Plugin, args []string) {
 	if isMetadataRequest(args) {
-		metadata := fillMetadata(plugin.GetMetadata())
-		json, err := json.Marshal(metadata)
-		if err != nil {
-			panic(err)
+		if err := sendMetadata(plugin, os.Stdout, os.Stderr); err != nil {
+			os.Exit(1)
 		}
-		os.Stdout.Write(json)
 		return
 	}
 
-	context := InitPluginContext(plugin.GetMetadata().Name)
+	context := initPluginContext(plugin.GetMetadata())
+	context.args = args
+	defer recoverPanic(context)
 
 	// initialization
 	i18n.T = i18n.Tfunc(context.Locale())
+	if hasFlag(args, "--no-color") {
+		terminal.NoColorFlag = true
+		terminal.InitColorSupport()
+	}
+
+	if err := checkMinCliVersion(plugin.GetMetadata(), context); err != nil {
+		NewUI(context).Failed("%s", err.Error())
+		runExitHandlers()
+		os.Exit(1)
+	}
+
+	// the warning goes to stderr so that it doesn't break JSON output
+	if msg := sdkVersionWarning(fillMetadata(plugin.GetMetadata())); msg != "" {
+		context.Warn("%s", msg)
+	}
 
+	defer runExitHandlers()
 	plugin.Run(context, args)
 }
 
+// sendMetadata writes the plugin metadata as JSON to w for the CLI to install
+// the plugin. The metadata is validated first, see ValidateMetadata: if it
+// is invalid, the error is written to errW and nothing is sent, so that the
+// plugin can't be installed. Warnings are written to errW.
+func sendMetadata(plugin Plugin, w io.Writer, errW io.Writer) error {
+	metadata := fillMetadata(plugin.GetMetadata())
+
+	warnings, err := ValidateMetadata(metadata)
+	if err != nil {
+		fmt.Fprintln(errW, err.Error())
+		return err
+	}
+	for _, warning := range warnings {
+		fmt.Fprintln(errW, warning)
+	}
+
+	json, err := json.Marshal(metadata)
+	if err != nil {
+		panic(err)
+	}
+	w.Write(json)
+	return nil
+}
+
+// sdkVersionWarning returns a warning message if the plugin is built with an
+// SDK older than the version set in environment variable
+// BLUEMIX_MIN_PLUGIN_SDK_VERSION, otherwise an empty string.
+func sdkVersionWarning(metadata PluginMetadata) string {
+	min := os.Getenv(consts.ENV_BLUEMIX_MIN_PLUGIN_SDK_VERSION)
+	if metadata.SDKVersionSupported(min) {
+		return ""
+	}
+	return i18n.T("Plugin '{{.Name}}' is built with SDK version {{.Version}} which is older than the recommended version {{.MinVersion}}. Update the plugin to the latest version.",
+		map[string]interface{}{
+			"Name":       metadata.Name,
+			"Version":    metadata.SDKVersion.String(),
+			"MinVersion": min,
+		})
+}
+
+// checkMinCliVersion returns an error if the running CLI is older than the
+// minimal CLI version required by the plugin. The check is skipped if the CLI
+// version is empty or not a dotted numeric version, or environment variable BLUEMIX_SKIP_CLI_VERSION_CHECK
+// is set.
+func checkMinCliVersion(metadata PluginMetadata, context PluginContext) error {
+	if os.Getenv(consts.ENV_BLUEMIX_SKIP_CLI_VERSION_CHECK) != "" {
+		return nil
+	}
+
+	cliVersion := context.CLIVersion()
+	if !isNumericVersion(cliVersion) {
+		return nil
+	}
+
+	if compareVersion(cliVersion, metadata.MinCliVersion.String()) < 0 {
+		return errors.New(i18n.T("Plugin '{{.Name}}' requires {{.CLIName}} CLI version {{.MinVersion}} or later, but the current version is {{.Version}}. Update the CLI and try again.",
+			map[string]interface{}{
+				"Name":       metadata.Name,
+				"CLIName":    context.CLIName(),
+				"MinVersion": metadata.MinCliVersion.String(),
+				"Version":    cliVersion,
+			}))
+	}
+	return nil
+}
+
+// isNumericVersion returns whether v is a dotted numeric version, e.g. 2.1.0
+func isNumericVersion(v string) bool {
+	if v == "" {
+		return false
+	}
+	for _, part := range strings.Split(v, ".") {
+		if _, err := strconv.Atoi(part); err != nil {
+			return false
+		}
+	}
+	return true
+}
+
 func fillMetadata(metadata PluginMetadata) PluginMetadata {
 	sdkVersion := bluemix.Version
 	metadata.SDKVersion = VersionType{
@@ -45,14 +146,23 @@ func fillMetadata(metadata PluginMetadata) PluginMetadata {
 	return metadata
 }
 
-// InitPluginContext initializes a plugin context for a given plugin
+// InitPluginContext initializes a plugin context for a given plugin. The
+// SDK's messages are translated to the locale of the context.
 func InitPluginContext(pluginName string) PluginContext {
+	context := initPluginContext(PluginMetadata{Name: pluginName})
+	i18n.T = i18n.Tfunc(context.Locale())
+	return context
+}
+
+func initPluginContext(metadata PluginMetadata) *pluginContext {
 	coreConfig := core_config.NewCoreConfig(
 		func(err error) {
 			panic("configuration error: " + err.Error())
 		})
-	pluginPath := config_helpers.PluginDir(pluginName)
-	return createPluginContext(pluginPath, coreConfig)
+	pluginPath := config_helpers.PluginDir(metadata.Name)
+	context := createPluginContext(pluginPath, coreConfig)
+	context.metadata = metadata
+	return context
 }
 
 func isMetadataRequest(args []string) bool {
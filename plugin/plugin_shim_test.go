@@ -0,0 +1,97 @@
+package plugin
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/testhelpers/configuration"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSDKVersionSupported(t *testing.T) {
+	assert := assert.New(t)
+
+	m := PluginMetadata{SDKVersion: VersionType{Major: 0, Minor: 1, Build: 5}}
+	assert.True(m.SDKVersionSupported(""))
+	assert.True(m.SDKVersionSupported("0.1.5"))
+	assert.True(m.SDKVersionSupported("0.1.1"))
+	assert.False(m.SDKVersionSupported("0.2.0"))
+}
+
+func TestSDKVersionWarning(t *testing.T) {
+	m := PluginMetadata{Name: "test", SDKVersion: VersionType{Major: 0, Minor: 1, Build: 5}}
+
+	os.Setenv("BLUEMIX_MIN_PLUGIN_SDK_VERSION", "0.2.0")
+	defer os.Unsetenv("BLUEMIX_MIN_PLUGIN_SDK_VERSION")
+	assert.Contains(t, sdkVersionWarning(m), "0.1.5")
+
+	os.Setenv("BLUEMIX_MIN_PLUGIN_SDK_VERSION", "0.1.0")
+	assert.Empty(t, sdkVersionWarning(m))
+}
+
+func TestCheckMinCliVersion(t *testing.T) {
+	assert := assert.New(t)
+
+	os.Unsetenv("BLUEMIX_SKIP_CLI_VERSION_CHECK")
+	defer os.Unsetenv("BLUEMIX_CLI_VERSION")
+
+	c := createPluginContext("", configuration.NewFakeCoreConfig())
+	m := PluginMetadata{Name: "test", MinCliVersion: VersionType{Major: 1, Minor: 2, Build: 0}}
+
+	for _, v := range []string{"1.2.0", "1.2.1", "1.10.0", "2.0"} {
+		os.Setenv("BLUEMIX_CLI_VERSION", v)
+		assert.NoError(checkMinCliVersion(m, c), v)
+	}
+
+	for _, v := range []string{"1.1.9", "1.1", "0.9.0"} {
+		os.Setenv("BLUEMIX_CLI_VERSION", v)
+		err := checkMinCliVersion(m, c)
+		if assert.Error(err, v) {
+			assert.Contains(err.Error(), v)
+			assert.Contains(err.Error(), "1.2.0")
+		}
+	}
+
+	// the check is skipped if the CLI version is unknown
+	for _, v := range []string{"", "dev", "1.1.0-beta"} {
+		os.Setenv("BLUEMIX_CLI_VERSION", v)
+		assert.NoError(checkMinCliVersion(m, c), v)
+	}
+
+	os.Setenv("BLUEMIX_CLI_VERSION", "1.0.0")
+	os.Setenv("BLUEMIX_SKIP_CLI_VERSION_CHECK", "true")
+	defer os.Unsetenv("BLUEMIX_SKIP_CLI_VERSION_CHECK")
+	assert.NoError(checkMinCliVersion(m, c))
+}
+
+type metadataPlugin struct {
+	runPlugin
+	metadata PluginMetadata
+}
+
+func (p *metadataPlugin) GetMetadata() PluginMetadata { return p.metadata }
+
+func TestSendMetadata(t *testing.T) {
+	assert := assert.New(t)
+
+	p := &metadataPlugin{metadata: PluginMetadata{
+		Name:     "test",
+		Commands: []Command{{Name: "list", Usage: "list [--all]"}},
+	}}
+
+	var out, errOut bytes.Buffer
+	assert.NoError(sendMetadata(p, &out, &errOut))
+	var m PluginMetadata
+	assert.NoError(json.Unmarshal(out.Bytes(), &m))
+	assert.Equal("test", m.Name)
+	assert.Contains(errOut.String(), "'--all'")
+
+	out.Reset()
+	errOut.Reset()
+	p.metadata.Commands = []Command{{Name: "List"}}
+	assert.Error(sendMetadata(p, &out, &errOut))
+	assert.Empty(out.String())
+	assert.Contains(errOut.String(), "Invalid command 'List'")
+}
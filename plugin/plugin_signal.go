@@ -0,0 +1,42 @@
+package plugin
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+)
+
+// NotifyContext returns a context that is cancelled when the plugin receives
+// SIGINT or SIGTERM, so that long-running operations can clean up on Ctrl-C.
+// The returned stop function releases the resources and restores the default
+// signal behavior. It should be called once the context is no longer used:
+//
+//	ctx, stop := plugin.NotifyContext()
+//	defer stop()
+func NotifyContext() (context.Context, func()) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	c := make(chan os.Signal, 1)
+	signal.Notify(c, os.Interrupt, syscall.SIGTERM)
+
+	done := make(chan struct{})
+	go func() {
+		select {
+		case <-c:
+			cancel()
+		case <-done:
+		}
+	}()
+
+	var once sync.Once
+	stop := func() {
+		once.Do(func() {
+			signal.Stop(c)
+			close(done)
+			cancel()
+		})
+	}
+	return ctx, stop
+}
@@ -0,0 +1,39 @@
+//go:build !windows
+// +build !windows
+
+package plugin
+
+import (
+	"context"
+	"os"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotifyContext(t *testing.T) {
+	assert := assert.New(t)
+
+	ctx, stop := NotifyContext()
+	defer stop()
+
+	assert.NoError(syscall.Kill(os.Getpid(), syscall.SIGTERM))
+
+	select {
+	case <-ctx.Done():
+		assert.Equal(context.Canceled, ctx.Err())
+	case <-time.After(5 * time.Second):
+		t.Fatal("context is not cancelled on SIGTERM")
+	}
+}
+
+func TestNotifyContext_Stop(t *testing.T) {
+	assert := assert.New(t)
+
+	ctx, stop := NotifyContext()
+	stop()
+	assert.NotPanics(stop)
+	assert.Equal(context.Canceled, ctx.Err())
+}
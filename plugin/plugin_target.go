@@ -0,0 +1,180 @@
+package plugin
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/bluemix/authentication"
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/bluemix/models"
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/common/rest"
+	. "github.com/IBM-Cloud/ibm-cloud-cli-sdk/i18n"
+)
+
+// Target is the snapshot of the target of the CLI session returned by
+// ExportTarget. Empty fields are not targeted.
+type Target struct {
+	Region        models.Region             `json:"region"`
+	Account       models.Account            `json:"account"`
+	ResourceGroup models.ResourceGroup      `json:"resource_group"`
+	CFAPIEndpoint string                    `json:"cf_api_endpoint,omitempty"`
+	CFOrg         models.OrganizationFields `json:"cf_org"`
+	CFSpace       models.SpaceFields        `json:"cf_space"`
+}
+
+// TargetNotFoundError means an entity of the imported target doesn't exist
+// any more.
+type TargetNotFoundError struct {
+	Type string // type of the entity, e.g. "Cloud Foundry org"
+	Name string // name of the entity
+}
+
+func (e *TargetNotFoundError) Error() string {
+	return T("{{.Type}} '{{.Name}}' of the target was not found", map[string]interface{}{"Type": e.Type, "Name": e.Name})
+}
+
+func (c *pluginContext) ExportTarget() ([]byte, error) {
+	t := Target{
+		Region:        c.CurrentRegion(),
+		Account:       c.CurrentAccount(),
+		ResourceGroup: c.CurrentResourceGroup(),
+		CFOrg:         c.CF().CurrentOrganization(),
+		CFSpace:       c.CF().CurrentSpace(),
+	}
+	if c.HasTargetedCF() {
+		t.CFAPIEndpoint = c.CF().APIEndpoint()
+	}
+	return json.Marshal(t)
+}
+
+func (c *pluginContext) ImportTarget(data []byte) error {
+	var t Target
+	if err := json.Unmarshal(data, &t); err != nil {
+		return errors.New(T("Invalid target: {{.Error}}", map[string]interface{}{"Error": err.Error()}))
+	}
+
+	if t.Region.Name != "" {
+		if _, ok := models.RegionByName(t.Region.Name); !ok {
+			return &InvalidRegionError{Name: t.Region.Name, ValidRegions: models.KnownRegions()}
+		}
+	}
+
+	var iamToken *authentication.Token
+	accountID := c.CurrentAccount().GUID
+	if t.Account.GUID != "" && t.Account.GUID != accountID {
+		token, err := c.refreshIAMTokenForAccount(t.Account.GUID, func(description string) error {
+			return authentication.NewAccountAccessError(t.Account.GUID, description)
+		})
+		if err != nil {
+			return err
+		}
+		iamToken = &token
+		accountID = t.Account.GUID
+	}
+
+	token := c.IAMToken()
+	if iamToken != nil {
+		token = iamToken.Token()
+	}
+
+	if t.ResourceGroup.GUID != "" {
+		group, err := c.resolveResourceGroup(accountID, token, t.ResourceGroup.GUID)
+		if err != nil {
+			return err
+		}
+		t.ResourceGroup = group
+	}
+
+	// the Cloud Foundry environment of the target, logged in with the IAM
+	// token if it is not the targeted one
+	cfEndpoint, uaaToken := c.CF().APIEndpoint(), c.CF().UAAToken()
+	var newCF *cfInfo
+	var cfToken authentication.Token
+	if t.CFAPIEndpoint != "" && normalizeEndpoint(t.CFAPIEndpoint) != normalizeEndpoint(cfEndpoint) {
+		client := NewClientFromContext(c)
+		info, err := getCFInfo(client, t.CFAPIEndpoint)
+		if err != nil {
+			return err
+		}
+		cfToken, err = cfUAAToken(client, info, token)
+		if err != nil {
+			return err
+		}
+		newCF = &info
+		cfEndpoint, uaaToken = t.CFAPIEndpoint, cfToken.Token()
+	}
+
+	if t.CFOrg.GUID != "" {
+		if err := c.checkCFEntity(cfEndpoint, uaaToken, "/v2/organizations/"+url.PathEscape(t.CFOrg.GUID), T("Cloud Foundry org"), t.CFOrg.Name); err != nil {
+			return err
+		}
+	}
+	if t.CFSpace.GUID != "" {
+		if err := c.checkCFEntity(cfEndpoint, uaaToken, "/v2/spaces/"+url.PathEscape(t.CFSpace.GUID), T("Cloud Foundry space"), t.CFSpace.Name); err != nil {
+			return err
+		}
+	}
+
+	if t.Region.Name != "" {
+		c.SetRegion(t.Region)
+	}
+	if iamToken != nil {
+		c.SetIAMToken(iamToken.Token())
+		c.SetIAMRefreshTokenWithExpiry(iamToken.RefreshToken, iamToken.RefreshTokenExpiration)
+		c.SetAccount(t.Account)
+	}
+
+	// the resource group belongs to the account
+	if t.ResourceGroup.GUID != "" {
+		c.SetResourceGroup(t.ResourceGroup)
+	} else if iamToken != nil {
+		c.SetResourceGroup(models.ResourceGroup{})
+	}
+
+	if newCF != nil {
+		cf := c.CFConfig()
+		cf.SetAPIEndpoint(t.CFAPIEndpoint)
+		cf.SetAPIVersion(newCF.APIVersion)
+		cf.SetAuthenticationEndpoint(newCF.AuthorizationEndpoint)
+		cf.SetUAAEndpoint(newCF.TokenEndpoint)
+		cf.SetDopplerEndpoint(newCF.DopplerEndpoint)
+		cf.SetRoutingAPIEndpoint(newCF.RoutingEndpoint)
+		cf.SetUAAToken(cfToken.Token())
+		cf.SetUAARefreshToken(cfToken.RefreshToken)
+	}
+
+	// the space belongs to the org, which belongs to the account and the
+	// Cloud Foundry environment
+	switch {
+	case t.CFOrg.GUID != "":
+		if t.CFOrg.GUID != c.CF().CurrentOrganization().GUID && t.CFSpace.GUID == "" {
+			c.CFConfig().SetSpace(models.SpaceFields{})
+		}
+		c.CFConfig().SetOrganization(t.CFOrg)
+	case iamToken != nil || newCF != nil:
+		c.CFConfig().SetOrganization(models.OrganizationFields{})
+		c.CFConfig().SetSpace(models.SpaceFields{})
+	}
+	if t.CFSpace.GUID != "" {
+		c.CFConfig().SetSpace(t.CFSpace)
+	}
+	return nil
+}
+
+// checkCFEntity checks the Cloud Foundry entity at path of the API endpoint
+// exists. A TargetNotFoundError is returned if not.
+func (c *pluginContext) checkCFEntity(apiEndpoint string, uaaToken string, path string, entityType string, name string) error {
+	if apiEndpoint == "" {
+		return errors.New(T("CloudFoundry API endpoint is not set"))
+	}
+
+	req := rest.GetRequest(strings.TrimRight(apiEndpoint, "/")+path).
+		Set("Authorization", uaaToken)
+	_, err := NewClientFromContext(c).Do(req, nil, nil)
+	if e, ok := err.(*rest.ErrorResponse); ok && e.StatusCode == http.StatusNotFound {
+		return &TargetNotFoundError{Type: entityType, Name: name}
+	}
+	return err
+}
@@ -0,0 +1,162 @@
+package plugin
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/bluemix/models"
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/testhelpers/configuration"
+)
+
+func TestExportImportTarget(t *testing.T) {
+	assert := assert.New(t)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v2/resource_groups":
+			fmt.Fprint(w, `{"resources": [{"id": "rg1", "name": "default"}]}`)
+		case "/v2/organizations/org1", "/v2/spaces/space1":
+			fmt.Fprint(w, `{}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	os.Setenv("RESOURCE_CONTROLLER_ENDPOINT", ts.URL)
+	defer os.Unsetenv("RESOURCE_CONTROLLER_ENDPOINT")
+
+	config := configuration.NewFakeCoreConfig()
+	config.CFConfig().SetAPIEndpoint(ts.URL)
+	config.SetRegion(models.Region{ID: "ibm:yp:us-south", Name: "us-south"})
+	config.SetAccount(models.Account{GUID: "account-id", Name: "my account"})
+	config.SetResourceGroup(models.ResourceGroup{GUID: "rg1", Name: "default"})
+	config.CFConfig().SetOrganization(models.OrganizationFields{GUID: "org1", Name: "my-org"})
+	config.CFConfig().SetSpace(models.SpaceFields{GUID: "space1", Name: "dev"})
+	c := createPluginContext("", config)
+
+	data, err := c.ExportTarget()
+	assert.NoError(err)
+
+	config.SetRegion(models.Region{ID: "ibm:yp:eu-de", Name: "eu-de"})
+	config.SetResourceGroup(models.ResourceGroup{})
+	config.CFConfig().SetSpace(models.SpaceFields{})
+
+	assert.NoError(c.ImportTarget(data))
+	assert.Equal("us-south", c.CurrentRegion().Name)
+	assert.Equal("rg1", c.CurrentResourceGroup().GUID)
+	assert.Equal("space1", c.CF().CurrentSpace().GUID)
+}
+
+func TestImportTarget_NotFound(t *testing.T) {
+	assert := assert.New(t)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer ts.Close()
+
+	config := configuration.NewFakeCoreConfig()
+	config.CFConfig().SetAPIEndpoint(ts.URL)
+	config.SetRegion(models.Region{ID: "ibm:yp:eu-de", Name: "eu-de"})
+	c := createPluginContext("", config)
+
+	err := c.ImportTarget([]byte(`{"region": {"Name": "us-south"}, "cf_org": {"GUID": "org1", "Name": "my-org"}}`))
+	assert.IsType(&TargetNotFoundError{}, err)
+	assert.Equal("eu-de", c.CurrentRegion().Name)
+
+	err = c.ImportTarget([]byte(`{"region": {"Name": "mars-1"}}`))
+	assert.IsType(&InvalidRegionError{}, err)
+}
+
+func TestImportTarget_AccountChange(t *testing.T) {
+	assert := assert.New(t)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/identity/token":
+			assert.NoError(r.ParseForm())
+			assert.Equal("other-account-id", r.PostForm.Get("bss_account"))
+			fmt.Fprint(w, `{"access_token": "other-account-token", "refresh_token": "refresh", "token_type": "Bearer"}`)
+		case "/v2/organizations/org2":
+			fmt.Fprint(w, `{}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	config := configuration.NewFakeCoreConfig()
+	config.SetIAMEndpoint(ts.URL)
+	config.SetIAMRefreshToken("refresh")
+	config.CFConfig().SetAPIEndpoint(ts.URL)
+	config.SetAccount(models.Account{GUID: "account-id"})
+	config.SetResourceGroup(models.ResourceGroup{GUID: "rg1", Name: "default"})
+	config.CFConfig().SetOrganization(models.OrganizationFields{GUID: "org1", Name: "my-org"})
+	config.CFConfig().SetSpace(models.SpaceFields{GUID: "space1", Name: "dev"})
+	c := createPluginContext("", config)
+
+	// the org changes but the target has no space
+	err := c.ImportTarget([]byte(`{"cf_org": {"GUID": "org2", "Name": "other-org"}}`))
+	assert.NoError(err)
+	assert.Equal("org2", c.CF().CurrentOrganization().GUID)
+	assert.Empty(c.CF().CurrentSpace().GUID)
+	assert.Equal("rg1", c.CurrentResourceGroup().GUID)
+
+	// the account changes but the target has no resource group or org
+	err = c.ImportTarget([]byte(`{"account": {"GUID": "other-account-id"}}`))
+	assert.NoError(err)
+	assert.Equal("other-account-id", c.CurrentAccount().GUID)
+	assert.Equal("Bearer other-account-token", c.IAMToken())
+	assert.Empty(c.CurrentResourceGroup().GUID)
+	assert.Empty(c.CF().CurrentOrganization().GUID)
+}
+
+func TestImportTarget_CFAPIEndpoint(t *testing.T) {
+	assert := assert.New(t)
+
+	uaa := uaaServer(t, "iam-token", "us-south-token")
+	defer uaa.Close()
+
+	usSouth := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v2/info":
+			fmt.Fprintf(w, `{"api_version": "2.150.0", "authorization_endpoint": "%s", "token_endpoint": "%s", "doppler_logging_endpoint": "wss://doppler.example.com:443"}`, uaa.URL, uaa.URL)
+		case "/v2/organizations/org1", "/v2/spaces/space1":
+			assert.Equal("bearer us-south-token", r.Header.Get("Authorization"))
+			fmt.Fprint(w, `{}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer usSouth.Close()
+
+	euDe := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer euDe.Close()
+
+	c := createPluginContext("", newCFTestConfig(euDe.URL))
+
+	err := c.ImportTarget([]byte(fmt.Sprintf(`{
+		"cf_api_endpoint": "%s",
+		"cf_org": {"GUID": "org1", "Name": "my-org"},
+		"cf_space": {"GUID": "space1", "Name": "dev"}
+	}`, usSouth.URL)))
+	assert.NoError(err)
+	assert.Equal(usSouth.URL, c.CF().APIEndpoint())
+	assert.Equal("2.150.0", c.CF().APIVersion())
+	assert.Equal(uaa.URL, c.CFConfig().AuthenticationEndpoint())
+	assert.Equal("bearer us-south-token", c.CF().UAAToken())
+	assert.Equal("org1", c.CF().CurrentOrganization().GUID)
+	assert.Equal("space1", c.CF().CurrentSpace().GUID)
+
+	data, err := c.ExportTarget()
+	assert.NoError(err)
+	assert.Contains(string(data), `"cf_api_endpoint":"`+usSouth.URL+`"`)
+}
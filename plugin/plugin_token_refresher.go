@@ -0,0 +1,135 @@
+package plugin
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/bluemix/authentication"
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/bluemix/trace"
+)
+
+func (c *pluginContext) StartTokenRefresher(ctx context.Context, interval time.Duration) func() {
+	return startRefresher(ctx, interval, func() error {
+		_, err := c.RefreshIAMToken()
+		return err
+	})
+}
+
+// startRefresher calls refresh every interval until ctx is cancelled or the
+// returned stop function is called. If interval is not positive, refresh is
+// never called and stop does nothing. Stop waits for an in-flight refresh to
+// complete, so the token is never updated after stop returns.
+//
+// If refresh fails because the service is unavailable and the server asks to
+// retry after a delay shorter than interval, the next refresh is attempted
+// after that delay, see retryDelay.
+func startRefresher(ctx context.Context, interval time.Duration, refresh func() error) func() {
+	// the timer is only used by the refresher goroutine, and after it has
+	// exited by stop
+	var timer *time.Timer
+	after := func(d time.Duration) <-chan time.Time {
+		if timer == nil {
+			timer = time.NewTimer(d)
+		} else {
+			timer.Reset(d)
+		}
+		return timer.C
+	}
+
+	stop := startRefresherWithTimer(ctx, interval, refresh, after)
+	return func() {
+		stop()
+		if timer != nil {
+			timer.Stop()
+		}
+	}
+}
+
+// startRefresherWithTimer is startRefresher waiting for the channels returned
+// by after, which fire after the given delay.
+func startRefresherWithTimer(ctx context.Context, interval time.Duration, refresh func() error, after func(time.Duration) <-chan time.Time) func() {
+	if interval <= 0 {
+		trace.Logger.Printf("Token refresher not started: invalid interval %v\n", interval)
+		return func() {}
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		delay := interval
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-after(delay):
+				err := refresh()
+				if err != nil {
+					trace.Logger.Printf("Failed to refresh IAM token: %v\n", err)
+				}
+				delay = retryDelay(err, interval)
+			}
+		}
+	}()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			cancel()
+			<-done
+		})
+	}
+}
+
+// retryDelay returns the delay before the next refresh after the refresh
+// returned err: the Retry-After delay of a ServiceUnavailableError if it is
+// shorter than interval, otherwise interval.
+func retryDelay(err error, interval time.Duration) time.Duration {
+	var unavailable *authentication.ServiceUnavailableError
+	if errors.As(err, &unavailable) && unavailable.RetryAfter > 0 && unavailable.RetryAfter < interval {
+		return unavailable.RetryAfter
+	}
+	return interval
+}
+
+// refreshGroup deduplicates concurrent token refreshes: callers arriving while
+// a refresh is in flight wait for it and share its result instead of
+// starting another one.
+type refreshGroup struct {
+	lock sync.Mutex
+	call *refreshCall
+}
+
+type refreshCall struct {
+	done    chan struct{}
+	waiters int
+	token   string
+	err     error
+}
+
+func (g *refreshGroup) do(refresh func() (string, error)) (string, error) {
+	g.lock.Lock()
+	if call := g.call; call != nil {
+		call.waiters++
+		g.lock.Unlock()
+		<-call.done
+		return call.token, call.err
+	}
+	call := &refreshCall{done: make(chan struct{})}
+	g.call = call
+	g.lock.Unlock()
+
+	defer func() {
+		g.lock.Lock()
+		g.call = nil
+		g.lock.Unlock()
+		close(call.done)
+	}()
+
+	call.token, call.err = refresh()
+	return call.token, call.err
+}
@@ -0,0 +1,142 @@
+package plugin
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/bluemix/authentication"
+)
+
+func TestStartRefresher(t *testing.T) {
+	var count int32
+	stop := startRefresher(context.Background(), time.Millisecond, func() error {
+		atomic.AddInt32(&count, 1)
+		return nil
+	})
+
+	time.Sleep(20 * time.Millisecond)
+	stop()
+	stop()
+
+	n := atomic.LoadInt32(&count)
+	assert.True(t, n > 0)
+
+	time.Sleep(5 * time.Millisecond)
+	assert.Equal(t, n, atomic.LoadInt32(&count))
+}
+
+func TestStartRefresher_ContextCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	stop := startRefresher(ctx, time.Hour, func() error { return nil })
+
+	cancel()
+	stop()
+}
+
+func TestStartRefresher_InvalidInterval(t *testing.T) {
+	var count int32
+	stop := startRefresher(context.Background(), 0, func() error {
+		atomic.AddInt32(&count, 1)
+		return nil
+	})
+
+	time.Sleep(5 * time.Millisecond)
+	stop()
+	assert.Equal(t, int32(0), atomic.LoadInt32(&count))
+}
+
+func TestRefreshGroup(t *testing.T) {
+	assert := assert.New(t)
+
+	var g refreshGroup
+	var count int32
+	release := make(chan struct{})
+	refresh := func() (string, error) {
+		atomic.AddInt32(&count, 1)
+		<-release
+		return "token", nil
+	}
+
+	waiters := func() int {
+		g.lock.Lock()
+		defer g.lock.Unlock()
+		if g.call == nil {
+			return -1
+		}
+		return g.call.waiters
+	}
+
+	var wg sync.WaitGroup
+	tokens := make([]string, 5)
+	for i := range tokens {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			tokens[i], _ = g.do(refresh)
+		}(i)
+	}
+
+	for waiters() < len(tokens)-1 {
+		time.Sleep(time.Millisecond)
+	}
+	close(release)
+	wg.Wait()
+
+	assert.Equal(int32(1), atomic.LoadInt32(&count))
+	assert.Equal([]string{"token", "token", "token", "token", "token"}, tokens)
+
+	token, err := g.do(func() (string, error) { return "", errors.New("failed") })
+	assert.Empty(token)
+	assert.EqualError(err, "failed")
+}
+
+func TestRetryDelay(t *testing.T) {
+	assert := assert.New(t)
+
+	unavailable := &authentication.ServiceUnavailableError{RetryAfter: time.Minute}
+	assert.Equal(time.Hour, retryDelay(nil, time.Hour))
+	assert.Equal(time.Hour, retryDelay(errors.New("failed"), time.Hour))
+	assert.Equal(time.Minute, retryDelay(unavailable, time.Hour))
+	assert.Equal(time.Minute, retryDelay(&IAMTokenRefreshError{StatusCode: 503, Err: unavailable}, time.Hour))
+	assert.Equal(30*time.Second, retryDelay(unavailable, 30*time.Second))
+	assert.Equal(time.Hour, retryDelay(&authentication.ServiceUnavailableError{}, time.Hour))
+}
+
+func TestStartRefresher_RetryAfter(t *testing.T) {
+	assert := assert.New(t)
+
+	// after fires immediately and reports the delay it was asked to wait, the
+	// delay after the failed refresh is the Retry-After delay
+	delays := make(chan time.Duration)
+	finished := make(chan struct{})
+	after := func(d time.Duration) <-chan time.Time {
+		c := make(chan time.Time, 1)
+		select {
+		case delays <- d:
+			c <- time.Now()
+		case <-finished:
+		}
+		return c
+	}
+
+	var count int32
+	stop := startRefresherWithTimer(context.Background(), time.Hour, func() error {
+		if atomic.AddInt32(&count, 1) == 1 {
+			return &authentication.ServiceUnavailableError{RetryAfter: time.Minute}
+		}
+		return nil
+	}, after)
+
+	assert.Equal(time.Hour, <-delays)
+	assert.Equal(time.Minute, <-delays)
+	assert.Equal(time.Hour, <-delays)
+
+	close(finished)
+	stop()
+}
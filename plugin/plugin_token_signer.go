@@ -0,0 +1,62 @@
+package plugin
+
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/bluemix/configuration/core_config"
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/common/rest"
+)
+
+// tokenRefreshMargin is how long before expiry the IAM token is refreshed
+var tokenRefreshMargin = 5 * time.Minute
+
+// AutoRefreshToken returns a rest.Signer that sets the IAM token of the
+// plugin context in the Authorization header of each request. If the token
+// has expired or is about to expire, it is refreshed first. It is safe for
+// concurrent use: concurrent requests trigger a single refresh.
+//
+//	client := rest.NewClient().WithSigner(plugin.AutoRefreshToken(context))
+func AutoRefreshToken(c PluginContext) rest.Signer {
+	s := &tokenSigner{context: c}
+	return rest.SignerFunc(s.sign)
+}
+
+type tokenSigner struct {
+	context PluginContext
+	lock    sync.Mutex
+}
+
+func (s *tokenSigner) sign(req *http.Request) error {
+	token, err := s.validToken()
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", token)
+	return nil
+}
+
+func (s *tokenSigner) validToken() (string, error) {
+	token := s.context.IAMToken()
+	if !tokenExpiring(token) {
+		return token, nil
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	// the token may have been refreshed while waiting for the lock
+	token = s.context.IAMToken()
+	if !tokenExpiring(token) {
+		return token, nil
+	}
+	return s.context.RefreshIAMToken()
+}
+
+// tokenExpiring returns whether the token expires within tokenRefreshMargin.
+// It returns false if the expiry is unknown.
+func tokenExpiring(token string) bool {
+	expiry := core_config.NewIAMTokenInfo(token).ExpiresAt()
+	return !expiry.IsZero() && time.Until(expiry) < tokenRefreshMargin
+}
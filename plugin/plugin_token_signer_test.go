@@ -0,0 +1,73 @@
+package plugin
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testIAMToken(expiry time.Time) string {
+	claims := fmt.Sprintf(`{"iam_id":"test","exp":%d}`, expiry.Unix())
+	return "Bearer header." + base64.RawStdEncoding.EncodeToString([]byte(claims)) + ".signature"
+}
+
+type tokenContext struct {
+	PluginContext
+	lock      sync.Mutex
+	token     string
+	refreshes int32
+}
+
+func (c *tokenContext) IAMToken() string {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.token
+}
+
+func (c *tokenContext) RefreshIAMToken() (string, error) {
+	atomic.AddInt32(&c.refreshes, 1)
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.token = testIAMToken(time.Now().Add(time.Hour))
+	return c.token, nil
+}
+
+func TestAutoRefreshToken(t *testing.T) {
+	assert := assert.New(t)
+
+	valid := testIAMToken(time.Now().Add(time.Hour))
+	c := &tokenContext{token: valid}
+	signer := AutoRefreshToken(c)
+
+	req, _ := http.NewRequest("GET", "http://example.com", nil)
+	assert.NoError(signer.Sign(req))
+	assert.Equal(valid, req.Header.Get("Authorization"))
+	assert.Equal(int32(0), c.refreshes)
+}
+
+func TestAutoRefreshToken_Expiring(t *testing.T) {
+	assert := assert.New(t)
+
+	c := &tokenContext{token: testIAMToken(time.Now().Add(time.Minute))}
+	signer := AutoRefreshToken(c)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			req, _ := http.NewRequest("GET", "http://example.com", nil)
+			assert.NoError(signer.Sign(req))
+			assert.Equal(c.IAMToken(), req.Header.Get("Authorization"))
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(int32(1), atomic.LoadInt32(&c.refreshes))
+}
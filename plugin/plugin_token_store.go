@@ -0,0 +1,71 @@
+package plugin
+
+import (
+	"os"
+	"strconv"
+
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/bluemix/consts"
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/bluemix/keyring"
+)
+
+// TokenStore persists tokens of a plugin session. By default, tokens are
+// stored in the plugin config. If the user opts in by setting environment
+// variable IBMCLOUD_USE_KEYRING to true, tokens are stored in the OS keyring
+// instead, see package keyring. Tokens are then never written to the plugin
+// config: an error is returned if the keyring is unavailable. Tokens stored
+// in the plugin config before opting in can still be read.
+type TokenStore struct {
+	service string
+	config  PluginConfig
+}
+
+// NewTokenStore creates a TokenStore. The service, e.g. the plugin name,
+// identifies the tokens in the keyring.
+func NewTokenStore(ctx PluginContext, service string) *TokenStore {
+	return &TokenStore{
+		service: service,
+		config:  ctx.PluginConfig(),
+	}
+}
+
+// Get returns the token stored under the key, or empty string if not found.
+func (s *TokenStore) Get(key string) (string, error) {
+	if useKeyring() {
+		token, err := keyring.Get(s.service, key)
+		if err == nil {
+			return token, nil
+		}
+	}
+	return s.config.GetString(key)
+}
+
+// Set stores the token under the key. If the token is stored in the
+// keyring, any copy in the plugin config is removed.
+func (s *TokenStore) Set(key string, token string) error {
+	if useKeyring() {
+		if err := keyring.Set(s.service, key, token); err != nil {
+			return err
+		}
+		if s.config.Exists(key) {
+			return s.config.Erase(key)
+		}
+		return nil
+	}
+	return s.config.Set(key, token)
+}
+
+// Delete removes the token stored under the key.
+func (s *TokenStore) Delete(key string) error {
+	if useKeyring() {
+		keyring.Delete(s.service, key)
+	}
+	if s.config.Exists(key) {
+		return s.config.Erase(key)
+	}
+	return nil
+}
+
+func useKeyring() bool {
+	b, _ := strconv.ParseBool(os.Getenv(consts.ENV_IBMCLOUD_USE_KEYRING))
+	return b
+}
@@ -0,0 +1,112 @@
+package plugin
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/bluemix/keyring"
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/testhelpers/configuration"
+)
+
+type memoryKeyring map[string]string
+
+func (k memoryKeyring) Get(service string, user string) (string, error) {
+	if v, ok := k[service+"/"+user]; ok {
+		return v, nil
+	}
+	return "", keyring.ErrNotFound
+}
+
+func (k memoryKeyring) Set(service string, user string, secret string) error {
+	k[service+"/"+user] = secret
+	return nil
+}
+
+func (k memoryKeyring) Delete(service string, user string) error {
+	delete(k, service+"/"+user)
+	return nil
+}
+
+func newTestTokenStore(t *testing.T) (*TokenStore, PluginConfig, func()) {
+	dir, err := ioutil.TempDir("", "plugin")
+	assert.NoError(t, err)
+
+	c := createPluginContext(dir, configuration.NewFakeCoreConfig())
+	return NewTokenStore(c, "test-plugin"), c.PluginConfig(), func() { os.RemoveAll(dir) }
+}
+
+func TestTokenStore_Config(t *testing.T) {
+	assert := assert.New(t)
+
+	store, config, cleanup := newTestTokenStore(t)
+	defer cleanup()
+
+	assert.NoError(store.Set("token", "abc"))
+	assert.Equal("abc", config.Get("token"))
+
+	token, err := store.Get("token")
+	assert.NoError(err)
+	assert.Equal("abc", token)
+
+	assert.NoError(store.Delete("token"))
+	assert.False(config.Exists("token"))
+}
+
+func TestTokenStore_Keyring(t *testing.T) {
+	assert := assert.New(t)
+
+	defer func(k keyring.Keyring) { keyring.Default = k }(keyring.Default)
+	memory := memoryKeyring{}
+	keyring.Default = memory
+
+	os.Setenv("IBMCLOUD_USE_KEYRING", "true")
+	defer os.Unsetenv("IBMCLOUD_USE_KEYRING")
+
+	store, config, cleanup := newTestTokenStore(t)
+	defer cleanup()
+
+	config.Set("token", "plaintext")
+	assert.NoError(store.Set("token", "abc"))
+	assert.Equal("abc", memory["test-plugin/token"])
+	assert.False(config.Exists("token"))
+
+	token, err := store.Get("token")
+	assert.NoError(err)
+	assert.Equal("abc", token)
+
+	assert.NoError(store.Delete("token"))
+	assert.Empty(memory)
+}
+
+func TestTokenStore_KeyringUnavailable(t *testing.T) {
+	assert := assert.New(t)
+
+	defer func(k keyring.Keyring) { keyring.Default = k }(keyring.Default)
+	keyring.Default = unavailableKeyring{}
+
+	os.Setenv("IBMCLOUD_USE_KEYRING", "true")
+	defer os.Unsetenv("IBMCLOUD_USE_KEYRING")
+
+	store, config, cleanup := newTestTokenStore(t)
+	defer cleanup()
+
+	assert.Equal(keyring.ErrUnsupported, store.Set("token", "abc"))
+	assert.False(config.Exists("token"))
+}
+
+type unavailableKeyring struct{}
+
+func (unavailableKeyring) Get(service string, user string) (string, error) {
+	return "", keyring.ErrUnsupported
+}
+
+func (unavailableKeyring) Set(service string, user string, secret string) error {
+	return keyring.ErrUnsupported
+}
+
+func (unavailableKeyring) Delete(service string, user string) error {
+	return keyring.ErrUnsupported
+}
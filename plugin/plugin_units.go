@@ -0,0 +1,94 @@
+package plugin
+
+import (
+	"errors"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	. "github.com/IBM-Cloud/ibm-cloud-cli-sdk/i18n"
+)
+
+var dayPattern = regexp.MustCompile(`([0-9]*\.?[0-9]+)d`)
+
+// ParseDuration parses a duration string such as "30m", "1h30m" or "2d".
+// In addition to the units accepted by time.ParseDuration, "d" (24 hours)
+// is supported.
+func ParseDuration(s string) (time.Duration, error) {
+	var convErr error
+	expanded := dayPattern.ReplaceAllStringFunc(strings.TrimSpace(s), func(m string) string {
+		days, err := strconv.ParseFloat(strings.TrimSuffix(m, "d"), 64)
+		if err != nil {
+			convErr = err
+			return m
+		}
+		return strconv.FormatFloat(days*24, 'f', -1, 64) + "h"
+	})
+
+	d, err := time.ParseDuration(expanded)
+	if convErr != nil || err != nil {
+		return 0, errors.New(T("Invalid duration '{{.Value}}'. Use a number with a unit of 'ns', 'us', 'ms', 's', 'm', 'h' or 'd', e.g. '30m'.",
+			map[string]interface{}{"Value": s}))
+	}
+	return d, nil
+}
+
+var quantityUnits = map[string]float64{
+	"":   1,
+	"k":  1e3,
+	"K":  1e3,
+	"M":  1e6,
+	"G":  1e9,
+	"T":  1e12,
+	"P":  1e15,
+	"Ki": 1 << 10,
+	"Mi": 1 << 20,
+	"Gi": 1 << 30,
+	"Ti": 1 << 40,
+	"Pi": 1 << 50,
+}
+
+var quantityPattern = regexp.MustCompile(`^([0-9]*\.?[0-9]+)([A-Za-z]*)$`)
+
+// ParseQuantity parses a quantity such as "512Mi" or "2Gi" and returns the
+// number of units. Binary suffixes "Ki", "Mi", "Gi", "Ti", "Pi" and decimal
+// suffixes "k", "M", "G", "T", "P" are supported.
+func ParseQuantity(s string) (int64, error) {
+	invalid := func() error {
+		return errors.New(T("Invalid quantity '{{.Value}}'. Use a number with an optional unit of 'Ki', 'Mi', 'Gi', 'Ti', 'Pi', 'k', 'M', 'G', 'T' or 'P', e.g. '2Gi'.",
+			map[string]interface{}{"Value": s}))
+	}
+
+	m := quantityPattern.FindStringSubmatch(strings.TrimSpace(s))
+	if m == nil {
+		return 0, invalid()
+	}
+
+	multiplier, ok := quantityUnits[m[2]]
+	if !ok {
+		return 0, invalid()
+	}
+
+	// an integer is parsed exactly, float64 can't represent all int64
+	if !strings.Contains(m[1], ".") {
+		n, err := strconv.ParseInt(m[1], 10, 64)
+		if err != nil || n > math.MaxInt64/int64(multiplier) {
+			return 0, invalid()
+		}
+		return n * int64(multiplier), nil
+	}
+
+	n, err := strconv.ParseFloat(m[1], 64)
+	if err != nil {
+		return 0, invalid()
+	}
+
+	// float64(math.MaxInt64) rounds up to 2^63, which overflows int64
+	v := n * multiplier
+	if v >= math.MaxInt64 {
+		return 0, invalid()
+	}
+	return int64(v), nil
+}
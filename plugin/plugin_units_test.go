@@ -0,0 +1,57 @@
+package plugin
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseDuration(t *testing.T) {
+	assert := assert.New(t)
+
+	cases := map[string]time.Duration{
+		"30m":   30 * time.Minute,
+		"1h30m": 90 * time.Minute,
+		"2d":    48 * time.Hour,
+		"1.5d":  36 * time.Hour,
+		"1d12h": 36 * time.Hour,
+		"500ms": 500 * time.Millisecond,
+	}
+	for s, expected := range cases {
+		d, err := ParseDuration(s)
+		assert.NoError(err, s)
+		assert.Equal(expected, d, s)
+	}
+
+	for _, s := range []string{"", "10", "3w", "d"} {
+		_, err := ParseDuration(s)
+		assert.Error(err, s)
+	}
+}
+
+func TestParseQuantity(t *testing.T) {
+	assert := assert.New(t)
+
+	cases := map[string]int64{
+		"100":                 100,
+		"2Gi":                 2 << 30,
+		"512Mi":               512 << 20,
+		"1.5Ki":               1536,
+		"1k":                  1000,
+		"3G":                  3000000000,
+		"9223372036854775807": math.MaxInt64,
+		"8191Pi":              8191 << 50,
+	}
+	for s, expected := range cases {
+		n, err := ParseQuantity(s)
+		assert.NoError(err, s)
+		assert.Equal(expected, n, s)
+	}
+
+	for _, s := range []string{"", "Gi", "2GB", "-1Mi", "1Ei", "99999999999Pi", "9223372036854775808", "8192Pi", "8192.0Pi", "9223.372036854775808P"} {
+		_, err := ParseQuantity(s)
+		assert.Error(err, s)
+	}
+}
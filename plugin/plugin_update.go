@@ -0,0 +1,145 @@
+package plugin
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/bluemix/configuration"
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/common/rest"
+)
+
+const (
+	updateCheckFile     = "update_check.json"
+	updateCheckInterval = 24 * time.Hour
+)
+
+// UpdateInfo describes a newer version of the plugin available in a plugin
+// repository.
+type UpdateInfo struct {
+	Name           string      // name of the plugin
+	RepoName       string      // name of the repository providing the update
+	CurrentVersion VersionType // version of the running plugin
+	LatestVersion  VersionType // latest version in the repository
+}
+
+type updateCheckResult struct {
+	LastCheck     time.Time
+	RepoName      string
+	LatestVersion string
+}
+
+func (r *updateCheckResult) Marshal() ([]byte, error) {
+	return json.MarshalIndent(r, "", "  ")
+}
+
+func (r *updateCheckResult) Unmarshal(bytes []byte) error {
+	return json.Unmarshal(bytes, r)
+}
+
+type repoPluginsResponse struct {
+	Plugins []struct {
+		Name     string `json:"name"`
+		Versions []struct {
+			Version string `json:"version"`
+		} `json:"versions"`
+	} `json:"plugins"`
+}
+
+func (c *pluginContext) CheckForUpdate() (*UpdateInfo, error) {
+	if !c.VersionCheckEnabled() || c.metadata.Name == "" {
+		return nil, nil
+	}
+
+	persistor := configuration.NewDiskPersistor(filepath.Join(c.pluginPath, updateCheckFile))
+
+	result := new(updateCheckResult)
+	if persistor.Exists() {
+		if err := persistor.Load(result); err != nil {
+			result = new(updateCheckResult)
+		}
+	}
+
+	if time.Since(result.LastCheck) > updateCheckInterval {
+		repoName, latest, err := c.latestVersionInRepos()
+		if err != nil {
+			// keep the previous result, but don't retry before the interval
+			// so that an unreachable repository doesn't slow down every
+			// command
+			result.LastCheck = time.Now()
+			persistor.Save(result)
+			return nil, err
+		}
+
+		result = &updateCheckResult{LastCheck: time.Now(), RepoName: repoName}
+		if repoName != "" {
+			result.LatestVersion = latest.String()
+		}
+
+		// caching is best-effort, a failure only causes a recheck next time
+		persistor.Save(result)
+	}
+
+	latest, err := ParseVersion(result.LatestVersion)
+	if err != nil || latest.Compare(c.metadata.Version) <= 0 {
+		return nil, nil
+	}
+
+	return &UpdateInfo{
+		Name:           c.metadata.Name,
+		RepoName:       result.RepoName,
+		CurrentVersion: c.metadata.Version,
+		LatestVersion:  latest,
+	}, nil
+}
+
+// latestVersionInRepos returns the latest version of the plugin among all
+// configured plugin repositories and the name of the repository providing
+// it. An error is returned only if none of the repositories can be queried.
+func (c *pluginContext) latestVersionInRepos() (repoName string, latest VersionType, err error) {
+	repos := c.PluginRepos()
+
+	var lastErr error
+	var succeeded bool
+	for _, repo := range repos {
+		versions, err := listPluginVersions(c, repo.URL, c.metadata.Name)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		succeeded = true
+
+		for _, v := range versions {
+			if repoName == "" || v.Compare(latest) > 0 {
+				repoName, latest = repo.Name, v
+			}
+		}
+	}
+
+	if len(repos) > 0 && !succeeded {
+		return "", VersionType{}, lastErr
+	}
+	return repoName, latest, nil
+}
+
+func listPluginVersions(c PluginContext, repoURL string, pluginName string) ([]VersionType, error) {
+	var resp repoPluginsResponse
+	_, err := NewClientFromContext(c).Do(rest.GetRequest(strings.TrimRight(repoURL, "/")+"/bx/list"), &resp, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var versions []VersionType
+	for _, p := range resp.Plugins {
+		if !strings.EqualFold(p.Name, pluginName) {
+			continue
+		}
+		for _, pv := range p.Versions {
+			if v, err := ParseVersion(pv.Version); err == nil {
+				versions = append(versions, v)
+			}
+		}
+	}
+	return versions, nil
+}
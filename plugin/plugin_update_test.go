@@ -0,0 +1,159 @@
+package plugin
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/bluemix/models"
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/testhelpers/configuration"
+)
+
+const repoPluginsJSON = `{
+	"plugins": [
+		{"name": "other", "versions": [{"version": "9.0.0"}]},
+		{"name": "demo", "versions": [{"version": "1.0.0"}, {"version": "1.2.0"}, {"version": "1.1.3"}]}
+	]
+}`
+
+func newUpdateTestContext(t *testing.T, repoURL string, version VersionType) *pluginContext {
+	tmpDir, err := ioutil.TempDir("", "plugin_update_test")
+	assert.NoError(t, err)
+
+	config := configuration.NewFakeCoreConfig()
+	config.SetPluginRepo(models.PluginRepo{Name: "IBM Cloud", URL: repoURL})
+
+	c := createPluginContext(tmpDir, config)
+	c.metadata = PluginMetadata{Name: "demo", Version: version}
+	return c
+}
+
+func TestCheckForUpdate(t *testing.T) {
+	assert := assert.New(t)
+
+	requests := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		assert.Equal("/bx/list", r.URL.Path)
+		fmt.Fprint(w, repoPluginsJSON)
+	}))
+	defer ts.Close()
+
+	c := newUpdateTestContext(t, ts.URL, VersionType{1, 0, 0})
+	defer os.RemoveAll(c.pluginPath)
+
+	update, err := c.CheckForUpdate()
+	assert.NoError(err)
+	assert.Equal(&UpdateInfo{
+		Name:           "demo",
+		RepoName:       "IBM Cloud",
+		CurrentVersion: VersionType{1, 0, 0},
+		LatestVersion:  VersionType{1, 2, 0},
+	}, update)
+	assert.True(fileExists(filepath.Join(c.pluginPath, updateCheckFile)))
+
+	_, err = c.CheckForUpdate()
+	assert.NoError(err)
+	assert.Equal(1, requests, "result should be cached")
+}
+
+func TestCheckForUpdate_UpToDate(t *testing.T) {
+	assert := assert.New(t)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, repoPluginsJSON)
+	}))
+	defer ts.Close()
+
+	c := newUpdateTestContext(t, ts.URL, VersionType{1, 2, 0})
+	defer os.RemoveAll(c.pluginPath)
+
+	update, err := c.CheckForUpdate()
+	assert.NoError(err)
+	assert.Nil(update)
+}
+
+func TestCheckForUpdate_Disabled(t *testing.T) {
+	assert := assert.New(t)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("plugin repository should not be queried")
+	}))
+	defer ts.Close()
+
+	c := newUpdateTestContext(t, ts.URL, VersionType{1, 0, 0})
+	defer os.RemoveAll(c.pluginPath)
+	c.SetCheckCLIVersionDisabled(true)
+
+	update, err := c.CheckForUpdate()
+	assert.NoError(err)
+	assert.Nil(update)
+}
+
+func TestCheckForUpdate_RepoError(t *testing.T) {
+	assert := assert.New(t)
+
+	requests := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		assert.NotEmpty(r.Header.Get(CorrelationIDHeader))
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer ts.Close()
+
+	c := newUpdateTestContext(t, ts.URL, VersionType{1, 0, 0})
+	defer os.RemoveAll(c.pluginPath)
+
+	update, err := c.CheckForUpdate()
+	assert.Error(err)
+	assert.Nil(update)
+
+	update, err = c.CheckForUpdate()
+	assert.NoError(err)
+	assert.Nil(update)
+	assert.Equal(1, requests, "failed check should be cached")
+}
+
+func fileExists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
+
+func TestSortVersions(t *testing.T) {
+	vs := []VersionType{
+		{Major: 1, Minor: 10, Build: 0},
+		{Major: 0, Minor: 9, Build: 1},
+		{Major: 1, Minor: 2, Build: 3},
+		{Major: 0, Minor: 9, Build: 1},
+	}
+	SortVersions(vs)
+
+	assert.Equal(t, []VersionType{
+		{Major: 0, Minor: 9, Build: 1},
+		{Major: 0, Minor: 9, Build: 1},
+		{Major: 1, Minor: 2, Build: 3},
+		{Major: 1, Minor: 10, Build: 0},
+	}, vs)
+
+	var empty []VersionType
+	SortVersions(empty)
+	assert.Empty(t, empty)
+}
+
+func TestMaxVersion(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal(VersionType{}, MaxVersion(nil))
+	assert.Equal(VersionType{Major: 1, Minor: 10}, MaxVersion([]VersionType{
+		{Major: 1, Minor: 2, Build: 3},
+		{Major: 1, Minor: 10},
+		{Major: 1, Minor: 10},
+		{Major: 0, Minor: 9, Build: 1},
+	}))
+}
@@ -0,0 +1,130 @@
+package plugin
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+
+	. "github.com/IBM-Cloud/ibm-cloud-cli-sdk/i18n"
+)
+
+// ValidateCommandName checks that a command or namespace name follows the
+// CLI naming rules, so that the plugin can be registered:
+//   - the name is not empty
+//   - segments of a qualified name, e.g. 'A B', are separated by a single
+//     space
+//   - the name is lowercase
+//   - a segment contains only letters, digits and hyphens, and does not
+//     start with a hyphen
+func ValidateCommandName(name string) error {
+	if name == "" {
+		return errors.New(T("Name must not be empty"))
+	}
+	if strings.ToLower(name) != name {
+		return errors.New(T("Name '{{.Name}}' must be lowercase", map[string]interface{}{"Name": name}))
+	}
+
+	for _, segment := range strings.Split(name, " ") {
+		if segment == "" {
+			return errors.New(T("Name '{{.Name}}' must be separated by single spaces and must not start or end with a space",
+				map[string]interface{}{"Name": name}))
+		}
+		if strings.HasPrefix(segment, "-") {
+			return errors.New(T("Name '{{.Name}}' must not start with a hyphen", map[string]interface{}{"Name": segment}))
+		}
+		for _, c := range segment {
+			if !isCommandNameChar(c) {
+				return errors.New(T("Name '{{.Name}}' contains invalid character '{{.Char}}'. Only letters, digits and hyphens are allowed",
+					map[string]interface{}{"Name": name, "Char": string(c)}))
+			}
+		}
+	}
+	return nil
+}
+
+func isCommandNameChar(c rune) bool {
+	return (c >= 'a' && c <= 'z') || (c >= '0' && c <= '9') || c == '-'
+}
+
+// ValidateMetadata checks the namespaces and commands of the plugin metadata
+// against the CLI naming rules, see ValidateCommandName. Command names and
+// aliases must be a single segment; the namespace of a command is a
+// qualified name.
+//
+// It also returns warnings for flags mentioned in the usage of a command,
+// e.g. "--force", that are not declared in the command's Flags. They are
+// not errors since the usage may mention global flags of the CLI.
+//
+// The metadata is validated when the CLI requests it to install the plugin,
+// so a plugin with invalid metadata can't be installed. Plugin authors
+// should call it in their tests to catch errors early.
+func ValidateMetadata(m PluginMetadata) (warnings []string, err error) {
+	for _, ns := range m.Namespaces {
+		if err := ValidateCommandName(ns.Name); err != nil {
+			return nil, errors.New(T("Invalid namespace '{{.Name}}': {{.Error}}",
+				map[string]interface{}{"Name": ns.Name, "Error": err.Error()}))
+		}
+	}
+
+	for _, cmd := range m.Commands {
+		err := validateCommand(cmd)
+		if err != nil {
+			return nil, errors.New(T("Invalid command '{{.Name}}': {{.Error}}",
+				map[string]interface{}{"Name": cmd.FullName(), "Error": err.Error()}))
+		}
+
+		for _, flag := range undeclaredUsageFlags(cmd) {
+			warnings = append(warnings, T("Usage of command '{{.Name}}' mentions flag '{{.Flag}}' which is not declared",
+				map[string]interface{}{"Name": cmd.FullName(), "Flag": flag}))
+		}
+	}
+	return warnings, nil
+}
+
+var usageFlagRegex = regexp.MustCompile(`(?:^|[^\w-])(--[a-zA-Z0-9][\w-]*)`)
+
+// undeclaredUsageFlags returns the flags in the form "--name" mentioned in
+// the command usage but not declared in the command's Flags. A flag with
+// multiple names, e.g. "f,force", declares each of them.
+func undeclaredUsageFlags(cmd Command) []string {
+	declared := make(map[string]bool)
+	for _, f := range cmd.Flags {
+		for _, name := range strings.Split(f.Name, ",") {
+			declared[strings.TrimSpace(name)] = true
+		}
+	}
+
+	var undeclared []string
+	seen := make(map[string]bool)
+	for _, match := range usageFlagRegex.FindAllStringSubmatch(cmd.Usage, -1) {
+		flag := match[1]
+		if declared[strings.TrimPrefix(flag, "--")] || seen[flag] {
+			continue
+		}
+		seen[flag] = true
+		undeclared = append(undeclared, flag)
+	}
+	return undeclared
+}
+
+func validateCommand(cmd Command) error {
+	if cmd.Namespace != "" {
+		if err := ValidateCommandName(cmd.Namespace); err != nil {
+			return err
+		}
+	}
+
+	names := []string{cmd.Name}
+	if cmd.Alias != "" {
+		names = append(names, cmd.Alias)
+	}
+	for _, name := range names {
+		if strings.Contains(name, " ") {
+			return errors.New(T("Name '{{.Name}}' must not contain spaces", map[string]interface{}{"Name": name}))
+		}
+		if err := ValidateCommandName(name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+package plugin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateCommandName(t *testing.T) {
+	assert := assert.New(t)
+
+	for _, name := range []string{"list", "k8s", "is", "service-id", "iam service-ids", "a b c"} {
+		assert.NoError(ValidateCommandName(name), name)
+	}
+
+	cases := map[string]string{
+		"":          "must not be empty",
+		"List":      "must be lowercase",
+		"iam  list": "single spaces",
+		" list":     "single spaces",
+		"list ":     "single spaces",
+		"-list":     "must not start with a hyphen",
+		"list_all":  "invalid character '_'",
+		"list.all":  "invalid character '.'",
+		"iam\tlist": "invalid character",
+		"ns café":   "invalid character",
+	}
+	for name, msg := range cases {
+		err := ValidateCommandName(name)
+		if assert.Error(err, name) {
+			assert.Contains(err.Error(), msg, name)
+		}
+	}
+}
+
+func TestValidateMetadata(t *testing.T) {
+	assert := assert.New(t)
+
+	m := PluginMetadata{
+		Namespaces: []Namespace{{Name: "iam"}, {Name: "iam service-ids"}},
+		Commands: []Command{
+			{Namespace: "iam service-ids", Name: "list", Alias: "ls"},
+			{Name: "version"},
+		},
+	}
+	warnings, err := ValidateMetadata(m)
+	assert.NoError(err)
+	assert.Empty(warnings)
+
+	m.Namespaces = append(m.Namespaces, Namespace{Name: "IAM"})
+	_, err = ValidateMetadata(m)
+	if assert.Error(err) {
+		assert.Contains(err.Error(), "Invalid namespace 'IAM'")
+	}
+
+	m.Namespaces = nil
+	m.Commands = []Command{{Namespace: "iam", Name: "list all"}}
+	_, err = ValidateMetadata(m)
+	if assert.Error(err) {
+		assert.Contains(err.Error(), "Invalid command 'iam list all'")
+		assert.Contains(err.Error(), "must not contain spaces")
+	}
+
+	m.Commands = []Command{{Name: "list", Alias: "l_s"}}
+	_, err = ValidateMetadata(m)
+	if assert.Error(err) {
+		assert.Contains(err.Error(), "invalid character '_'")
+	}
+}
+
+func TestValidateMetadata_UsageFlags(t *testing.T) {
+	assert := assert.New(t)
+
+	m := PluginMetadata{
+		Commands: []Command{
+			{
+				Name:  "create",
+				Usage: "create NAME [-g RESOURCE_GROUP] [--output json] [--tag TAG] [--tag TAG] [--force]\n\nEXAMPLE:\n  create my-app --dry-run",
+				Flags: []Flag{{Name: "g"}, {Name: "output"}, {Name: "f,force"}},
+			},
+		},
+	}
+
+	warnings, err := ValidateMetadata(m)
+	assert.NoError(err)
+	if assert.Len(warnings, 2) {
+		assert.Contains(warnings[0], "'--tag'")
+		assert.Contains(warnings[1], "'--dry-run'")
+	}
+}
@@ -0,0 +1,61 @@
+package plugin
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+)
+
+// VersionFile is the name of the file in the plugin directory holding the
+// plugin version, see VersionFromFile.
+const VersionFile = "VERSION"
+
+// VersionFromString parses the plugin version from a string set at build
+// time, so that the version needn't be edited in code for a release. It
+// accepts what ParseVersion accepts, and also build metadata, e.g.
+// "1.2.3+build.5", which is ignored. It returns the zero version if s is
+// empty, "dev" or not a valid version, and for pre-releases and the output of
+// 'git describe' for an untagged commit, e.g. "v2.0.0-rc.1" or
+// "v1.2.3-4-gabcdef", so that such builds are not reported as the release.
+//
+// The version is usually injected with ldflags:
+//
+//	var version = "dev"
+//
+//	func (p *MyPlugin) GetMetadata() plugin.PluginMetadata {
+//		return plugin.PluginMetadata{
+//			Version: plugin.VersionFromString(version),
+//			...
+//		}
+//	}
+//
+// and built with:
+//
+//	go build -ldflags "-X main.version=$(git describe --tags --exact-match)"
+func VersionFromString(s string) VersionType {
+	s = strings.TrimSpace(s)
+	if i := strings.Index(s, "+"); i >= 0 {
+		s = s[:i]
+	}
+	if strings.Contains(s, "-") {
+		return VersionType{}
+	}
+
+	v, err := ParseVersion(s)
+	if err != nil {
+		return VersionType{}
+	}
+	return v
+}
+
+// VersionFromFile reads the plugin version from file VERSION in the plugin
+// directory, see PluginContext.PluginDirectory. It is a fallback for builds
+// without the version injected. The zero version is returned if the file
+// does not exist or is invalid.
+func VersionFromFile(c PluginContext) VersionType {
+	b, err := ioutil.ReadFile(filepath.Join(c.PluginDirectory(), VersionFile))
+	if err != nil {
+		return VersionType{}
+	}
+	return VersionFromString(string(b))
+}
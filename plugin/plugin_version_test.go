@@ -0,0 +1,46 @@
+package plugin
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/testhelpers/configuration"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVersionFromString(t *testing.T) {
+	assert := assert.New(t)
+
+	cases := map[string]VersionType{
+		"1.2.3":            {1, 2, 3},
+		"v1.2.3":           {1, 2, 3},
+		" 1.2.3\n":         {1, 2, 3},
+		"1.2":              {1, 2, 0},
+		"v1.2.3-4-gabcdef": {},
+		"1.2.3+build.5":    {1, 2, 3},
+		"v2.0.0-rc.1":      {},
+		"":                 {},
+		"dev":              {},
+		"not-a-version":    {},
+		"1.2.3.4":          {},
+	}
+	for s, expected := range cases {
+		assert.Equal(expected, VersionFromString(s), s)
+	}
+}
+
+func TestVersionFromFile(t *testing.T) {
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "plugin")
+	assert.NoError(err)
+	defer os.RemoveAll(dir)
+
+	c := createPluginContext(dir, configuration.NewFakeCoreConfig())
+	assert.Equal(VersionType{}, VersionFromFile(c))
+
+	assert.NoError(ioutil.WriteFile(filepath.Join(dir, VersionFile), []byte("v0.4.1\n"), 0600))
+	assert.Equal(VersionType{0, 4, 1}, VersionFromFile(c))
+}
@@ -238,6 +238,30 @@ type FakePluginConfig struct {
 	eraseReturnsOnCall map[int]struct {
 		result1 error
 	}
+	GetStructStub        func(key string, v interface{}) error
+	getStructMutex       sync.RWMutex
+	getStructArgsForCall []struct {
+		key string
+		v   interface{}
+	}
+	getStructReturns struct {
+		result1 error
+	}
+	getStructReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SetStructStub        func(key string, v interface{}) error
+	setStructMutex       sync.RWMutex
+	setStructArgsForCall []struct {
+		key string
+		v   interface{}
+	}
+	setStructReturns struct {
+		result1 error
+	}
+	setStructReturnsOnCall map[int]struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -1151,6 +1175,104 @@ func (fake *FakePluginConfig) EraseReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakePluginConfig) GetStruct(key string, v interface{}) error {
+	fake.getStructMutex.Lock()
+	ret, specificReturn := fake.getStructReturnsOnCall[len(fake.getStructArgsForCall)]
+	fake.getStructArgsForCall = append(fake.getStructArgsForCall, struct {
+		key string
+		v   interface{}
+	}{key, v})
+	fake.recordInvocation("GetStruct", []interface{}{key, v})
+	fake.getStructMutex.Unlock()
+	if fake.GetStructStub != nil {
+		return fake.GetStructStub(key, v)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.getStructReturns.result1
+}
+
+func (fake *FakePluginConfig) GetStructCallCount() int {
+	fake.getStructMutex.RLock()
+	defer fake.getStructMutex.RUnlock()
+	return len(fake.getStructArgsForCall)
+}
+
+func (fake *FakePluginConfig) GetStructArgsForCall(i int) (string, interface{}) {
+	fake.getStructMutex.RLock()
+	defer fake.getStructMutex.RUnlock()
+	return fake.getStructArgsForCall[i].key, fake.getStructArgsForCall[i].v
+}
+
+func (fake *FakePluginConfig) GetStructReturns(result1 error) {
+	fake.GetStructStub = nil
+	fake.getStructReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePluginConfig) GetStructReturnsOnCall(i int, result1 error) {
+	fake.GetStructStub = nil
+	if fake.getStructReturnsOnCall == nil {
+		fake.getStructReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.getStructReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePluginConfig) SetStruct(key string, v interface{}) error {
+	fake.setStructMutex.Lock()
+	ret, specificReturn := fake.setStructReturnsOnCall[len(fake.setStructArgsForCall)]
+	fake.setStructArgsForCall = append(fake.setStructArgsForCall, struct {
+		key string
+		v   interface{}
+	}{key, v})
+	fake.recordInvocation("SetStruct", []interface{}{key, v})
+	fake.setStructMutex.Unlock()
+	if fake.SetStructStub != nil {
+		return fake.SetStructStub(key, v)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.setStructReturns.result1
+}
+
+func (fake *FakePluginConfig) SetStructCallCount() int {
+	fake.setStructMutex.RLock()
+	defer fake.setStructMutex.RUnlock()
+	return len(fake.setStructArgsForCall)
+}
+
+func (fake *FakePluginConfig) SetStructArgsForCall(i int) (string, interface{}) {
+	fake.setStructMutex.RLock()
+	defer fake.setStructMutex.RUnlock()
+	return fake.setStructArgsForCall[i].key, fake.setStructArgsForCall[i].v
+}
+
+func (fake *FakePluginConfig) SetStructReturns(result1 error) {
+	fake.SetStructStub = nil
+	fake.setStructReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePluginConfig) SetStructReturnsOnCall(i int, result1 error) {
+	fake.SetStructStub = nil
+	if fake.setStructReturnsOnCall == nil {
+		fake.setStructReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setStructReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakePluginConfig) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -1190,6 +1312,10 @@ func (fake *FakePluginConfig) Invocations() map[string][][]interface{} {
 	defer fake.setMutex.RUnlock()
 	fake.eraseMutex.RLock()
 	defer fake.eraseMutex.RUnlock()
+	fake.getStructMutex.RLock()
+	defer fake.getStructMutex.RUnlock()
+	fake.setStructMutex.RLock()
+	defer fake.setStructMutex.RUnlock()
 	return fake.invocations
 }
 
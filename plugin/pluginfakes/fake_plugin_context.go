@@ -2,7 +2,10 @@
 package pluginfakes
 
 import (
+	"context"
+	"io"
 	"sync"
+	"time"
 
 	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/bluemix/models"
 	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/plugin"
@@ -272,6 +275,343 @@ type FakePluginContext struct {
 	cLINameReturnsOnCall map[int]struct {
 		result1 string
 	}
+	CheckForUpdateStub        func() (*plugin.UpdateInfo, error)
+	checkForUpdateMutex       sync.RWMutex
+	checkForUpdateArgsForCall []struct{}
+	checkForUpdateReturns     struct {
+		result1 *plugin.UpdateInfo
+		result2 error
+	}
+	checkForUpdateReturnsOnCall map[int]struct {
+		result1 *plugin.UpdateInfo
+		result2 error
+	}
+	CLIVersionStub        func() string
+	cLIVersionMutex       sync.RWMutex
+	cLIVersionArgsForCall []struct{}
+	cLIVersionReturns     struct {
+		result1 string
+	}
+	cLIVersionReturnsOnCall map[int]struct {
+		result1 string
+	}
+	IsInteractiveStub        func() bool
+	isInteractiveMutex       sync.RWMutex
+	isInteractiveArgsForCall []struct{}
+	isInteractiveReturns     struct {
+		result1 bool
+	}
+	isInteractiveReturnsOnCall map[int]struct {
+		result1 bool
+	}
+	MCCPEndpointStub        func() (string, bool)
+	mCCPEndpointMutex       sync.RWMutex
+	mCCPEndpointArgsForCall []struct{}
+	mCCPEndpointReturns     struct {
+		result1 string
+		result2 bool
+	}
+	mCCPEndpointReturnsOnCall map[int]struct {
+		result1 string
+		result2 bool
+	}
+	HTTPTimeoutDurationStub        func() time.Duration
+	hTTPTimeoutDurationMutex       sync.RWMutex
+	hTTPTimeoutDurationArgsForCall []struct{}
+	hTTPTimeoutDurationReturns     struct {
+		result1 time.Duration
+	}
+	hTTPTimeoutDurationReturnsOnCall map[int]struct {
+		result1 time.Duration
+	}
+	RefreshIAMTokenForAccountStub        func(accountID string) (string, error)
+	refreshIAMTokenForAccountMutex       sync.RWMutex
+	refreshIAMTokenForAccountArgsForCall []struct {
+		accountID string
+	}
+	refreshIAMTokenForAccountReturns struct {
+		result1 string
+		result2 error
+	}
+	refreshIAMTokenForAccountReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	IsClassicCLIStub        func() bool
+	isClassicCLIMutex       sync.RWMutex
+	isClassicCLIArgsForCall []struct{}
+	isClassicCLIReturns     struct {
+		result1 bool
+	}
+	isClassicCLIReturnsOnCall map[int]struct {
+		result1 bool
+	}
+	IsIBMCloudCLIStub        func() bool
+	isIBMCloudCLIMutex       sync.RWMutex
+	isIBMCloudCLIArgsForCall []struct{}
+	isIBMCloudCLIReturns     struct {
+		result1 bool
+	}
+	isIBMCloudCLIReturnsOnCall map[int]struct {
+		result1 bool
+	}
+	PasscodeEndpointStub        func() string
+	passcodeEndpointMutex       sync.RWMutex
+	passcodeEndpointArgsForCall []struct{}
+	passcodeEndpointReturns     struct {
+		result1 string
+	}
+	passcodeEndpointReturnsOnCall map[int]struct {
+		result1 string
+	}
+	LoginURLStub        func() string
+	loginURLMutex       sync.RWMutex
+	loginURLArgsForCall []struct{}
+	loginURLReturns     struct {
+		result1 string
+	}
+	loginURLReturnsOnCall map[int]struct {
+		result1 string
+	}
+	ReloadStub        func() error
+	reloadMutex       sync.RWMutex
+	reloadArgsForCall []struct{}
+	reloadReturns     struct {
+		result1 error
+	}
+	reloadReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ListResourceInstancesStub        func(q plugin.ResourceInstanceQuery) ([]models.ServiceInstance, error)
+	listResourceInstancesMutex       sync.RWMutex
+	listResourceInstancesArgsForCall []struct {
+		q plugin.ResourceInstanceQuery
+	}
+	listResourceInstancesReturns struct {
+		result1 []models.ServiceInstance
+		result2 error
+	}
+	listResourceInstancesReturnsOnCall map[int]struct {
+		result1 []models.ServiceInstance
+		result2 error
+	}
+	ResolveServiceInstanceStub        func(name string) (models.ServiceInstance, error)
+	resolveServiceInstanceMutex       sync.RWMutex
+	resolveServiceInstanceArgsForCall []struct {
+		name string
+	}
+	resolveServiceInstanceReturns struct {
+		result1 models.ServiceInstance
+		result2 error
+	}
+	resolveServiceInstanceReturnsOnCall map[int]struct {
+		result1 models.ServiceInstance
+		result2 error
+	}
+	ResolveServiceInstanceInResourceGroupStub        func(name string, resourceGroupID string) (models.ServiceInstance, error)
+	resolveServiceInstanceInResourceGroupMutex       sync.RWMutex
+	resolveServiceInstanceInResourceGroupArgsForCall []struct {
+		name            string
+		resourceGroupID string
+	}
+	resolveServiceInstanceInResourceGroupReturns struct {
+		result1 models.ServiceInstance
+		result2 error
+	}
+	resolveServiceInstanceInResourceGroupReturnsOnCall map[int]struct {
+		result1 models.ServiceInstance
+		result2 error
+	}
+	IMSUsernameStub        func() string
+	iMSUsernameMutex       sync.RWMutex
+	iMSUsernameArgsForCall []struct{}
+	iMSUsernameReturns     struct {
+		result1 string
+	}
+	iMSUsernameReturnsOnCall map[int]struct {
+		result1 string
+	}
+	IMSAPIKeyStub        func() string
+	iMSAPIKeyMutex       sync.RWMutex
+	iMSAPIKeyArgsForCall []struct{}
+	iMSAPIKeyReturns     struct {
+		result1 string
+	}
+	iMSAPIKeyReturnsOnCall map[int]struct {
+		result1 string
+	}
+	StartTokenRefresherStub        func(ctx context.Context, interval time.Duration) func()
+	startTokenRefresherMutex       sync.RWMutex
+	startTokenRefresherArgsForCall []struct {
+		ctx      context.Context
+		interval time.Duration
+	}
+	startTokenRefresherReturns struct {
+		result1 func()
+	}
+	startTokenRefresherReturnsOnCall map[int]struct {
+		result1 func()
+	}
+	ResolveResourceGroupStub        func(nameOrID string) (models.ResourceGroup, error)
+	resolveResourceGroupMutex       sync.RWMutex
+	resolveResourceGroupArgsForCall []struct {
+		nameOrID string
+	}
+	resolveResourceGroupReturns struct {
+		result1 models.ResourceGroup
+		result2 error
+	}
+	resolveResourceGroupReturnsOnCall map[int]struct {
+		result1 models.ResourceGroup
+		result2 error
+	}
+	DefaultRegionStub        func() (models.Region, error)
+	defaultRegionMutex       sync.RWMutex
+	defaultRegionArgsForCall []struct{}
+	defaultRegionReturns     struct {
+		result1 models.Region
+		result2 error
+	}
+	defaultRegionReturnsOnCall map[int]struct {
+		result1 models.Region
+		result2 error
+	}
+	RefreshAllTokensStub        func() error
+	refreshAllTokensMutex       sync.RWMutex
+	refreshAllTokensArgsForCall []struct{}
+	refreshAllTokensReturns     struct {
+		result1 error
+	}
+	refreshAllTokensReturnsOnCall map[int]struct {
+		result1 error
+	}
+	StdoutStub        func() io.Writer
+	stdoutMutex       sync.RWMutex
+	stdoutArgsForCall []struct{}
+	stdoutReturns     struct {
+		result1 io.Writer
+	}
+	stdoutReturnsOnCall map[int]struct {
+		result1 io.Writer
+	}
+	StderrStub        func() io.Writer
+	stderrMutex       sync.RWMutex
+	stderrArgsForCall []struct{}
+	stderrReturns     struct {
+		result1 io.Writer
+	}
+	stderrReturnsOnCall map[int]struct {
+		result1 io.Writer
+	}
+	IsCompletionStub        func() bool
+	isCompletionMutex       sync.RWMutex
+	isCompletionArgsForCall []struct{}
+	isCompletionReturns     struct {
+		result1 bool
+	}
+	isCompletionReturnsOnCall map[int]struct {
+		result1 bool
+	}
+	DefaultResourceGroupStub        func() models.ResourceGroup
+	defaultResourceGroupMutex       sync.RWMutex
+	defaultResourceGroupArgsForCall []struct{}
+	defaultResourceGroupReturns     struct {
+		result1 models.ResourceGroup
+	}
+	defaultResourceGroupReturnsOnCall map[int]struct {
+		result1 models.ResourceGroup
+	}
+	SetDefaultResourceGroupStub        func(nameOrID string) (models.ResourceGroup, error)
+	setDefaultResourceGroupMutex       sync.RWMutex
+	setDefaultResourceGroupArgsForCall []struct {
+		nameOrID string
+	}
+	setDefaultResourceGroupReturns struct {
+		result1 models.ResourceGroup
+		result2 error
+	}
+	setDefaultResourceGroupReturnsOnCall map[int]struct {
+		result1 models.ResourceGroup
+		result2 error
+	}
+	AccountRoleStub        func() (string, error)
+	accountRoleMutex       sync.RWMutex
+	accountRoleArgsForCall []struct{}
+	accountRoleReturns     struct {
+		result1 string
+		result2 error
+	}
+	accountRoleReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	WarnStub        func(format string, args ...interface{})
+	warnMutex       sync.RWMutex
+	warnArgsForCall []struct {
+		format string
+		args   []interface{}
+	}
+	InfoStub        func(format string, args ...interface{})
+	infoMutex       sync.RWMutex
+	infoArgsForCall []struct {
+		format string
+		args   []interface{}
+	}
+	RefreshTokenExpiresAtStub        func() (time.Time, error)
+	refreshTokenExpiresAtMutex       sync.RWMutex
+	refreshTokenExpiresAtArgsForCall []struct{}
+	refreshTokenExpiresAtReturns     struct {
+		result1 time.Time
+		result2 error
+	}
+	refreshTokenExpiresAtReturnsOnCall map[int]struct {
+		result1 time.Time
+		result2 error
+	}
+	IAMTokenForChildAccountStub        func(accountID string) (string, error)
+	iAMTokenForChildAccountMutex       sync.RWMutex
+	iAMTokenForChildAccountArgsForCall []struct {
+		accountID string
+	}
+	iAMTokenForChildAccountReturns struct {
+		result1 string
+		result2 error
+	}
+	iAMTokenForChildAccountReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	ExportTargetStub        func() ([]byte, error)
+	exportTargetMutex       sync.RWMutex
+	exportTargetArgsForCall []struct{}
+	exportTargetReturns     struct {
+		result1 []byte
+		result2 error
+	}
+	exportTargetReturnsOnCall map[int]struct {
+		result1 []byte
+		result2 error
+	}
+	ImportTargetStub        func(data []byte) error
+	importTargetMutex       sync.RWMutex
+	importTargetArgsForCall []struct {
+		data []byte
+	}
+	importTargetReturns struct {
+		result1 error
+	}
+	importTargetReturnsOnCall map[int]struct {
+		result1 error
+	}
+	CorrelationIDStub        func() string
+	correlationIDMutex       sync.RWMutex
+	correlationIDArgsForCall []struct{}
+	correlationIDReturns     struct {
+		result1 string
+	}
+	correlationIDReturnsOnCall map[int]struct {
+		result1 string
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -1439,6 +1779,1414 @@ func (fake *FakePluginContext) CLINameReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakePluginContext) CheckForUpdate() (*plugin.UpdateInfo, error) {
+	fake.checkForUpdateMutex.Lock()
+	ret, specificReturn := fake.checkForUpdateReturnsOnCall[len(fake.checkForUpdateArgsForCall)]
+	fake.checkForUpdateArgsForCall = append(fake.checkForUpdateArgsForCall, struct{}{})
+	fake.recordInvocation("CheckForUpdate", []interface{}{})
+	fake.checkForUpdateMutex.Unlock()
+	if fake.CheckForUpdateStub != nil {
+		return fake.CheckForUpdateStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.checkForUpdateReturns.result1, fake.checkForUpdateReturns.result2
+}
+
+func (fake *FakePluginContext) CheckForUpdateCallCount() int {
+	fake.checkForUpdateMutex.RLock()
+	defer fake.checkForUpdateMutex.RUnlock()
+	return len(fake.checkForUpdateArgsForCall)
+}
+
+func (fake *FakePluginContext) CheckForUpdateReturns(result1 *plugin.UpdateInfo, result2 error) {
+	fake.CheckForUpdateStub = nil
+	fake.checkForUpdateReturns = struct {
+		result1 *plugin.UpdateInfo
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePluginContext) CheckForUpdateReturnsOnCall(i int, result1 *plugin.UpdateInfo, result2 error) {
+	fake.CheckForUpdateStub = nil
+	if fake.checkForUpdateReturnsOnCall == nil {
+		fake.checkForUpdateReturnsOnCall = make(map[int]struct {
+			result1 *plugin.UpdateInfo
+			result2 error
+		})
+	}
+	fake.checkForUpdateReturnsOnCall[i] = struct {
+		result1 *plugin.UpdateInfo
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePluginContext) CLIVersion() string {
+	fake.cLIVersionMutex.Lock()
+	ret, specificReturn := fake.cLIVersionReturnsOnCall[len(fake.cLIVersionArgsForCall)]
+	fake.cLIVersionArgsForCall = append(fake.cLIVersionArgsForCall, struct{}{})
+	fake.recordInvocation("CLIVersion", []interface{}{})
+	fake.cLIVersionMutex.Unlock()
+	if fake.CLIVersionStub != nil {
+		return fake.CLIVersionStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.cLIVersionReturns.result1
+}
+
+func (fake *FakePluginContext) CLIVersionCallCount() int {
+	fake.cLIVersionMutex.RLock()
+	defer fake.cLIVersionMutex.RUnlock()
+	return len(fake.cLIVersionArgsForCall)
+}
+
+func (fake *FakePluginContext) CLIVersionReturns(result1 string) {
+	fake.CLIVersionStub = nil
+	fake.cLIVersionReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakePluginContext) CLIVersionReturnsOnCall(i int, result1 string) {
+	fake.CLIVersionStub = nil
+	if fake.cLIVersionReturnsOnCall == nil {
+		fake.cLIVersionReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.cLIVersionReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakePluginContext) IsInteractive() bool {
+	fake.isInteractiveMutex.Lock()
+	ret, specificReturn := fake.isInteractiveReturnsOnCall[len(fake.isInteractiveArgsForCall)]
+	fake.isInteractiveArgsForCall = append(fake.isInteractiveArgsForCall, struct{}{})
+	fake.recordInvocation("IsInteractive", []interface{}{})
+	fake.isInteractiveMutex.Unlock()
+	if fake.IsInteractiveStub != nil {
+		return fake.IsInteractiveStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.isInteractiveReturns.result1
+}
+
+func (fake *FakePluginContext) IsInteractiveCallCount() int {
+	fake.isInteractiveMutex.RLock()
+	defer fake.isInteractiveMutex.RUnlock()
+	return len(fake.isInteractiveArgsForCall)
+}
+
+func (fake *FakePluginContext) IsInteractiveReturns(result1 bool) {
+	fake.IsInteractiveStub = nil
+	fake.isInteractiveReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakePluginContext) IsInteractiveReturnsOnCall(i int, result1 bool) {
+	fake.IsInteractiveStub = nil
+	if fake.isInteractiveReturnsOnCall == nil {
+		fake.isInteractiveReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.isInteractiveReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakePluginContext) MCCPEndpoint() (string, bool) {
+	fake.mCCPEndpointMutex.Lock()
+	ret, specificReturn := fake.mCCPEndpointReturnsOnCall[len(fake.mCCPEndpointArgsForCall)]
+	fake.mCCPEndpointArgsForCall = append(fake.mCCPEndpointArgsForCall, struct{}{})
+	fake.recordInvocation("MCCPEndpoint", []interface{}{})
+	fake.mCCPEndpointMutex.Unlock()
+	if fake.MCCPEndpointStub != nil {
+		return fake.MCCPEndpointStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.mCCPEndpointReturns.result1, fake.mCCPEndpointReturns.result2
+}
+
+func (fake *FakePluginContext) MCCPEndpointCallCount() int {
+	fake.mCCPEndpointMutex.RLock()
+	defer fake.mCCPEndpointMutex.RUnlock()
+	return len(fake.mCCPEndpointArgsForCall)
+}
+
+func (fake *FakePluginContext) MCCPEndpointReturns(result1 string, result2 bool) {
+	fake.MCCPEndpointStub = nil
+	fake.mCCPEndpointReturns = struct {
+		result1 string
+		result2 bool
+	}{result1, result2}
+}
+
+func (fake *FakePluginContext) MCCPEndpointReturnsOnCall(i int, result1 string, result2 bool) {
+	fake.MCCPEndpointStub = nil
+	if fake.mCCPEndpointReturnsOnCall == nil {
+		fake.mCCPEndpointReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 bool
+		})
+	}
+	fake.mCCPEndpointReturnsOnCall[i] = struct {
+		result1 string
+		result2 bool
+	}{result1, result2}
+}
+
+func (fake *FakePluginContext) HTTPTimeoutDuration() time.Duration {
+	fake.hTTPTimeoutDurationMutex.Lock()
+	ret, specificReturn := fake.hTTPTimeoutDurationReturnsOnCall[len(fake.hTTPTimeoutDurationArgsForCall)]
+	fake.hTTPTimeoutDurationArgsForCall = append(fake.hTTPTimeoutDurationArgsForCall, struct{}{})
+	fake.recordInvocation("HTTPTimeoutDuration", []interface{}{})
+	fake.hTTPTimeoutDurationMutex.Unlock()
+	if fake.HTTPTimeoutDurationStub != nil {
+		return fake.HTTPTimeoutDurationStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.hTTPTimeoutDurationReturns.result1
+}
+
+func (fake *FakePluginContext) HTTPTimeoutDurationCallCount() int {
+	fake.hTTPTimeoutDurationMutex.RLock()
+	defer fake.hTTPTimeoutDurationMutex.RUnlock()
+	return len(fake.hTTPTimeoutDurationArgsForCall)
+}
+
+func (fake *FakePluginContext) HTTPTimeoutDurationReturns(result1 time.Duration) {
+	fake.HTTPTimeoutDurationStub = nil
+	fake.hTTPTimeoutDurationReturns = struct {
+		result1 time.Duration
+	}{result1}
+}
+
+func (fake *FakePluginContext) HTTPTimeoutDurationReturnsOnCall(i int, result1 time.Duration) {
+	fake.HTTPTimeoutDurationStub = nil
+	if fake.hTTPTimeoutDurationReturnsOnCall == nil {
+		fake.hTTPTimeoutDurationReturnsOnCall = make(map[int]struct {
+			result1 time.Duration
+		})
+	}
+	fake.hTTPTimeoutDurationReturnsOnCall[i] = struct {
+		result1 time.Duration
+	}{result1}
+}
+
+func (fake *FakePluginContext) RefreshIAMTokenForAccount(accountID string) (string, error) {
+	fake.refreshIAMTokenForAccountMutex.Lock()
+	ret, specificReturn := fake.refreshIAMTokenForAccountReturnsOnCall[len(fake.refreshIAMTokenForAccountArgsForCall)]
+	fake.refreshIAMTokenForAccountArgsForCall = append(fake.refreshIAMTokenForAccountArgsForCall, struct {
+		accountID string
+	}{accountID})
+	fake.recordInvocation("RefreshIAMTokenForAccount", []interface{}{accountID})
+	fake.refreshIAMTokenForAccountMutex.Unlock()
+	if fake.RefreshIAMTokenForAccountStub != nil {
+		return fake.RefreshIAMTokenForAccountStub(accountID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.refreshIAMTokenForAccountReturns.result1, fake.refreshIAMTokenForAccountReturns.result2
+}
+
+func (fake *FakePluginContext) RefreshIAMTokenForAccountCallCount() int {
+	fake.refreshIAMTokenForAccountMutex.RLock()
+	defer fake.refreshIAMTokenForAccountMutex.RUnlock()
+	return len(fake.refreshIAMTokenForAccountArgsForCall)
+}
+
+func (fake *FakePluginContext) RefreshIAMTokenForAccountArgsForCall(i int) string {
+	fake.refreshIAMTokenForAccountMutex.RLock()
+	defer fake.refreshIAMTokenForAccountMutex.RUnlock()
+	return fake.refreshIAMTokenForAccountArgsForCall[i].accountID
+}
+
+func (fake *FakePluginContext) RefreshIAMTokenForAccountReturns(result1 string, result2 error) {
+	fake.RefreshIAMTokenForAccountStub = nil
+	fake.refreshIAMTokenForAccountReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePluginContext) RefreshIAMTokenForAccountReturnsOnCall(i int, result1 string, result2 error) {
+	fake.RefreshIAMTokenForAccountStub = nil
+	if fake.refreshIAMTokenForAccountReturnsOnCall == nil {
+		fake.refreshIAMTokenForAccountReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.refreshIAMTokenForAccountReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePluginContext) IsClassicCLI() bool {
+	fake.isClassicCLIMutex.Lock()
+	ret, specificReturn := fake.isClassicCLIReturnsOnCall[len(fake.isClassicCLIArgsForCall)]
+	fake.isClassicCLIArgsForCall = append(fake.isClassicCLIArgsForCall, struct{}{})
+	fake.recordInvocation("IsClassicCLI", []interface{}{})
+	fake.isClassicCLIMutex.Unlock()
+	if fake.IsClassicCLIStub != nil {
+		return fake.IsClassicCLIStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.isClassicCLIReturns.result1
+}
+
+func (fake *FakePluginContext) IsClassicCLICallCount() int {
+	fake.isClassicCLIMutex.RLock()
+	defer fake.isClassicCLIMutex.RUnlock()
+	return len(fake.isClassicCLIArgsForCall)
+}
+
+func (fake *FakePluginContext) IsClassicCLIReturns(result1 bool) {
+	fake.IsClassicCLIStub = nil
+	fake.isClassicCLIReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakePluginContext) IsClassicCLIReturnsOnCall(i int, result1 bool) {
+	fake.IsClassicCLIStub = nil
+	if fake.isClassicCLIReturnsOnCall == nil {
+		fake.isClassicCLIReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.isClassicCLIReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakePluginContext) IsIBMCloudCLI() bool {
+	fake.isIBMCloudCLIMutex.Lock()
+	ret, specificReturn := fake.isIBMCloudCLIReturnsOnCall[len(fake.isIBMCloudCLIArgsForCall)]
+	fake.isIBMCloudCLIArgsForCall = append(fake.isIBMCloudCLIArgsForCall, struct{}{})
+	fake.recordInvocation("IsIBMCloudCLI", []interface{}{})
+	fake.isIBMCloudCLIMutex.Unlock()
+	if fake.IsIBMCloudCLIStub != nil {
+		return fake.IsIBMCloudCLIStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.isIBMCloudCLIReturns.result1
+}
+
+func (fake *FakePluginContext) IsIBMCloudCLICallCount() int {
+	fake.isIBMCloudCLIMutex.RLock()
+	defer fake.isIBMCloudCLIMutex.RUnlock()
+	return len(fake.isIBMCloudCLIArgsForCall)
+}
+
+func (fake *FakePluginContext) IsIBMCloudCLIReturns(result1 bool) {
+	fake.IsIBMCloudCLIStub = nil
+	fake.isIBMCloudCLIReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakePluginContext) IsIBMCloudCLIReturnsOnCall(i int, result1 bool) {
+	fake.IsIBMCloudCLIStub = nil
+	if fake.isIBMCloudCLIReturnsOnCall == nil {
+		fake.isIBMCloudCLIReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.isIBMCloudCLIReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakePluginContext) PasscodeEndpoint() string {
+	fake.passcodeEndpointMutex.Lock()
+	ret, specificReturn := fake.passcodeEndpointReturnsOnCall[len(fake.passcodeEndpointArgsForCall)]
+	fake.passcodeEndpointArgsForCall = append(fake.passcodeEndpointArgsForCall, struct{}{})
+	fake.recordInvocation("PasscodeEndpoint", []interface{}{})
+	fake.passcodeEndpointMutex.Unlock()
+	if fake.PasscodeEndpointStub != nil {
+		return fake.PasscodeEndpointStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.passcodeEndpointReturns.result1
+}
+
+func (fake *FakePluginContext) PasscodeEndpointCallCount() int {
+	fake.passcodeEndpointMutex.RLock()
+	defer fake.passcodeEndpointMutex.RUnlock()
+	return len(fake.passcodeEndpointArgsForCall)
+}
+
+func (fake *FakePluginContext) PasscodeEndpointReturns(result1 string) {
+	fake.PasscodeEndpointStub = nil
+	fake.passcodeEndpointReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakePluginContext) PasscodeEndpointReturnsOnCall(i int, result1 string) {
+	fake.PasscodeEndpointStub = nil
+	if fake.passcodeEndpointReturnsOnCall == nil {
+		fake.passcodeEndpointReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.passcodeEndpointReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakePluginContext) LoginURL() string {
+	fake.loginURLMutex.Lock()
+	ret, specificReturn := fake.loginURLReturnsOnCall[len(fake.loginURLArgsForCall)]
+	fake.loginURLArgsForCall = append(fake.loginURLArgsForCall, struct{}{})
+	fake.recordInvocation("LoginURL", []interface{}{})
+	fake.loginURLMutex.Unlock()
+	if fake.LoginURLStub != nil {
+		return fake.LoginURLStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.loginURLReturns.result1
+}
+
+func (fake *FakePluginContext) LoginURLCallCount() int {
+	fake.loginURLMutex.RLock()
+	defer fake.loginURLMutex.RUnlock()
+	return len(fake.loginURLArgsForCall)
+}
+
+func (fake *FakePluginContext) LoginURLReturns(result1 string) {
+	fake.LoginURLStub = nil
+	fake.loginURLReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakePluginContext) LoginURLReturnsOnCall(i int, result1 string) {
+	fake.LoginURLStub = nil
+	if fake.loginURLReturnsOnCall == nil {
+		fake.loginURLReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.loginURLReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakePluginContext) Reload() error {
+	fake.reloadMutex.Lock()
+	ret, specificReturn := fake.reloadReturnsOnCall[len(fake.reloadArgsForCall)]
+	fake.reloadArgsForCall = append(fake.reloadArgsForCall, struct{}{})
+	fake.recordInvocation("Reload", []interface{}{})
+	fake.reloadMutex.Unlock()
+	if fake.ReloadStub != nil {
+		return fake.ReloadStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.reloadReturns.result1
+}
+
+func (fake *FakePluginContext) ReloadCallCount() int {
+	fake.reloadMutex.RLock()
+	defer fake.reloadMutex.RUnlock()
+	return len(fake.reloadArgsForCall)
+}
+
+func (fake *FakePluginContext) ReloadReturns(result1 error) {
+	fake.ReloadStub = nil
+	fake.reloadReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePluginContext) ReloadReturnsOnCall(i int, result1 error) {
+	fake.ReloadStub = nil
+	if fake.reloadReturnsOnCall == nil {
+		fake.reloadReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.reloadReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePluginContext) ListResourceInstances(q plugin.ResourceInstanceQuery) ([]models.ServiceInstance, error) {
+	fake.listResourceInstancesMutex.Lock()
+	ret, specificReturn := fake.listResourceInstancesReturnsOnCall[len(fake.listResourceInstancesArgsForCall)]
+	fake.listResourceInstancesArgsForCall = append(fake.listResourceInstancesArgsForCall, struct {
+		q plugin.ResourceInstanceQuery
+	}{q})
+	fake.recordInvocation("ListResourceInstances", []interface{}{q})
+	fake.listResourceInstancesMutex.Unlock()
+	if fake.ListResourceInstancesStub != nil {
+		return fake.ListResourceInstancesStub(q)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.listResourceInstancesReturns.result1, fake.listResourceInstancesReturns.result2
+}
+
+func (fake *FakePluginContext) ListResourceInstancesCallCount() int {
+	fake.listResourceInstancesMutex.RLock()
+	defer fake.listResourceInstancesMutex.RUnlock()
+	return len(fake.listResourceInstancesArgsForCall)
+}
+
+func (fake *FakePluginContext) ListResourceInstancesArgsForCall(i int) plugin.ResourceInstanceQuery {
+	fake.listResourceInstancesMutex.RLock()
+	defer fake.listResourceInstancesMutex.RUnlock()
+	return fake.listResourceInstancesArgsForCall[i].q
+}
+
+func (fake *FakePluginContext) ListResourceInstancesReturns(result1 []models.ServiceInstance, result2 error) {
+	fake.ListResourceInstancesStub = nil
+	fake.listResourceInstancesReturns = struct {
+		result1 []models.ServiceInstance
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePluginContext) ListResourceInstancesReturnsOnCall(i int, result1 []models.ServiceInstance, result2 error) {
+	fake.ListResourceInstancesStub = nil
+	if fake.listResourceInstancesReturnsOnCall == nil {
+		fake.listResourceInstancesReturnsOnCall = make(map[int]struct {
+			result1 []models.ServiceInstance
+			result2 error
+		})
+	}
+	fake.listResourceInstancesReturnsOnCall[i] = struct {
+		result1 []models.ServiceInstance
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePluginContext) ResolveServiceInstance(name string) (models.ServiceInstance, error) {
+	fake.resolveServiceInstanceMutex.Lock()
+	ret, specificReturn := fake.resolveServiceInstanceReturnsOnCall[len(fake.resolveServiceInstanceArgsForCall)]
+	fake.resolveServiceInstanceArgsForCall = append(fake.resolveServiceInstanceArgsForCall, struct {
+		name string
+	}{name})
+	fake.recordInvocation("ResolveServiceInstance", []interface{}{name})
+	fake.resolveServiceInstanceMutex.Unlock()
+	if fake.ResolveServiceInstanceStub != nil {
+		return fake.ResolveServiceInstanceStub(name)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.resolveServiceInstanceReturns.result1, fake.resolveServiceInstanceReturns.result2
+}
+
+func (fake *FakePluginContext) ResolveServiceInstanceCallCount() int {
+	fake.resolveServiceInstanceMutex.RLock()
+	defer fake.resolveServiceInstanceMutex.RUnlock()
+	return len(fake.resolveServiceInstanceArgsForCall)
+}
+
+func (fake *FakePluginContext) ResolveServiceInstanceArgsForCall(i int) string {
+	fake.resolveServiceInstanceMutex.RLock()
+	defer fake.resolveServiceInstanceMutex.RUnlock()
+	return fake.resolveServiceInstanceArgsForCall[i].name
+}
+
+func (fake *FakePluginContext) ResolveServiceInstanceReturns(result1 models.ServiceInstance, result2 error) {
+	fake.ResolveServiceInstanceStub = nil
+	fake.resolveServiceInstanceReturns = struct {
+		result1 models.ServiceInstance
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePluginContext) ResolveServiceInstanceReturnsOnCall(i int, result1 models.ServiceInstance, result2 error) {
+	fake.ResolveServiceInstanceStub = nil
+	if fake.resolveServiceInstanceReturnsOnCall == nil {
+		fake.resolveServiceInstanceReturnsOnCall = make(map[int]struct {
+			result1 models.ServiceInstance
+			result2 error
+		})
+	}
+	fake.resolveServiceInstanceReturnsOnCall[i] = struct {
+		result1 models.ServiceInstance
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePluginContext) ResolveServiceInstanceInResourceGroup(name string, resourceGroupID string) (models.ServiceInstance, error) {
+	fake.resolveServiceInstanceInResourceGroupMutex.Lock()
+	ret, specificReturn := fake.resolveServiceInstanceInResourceGroupReturnsOnCall[len(fake.resolveServiceInstanceInResourceGroupArgsForCall)]
+	fake.resolveServiceInstanceInResourceGroupArgsForCall = append(fake.resolveServiceInstanceInResourceGroupArgsForCall, struct {
+		name            string
+		resourceGroupID string
+	}{name, resourceGroupID})
+	fake.recordInvocation("ResolveServiceInstanceInResourceGroup", []interface{}{name, resourceGroupID})
+	fake.resolveServiceInstanceInResourceGroupMutex.Unlock()
+	if fake.ResolveServiceInstanceInResourceGroupStub != nil {
+		return fake.ResolveServiceInstanceInResourceGroupStub(name, resourceGroupID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.resolveServiceInstanceInResourceGroupReturns.result1, fake.resolveServiceInstanceInResourceGroupReturns.result2
+}
+
+func (fake *FakePluginContext) ResolveServiceInstanceInResourceGroupCallCount() int {
+	fake.resolveServiceInstanceInResourceGroupMutex.RLock()
+	defer fake.resolveServiceInstanceInResourceGroupMutex.RUnlock()
+	return len(fake.resolveServiceInstanceInResourceGroupArgsForCall)
+}
+
+func (fake *FakePluginContext) ResolveServiceInstanceInResourceGroupArgsForCall(i int) (string, string) {
+	fake.resolveServiceInstanceInResourceGroupMutex.RLock()
+	defer fake.resolveServiceInstanceInResourceGroupMutex.RUnlock()
+	return fake.resolveServiceInstanceInResourceGroupArgsForCall[i].name, fake.resolveServiceInstanceInResourceGroupArgsForCall[i].resourceGroupID
+}
+
+func (fake *FakePluginContext) ResolveServiceInstanceInResourceGroupReturns(result1 models.ServiceInstance, result2 error) {
+	fake.ResolveServiceInstanceInResourceGroupStub = nil
+	fake.resolveServiceInstanceInResourceGroupReturns = struct {
+		result1 models.ServiceInstance
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePluginContext) ResolveServiceInstanceInResourceGroupReturnsOnCall(i int, result1 models.ServiceInstance, result2 error) {
+	fake.ResolveServiceInstanceInResourceGroupStub = nil
+	if fake.resolveServiceInstanceInResourceGroupReturnsOnCall == nil {
+		fake.resolveServiceInstanceInResourceGroupReturnsOnCall = make(map[int]struct {
+			result1 models.ServiceInstance
+			result2 error
+		})
+	}
+	fake.resolveServiceInstanceInResourceGroupReturnsOnCall[i] = struct {
+		result1 models.ServiceInstance
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePluginContext) IMSUsername() string {
+	fake.iMSUsernameMutex.Lock()
+	ret, specificReturn := fake.iMSUsernameReturnsOnCall[len(fake.iMSUsernameArgsForCall)]
+	fake.iMSUsernameArgsForCall = append(fake.iMSUsernameArgsForCall, struct{}{})
+	fake.recordInvocation("IMSUsername", []interface{}{})
+	fake.iMSUsernameMutex.Unlock()
+	if fake.IMSUsernameStub != nil {
+		return fake.IMSUsernameStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.iMSUsernameReturns.result1
+}
+
+func (fake *FakePluginContext) IMSUsernameCallCount() int {
+	fake.iMSUsernameMutex.RLock()
+	defer fake.iMSUsernameMutex.RUnlock()
+	return len(fake.iMSUsernameArgsForCall)
+}
+
+func (fake *FakePluginContext) IMSUsernameReturns(result1 string) {
+	fake.IMSUsernameStub = nil
+	fake.iMSUsernameReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakePluginContext) IMSUsernameReturnsOnCall(i int, result1 string) {
+	fake.IMSUsernameStub = nil
+	if fake.iMSUsernameReturnsOnCall == nil {
+		fake.iMSUsernameReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.iMSUsernameReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakePluginContext) IMSAPIKey() string {
+	fake.iMSAPIKeyMutex.Lock()
+	ret, specificReturn := fake.iMSAPIKeyReturnsOnCall[len(fake.iMSAPIKeyArgsForCall)]
+	fake.iMSAPIKeyArgsForCall = append(fake.iMSAPIKeyArgsForCall, struct{}{})
+	fake.recordInvocation("IMSAPIKey", []interface{}{})
+	fake.iMSAPIKeyMutex.Unlock()
+	if fake.IMSAPIKeyStub != nil {
+		return fake.IMSAPIKeyStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.iMSAPIKeyReturns.result1
+}
+
+func (fake *FakePluginContext) IMSAPIKeyCallCount() int {
+	fake.iMSAPIKeyMutex.RLock()
+	defer fake.iMSAPIKeyMutex.RUnlock()
+	return len(fake.iMSAPIKeyArgsForCall)
+}
+
+func (fake *FakePluginContext) IMSAPIKeyReturns(result1 string) {
+	fake.IMSAPIKeyStub = nil
+	fake.iMSAPIKeyReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakePluginContext) IMSAPIKeyReturnsOnCall(i int, result1 string) {
+	fake.IMSAPIKeyStub = nil
+	if fake.iMSAPIKeyReturnsOnCall == nil {
+		fake.iMSAPIKeyReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.iMSAPIKeyReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakePluginContext) StartTokenRefresher(ctx context.Context, interval time.Duration) func() {
+	fake.startTokenRefresherMutex.Lock()
+	ret, specificReturn := fake.startTokenRefresherReturnsOnCall[len(fake.startTokenRefresherArgsForCall)]
+	fake.startTokenRefresherArgsForCall = append(fake.startTokenRefresherArgsForCall, struct {
+		ctx      context.Context
+		interval time.Duration
+	}{ctx, interval})
+	fake.recordInvocation("StartTokenRefresher", []interface{}{ctx, interval})
+	fake.startTokenRefresherMutex.Unlock()
+	if fake.StartTokenRefresherStub != nil {
+		return fake.StartTokenRefresherStub(ctx, interval)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.startTokenRefresherReturns.result1
+}
+
+func (fake *FakePluginContext) StartTokenRefresherCallCount() int {
+	fake.startTokenRefresherMutex.RLock()
+	defer fake.startTokenRefresherMutex.RUnlock()
+	return len(fake.startTokenRefresherArgsForCall)
+}
+
+func (fake *FakePluginContext) StartTokenRefresherArgsForCall(i int) (context.Context, time.Duration) {
+	fake.startTokenRefresherMutex.RLock()
+	defer fake.startTokenRefresherMutex.RUnlock()
+	return fake.startTokenRefresherArgsForCall[i].ctx, fake.startTokenRefresherArgsForCall[i].interval
+}
+
+func (fake *FakePluginContext) StartTokenRefresherReturns(result1 func()) {
+	fake.StartTokenRefresherStub = nil
+	fake.startTokenRefresherReturns = struct {
+		result1 func()
+	}{result1}
+}
+
+func (fake *FakePluginContext) StartTokenRefresherReturnsOnCall(i int, result1 func()) {
+	fake.StartTokenRefresherStub = nil
+	if fake.startTokenRefresherReturnsOnCall == nil {
+		fake.startTokenRefresherReturnsOnCall = make(map[int]struct {
+			result1 func()
+		})
+	}
+	fake.startTokenRefresherReturnsOnCall[i] = struct {
+		result1 func()
+	}{result1}
+}
+
+func (fake *FakePluginContext) ResolveResourceGroup(nameOrID string) (models.ResourceGroup, error) {
+	fake.resolveResourceGroupMutex.Lock()
+	ret, specificReturn := fake.resolveResourceGroupReturnsOnCall[len(fake.resolveResourceGroupArgsForCall)]
+	fake.resolveResourceGroupArgsForCall = append(fake.resolveResourceGroupArgsForCall, struct {
+		nameOrID string
+	}{nameOrID})
+	fake.recordInvocation("ResolveResourceGroup", []interface{}{nameOrID})
+	fake.resolveResourceGroupMutex.Unlock()
+	if fake.ResolveResourceGroupStub != nil {
+		return fake.ResolveResourceGroupStub(nameOrID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.resolveResourceGroupReturns.result1, fake.resolveResourceGroupReturns.result2
+}
+
+func (fake *FakePluginContext) ResolveResourceGroupCallCount() int {
+	fake.resolveResourceGroupMutex.RLock()
+	defer fake.resolveResourceGroupMutex.RUnlock()
+	return len(fake.resolveResourceGroupArgsForCall)
+}
+
+func (fake *FakePluginContext) ResolveResourceGroupArgsForCall(i int) string {
+	fake.resolveResourceGroupMutex.RLock()
+	defer fake.resolveResourceGroupMutex.RUnlock()
+	return fake.resolveResourceGroupArgsForCall[i].nameOrID
+}
+
+func (fake *FakePluginContext) ResolveResourceGroupReturns(result1 models.ResourceGroup, result2 error) {
+	fake.ResolveResourceGroupStub = nil
+	fake.resolveResourceGroupReturns = struct {
+		result1 models.ResourceGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePluginContext) ResolveResourceGroupReturnsOnCall(i int, result1 models.ResourceGroup, result2 error) {
+	fake.ResolveResourceGroupStub = nil
+	if fake.resolveResourceGroupReturnsOnCall == nil {
+		fake.resolveResourceGroupReturnsOnCall = make(map[int]struct {
+			result1 models.ResourceGroup
+			result2 error
+		})
+	}
+	fake.resolveResourceGroupReturnsOnCall[i] = struct {
+		result1 models.ResourceGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePluginContext) DefaultRegion() (models.Region, error) {
+	fake.defaultRegionMutex.Lock()
+	ret, specificReturn := fake.defaultRegionReturnsOnCall[len(fake.defaultRegionArgsForCall)]
+	fake.defaultRegionArgsForCall = append(fake.defaultRegionArgsForCall, struct{}{})
+	fake.recordInvocation("DefaultRegion", []interface{}{})
+	fake.defaultRegionMutex.Unlock()
+	if fake.DefaultRegionStub != nil {
+		return fake.DefaultRegionStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.defaultRegionReturns.result1, fake.defaultRegionReturns.result2
+}
+
+func (fake *FakePluginContext) DefaultRegionCallCount() int {
+	fake.defaultRegionMutex.RLock()
+	defer fake.defaultRegionMutex.RUnlock()
+	return len(fake.defaultRegionArgsForCall)
+}
+
+func (fake *FakePluginContext) DefaultRegionReturns(result1 models.Region, result2 error) {
+	fake.DefaultRegionStub = nil
+	fake.defaultRegionReturns = struct {
+		result1 models.Region
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePluginContext) DefaultRegionReturnsOnCall(i int, result1 models.Region, result2 error) {
+	fake.DefaultRegionStub = nil
+	if fake.defaultRegionReturnsOnCall == nil {
+		fake.defaultRegionReturnsOnCall = make(map[int]struct {
+			result1 models.Region
+			result2 error
+		})
+	}
+	fake.defaultRegionReturnsOnCall[i] = struct {
+		result1 models.Region
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePluginContext) RefreshAllTokens() error {
+	fake.refreshAllTokensMutex.Lock()
+	ret, specificReturn := fake.refreshAllTokensReturnsOnCall[len(fake.refreshAllTokensArgsForCall)]
+	fake.refreshAllTokensArgsForCall = append(fake.refreshAllTokensArgsForCall, struct{}{})
+	fake.recordInvocation("RefreshAllTokens", []interface{}{})
+	fake.refreshAllTokensMutex.Unlock()
+	if fake.RefreshAllTokensStub != nil {
+		return fake.RefreshAllTokensStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.refreshAllTokensReturns.result1
+}
+
+func (fake *FakePluginContext) RefreshAllTokensCallCount() int {
+	fake.refreshAllTokensMutex.RLock()
+	defer fake.refreshAllTokensMutex.RUnlock()
+	return len(fake.refreshAllTokensArgsForCall)
+}
+
+func (fake *FakePluginContext) RefreshAllTokensReturns(result1 error) {
+	fake.RefreshAllTokensStub = nil
+	fake.refreshAllTokensReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePluginContext) RefreshAllTokensReturnsOnCall(i int, result1 error) {
+	fake.RefreshAllTokensStub = nil
+	if fake.refreshAllTokensReturnsOnCall == nil {
+		fake.refreshAllTokensReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.refreshAllTokensReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePluginContext) Stdout() io.Writer {
+	fake.stdoutMutex.Lock()
+	ret, specificReturn := fake.stdoutReturnsOnCall[len(fake.stdoutArgsForCall)]
+	fake.stdoutArgsForCall = append(fake.stdoutArgsForCall, struct{}{})
+	fake.recordInvocation("Stdout", []interface{}{})
+	fake.stdoutMutex.Unlock()
+	if fake.StdoutStub != nil {
+		return fake.StdoutStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.stdoutReturns.result1
+}
+
+func (fake *FakePluginContext) StdoutCallCount() int {
+	fake.stdoutMutex.RLock()
+	defer fake.stdoutMutex.RUnlock()
+	return len(fake.stdoutArgsForCall)
+}
+
+func (fake *FakePluginContext) StdoutReturns(result1 io.Writer) {
+	fake.StdoutStub = nil
+	fake.stdoutReturns = struct {
+		result1 io.Writer
+	}{result1}
+}
+
+func (fake *FakePluginContext) StdoutReturnsOnCall(i int, result1 io.Writer) {
+	fake.StdoutStub = nil
+	if fake.stdoutReturnsOnCall == nil {
+		fake.stdoutReturnsOnCall = make(map[int]struct {
+			result1 io.Writer
+		})
+	}
+	fake.stdoutReturnsOnCall[i] = struct {
+		result1 io.Writer
+	}{result1}
+}
+
+func (fake *FakePluginContext) Stderr() io.Writer {
+	fake.stderrMutex.Lock()
+	ret, specificReturn := fake.stderrReturnsOnCall[len(fake.stderrArgsForCall)]
+	fake.stderrArgsForCall = append(fake.stderrArgsForCall, struct{}{})
+	fake.recordInvocation("Stderr", []interface{}{})
+	fake.stderrMutex.Unlock()
+	if fake.StderrStub != nil {
+		return fake.StderrStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.stderrReturns.result1
+}
+
+func (fake *FakePluginContext) StderrCallCount() int {
+	fake.stderrMutex.RLock()
+	defer fake.stderrMutex.RUnlock()
+	return len(fake.stderrArgsForCall)
+}
+
+func (fake *FakePluginContext) StderrReturns(result1 io.Writer) {
+	fake.StderrStub = nil
+	fake.stderrReturns = struct {
+		result1 io.Writer
+	}{result1}
+}
+
+func (fake *FakePluginContext) StderrReturnsOnCall(i int, result1 io.Writer) {
+	fake.StderrStub = nil
+	if fake.stderrReturnsOnCall == nil {
+		fake.stderrReturnsOnCall = make(map[int]struct {
+			result1 io.Writer
+		})
+	}
+	fake.stderrReturnsOnCall[i] = struct {
+		result1 io.Writer
+	}{result1}
+}
+
+func (fake *FakePluginContext) IsCompletion() bool {
+	fake.isCompletionMutex.Lock()
+	ret, specificReturn := fake.isCompletionReturnsOnCall[len(fake.isCompletionArgsForCall)]
+	fake.isCompletionArgsForCall = append(fake.isCompletionArgsForCall, struct{}{})
+	fake.recordInvocation("IsCompletion", []interface{}{})
+	fake.isCompletionMutex.Unlock()
+	if fake.IsCompletionStub != nil {
+		return fake.IsCompletionStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.isCompletionReturns.result1
+}
+
+func (fake *FakePluginContext) IsCompletionCallCount() int {
+	fake.isCompletionMutex.RLock()
+	defer fake.isCompletionMutex.RUnlock()
+	return len(fake.isCompletionArgsForCall)
+}
+
+func (fake *FakePluginContext) IsCompletionReturns(result1 bool) {
+	fake.IsCompletionStub = nil
+	fake.isCompletionReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakePluginContext) IsCompletionReturnsOnCall(i int, result1 bool) {
+	fake.IsCompletionStub = nil
+	if fake.isCompletionReturnsOnCall == nil {
+		fake.isCompletionReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.isCompletionReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakePluginContext) DefaultResourceGroup() models.ResourceGroup {
+	fake.defaultResourceGroupMutex.Lock()
+	ret, specificReturn := fake.defaultResourceGroupReturnsOnCall[len(fake.defaultResourceGroupArgsForCall)]
+	fake.defaultResourceGroupArgsForCall = append(fake.defaultResourceGroupArgsForCall, struct{}{})
+	fake.recordInvocation("DefaultResourceGroup", []interface{}{})
+	fake.defaultResourceGroupMutex.Unlock()
+	if fake.DefaultResourceGroupStub != nil {
+		return fake.DefaultResourceGroupStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.defaultResourceGroupReturns.result1
+}
+
+func (fake *FakePluginContext) DefaultResourceGroupCallCount() int {
+	fake.defaultResourceGroupMutex.RLock()
+	defer fake.defaultResourceGroupMutex.RUnlock()
+	return len(fake.defaultResourceGroupArgsForCall)
+}
+
+func (fake *FakePluginContext) DefaultResourceGroupReturns(result1 models.ResourceGroup) {
+	fake.DefaultResourceGroupStub = nil
+	fake.defaultResourceGroupReturns = struct {
+		result1 models.ResourceGroup
+	}{result1}
+}
+
+func (fake *FakePluginContext) DefaultResourceGroupReturnsOnCall(i int, result1 models.ResourceGroup) {
+	fake.DefaultResourceGroupStub = nil
+	if fake.defaultResourceGroupReturnsOnCall == nil {
+		fake.defaultResourceGroupReturnsOnCall = make(map[int]struct {
+			result1 models.ResourceGroup
+		})
+	}
+	fake.defaultResourceGroupReturnsOnCall[i] = struct {
+		result1 models.ResourceGroup
+	}{result1}
+}
+
+func (fake *FakePluginContext) SetDefaultResourceGroup(nameOrID string) (models.ResourceGroup, error) {
+	fake.setDefaultResourceGroupMutex.Lock()
+	ret, specificReturn := fake.setDefaultResourceGroupReturnsOnCall[len(fake.setDefaultResourceGroupArgsForCall)]
+	fake.setDefaultResourceGroupArgsForCall = append(fake.setDefaultResourceGroupArgsForCall, struct {
+		nameOrID string
+	}{nameOrID})
+	fake.recordInvocation("SetDefaultResourceGroup", []interface{}{nameOrID})
+	fake.setDefaultResourceGroupMutex.Unlock()
+	if fake.SetDefaultResourceGroupStub != nil {
+		return fake.SetDefaultResourceGroupStub(nameOrID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.setDefaultResourceGroupReturns.result1, fake.setDefaultResourceGroupReturns.result2
+}
+
+func (fake *FakePluginContext) SetDefaultResourceGroupCallCount() int {
+	fake.setDefaultResourceGroupMutex.RLock()
+	defer fake.setDefaultResourceGroupMutex.RUnlock()
+	return len(fake.setDefaultResourceGroupArgsForCall)
+}
+
+func (fake *FakePluginContext) SetDefaultResourceGroupArgsForCall(i int) string {
+	fake.setDefaultResourceGroupMutex.RLock()
+	defer fake.setDefaultResourceGroupMutex.RUnlock()
+	return fake.setDefaultResourceGroupArgsForCall[i].nameOrID
+}
+
+func (fake *FakePluginContext) SetDefaultResourceGroupReturns(result1 models.ResourceGroup, result2 error) {
+	fake.SetDefaultResourceGroupStub = nil
+	fake.setDefaultResourceGroupReturns = struct {
+		result1 models.ResourceGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePluginContext) SetDefaultResourceGroupReturnsOnCall(i int, result1 models.ResourceGroup, result2 error) {
+	fake.SetDefaultResourceGroupStub = nil
+	if fake.setDefaultResourceGroupReturnsOnCall == nil {
+		fake.setDefaultResourceGroupReturnsOnCall = make(map[int]struct {
+			result1 models.ResourceGroup
+			result2 error
+		})
+	}
+	fake.setDefaultResourceGroupReturnsOnCall[i] = struct {
+		result1 models.ResourceGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePluginContext) AccountRole() (string, error) {
+	fake.accountRoleMutex.Lock()
+	ret, specificReturn := fake.accountRoleReturnsOnCall[len(fake.accountRoleArgsForCall)]
+	fake.accountRoleArgsForCall = append(fake.accountRoleArgsForCall, struct{}{})
+	fake.recordInvocation("AccountRole", []interface{}{})
+	fake.accountRoleMutex.Unlock()
+	if fake.AccountRoleStub != nil {
+		return fake.AccountRoleStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.accountRoleReturns.result1, fake.accountRoleReturns.result2
+}
+
+func (fake *FakePluginContext) AccountRoleCallCount() int {
+	fake.accountRoleMutex.RLock()
+	defer fake.accountRoleMutex.RUnlock()
+	return len(fake.accountRoleArgsForCall)
+}
+
+func (fake *FakePluginContext) AccountRoleReturns(result1 string, result2 error) {
+	fake.AccountRoleStub = nil
+	fake.accountRoleReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePluginContext) AccountRoleReturnsOnCall(i int, result1 string, result2 error) {
+	fake.AccountRoleStub = nil
+	if fake.accountRoleReturnsOnCall == nil {
+		fake.accountRoleReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.accountRoleReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePluginContext) Warn(format string, args ...interface{}) {
+	fake.warnMutex.Lock()
+	fake.warnArgsForCall = append(fake.warnArgsForCall, struct {
+		format string
+		args   []interface{}
+	}{format, args})
+	fake.recordInvocation("Warn", []interface{}{format, args})
+	fake.warnMutex.Unlock()
+	if fake.WarnStub != nil {
+		fake.WarnStub(format, args...)
+	}
+}
+
+func (fake *FakePluginContext) WarnCallCount() int {
+	fake.warnMutex.RLock()
+	defer fake.warnMutex.RUnlock()
+	return len(fake.warnArgsForCall)
+}
+
+func (fake *FakePluginContext) WarnArgsForCall(i int) (string, []interface{}) {
+	fake.warnMutex.RLock()
+	defer fake.warnMutex.RUnlock()
+	return fake.warnArgsForCall[i].format, fake.warnArgsForCall[i].args
+}
+
+func (fake *FakePluginContext) Info(format string, args ...interface{}) {
+	fake.infoMutex.Lock()
+	fake.infoArgsForCall = append(fake.infoArgsForCall, struct {
+		format string
+		args   []interface{}
+	}{format, args})
+	fake.recordInvocation("Info", []interface{}{format, args})
+	fake.infoMutex.Unlock()
+	if fake.InfoStub != nil {
+		fake.InfoStub(format, args...)
+	}
+}
+
+func (fake *FakePluginContext) InfoCallCount() int {
+	fake.infoMutex.RLock()
+	defer fake.infoMutex.RUnlock()
+	return len(fake.infoArgsForCall)
+}
+
+func (fake *FakePluginContext) InfoArgsForCall(i int) (string, []interface{}) {
+	fake.infoMutex.RLock()
+	defer fake.infoMutex.RUnlock()
+	return fake.infoArgsForCall[i].format, fake.infoArgsForCall[i].args
+}
+
+func (fake *FakePluginContext) RefreshTokenExpiresAt() (time.Time, error) {
+	fake.refreshTokenExpiresAtMutex.Lock()
+	ret, specificReturn := fake.refreshTokenExpiresAtReturnsOnCall[len(fake.refreshTokenExpiresAtArgsForCall)]
+	fake.refreshTokenExpiresAtArgsForCall = append(fake.refreshTokenExpiresAtArgsForCall, struct{}{})
+	fake.recordInvocation("RefreshTokenExpiresAt", []interface{}{})
+	fake.refreshTokenExpiresAtMutex.Unlock()
+	if fake.RefreshTokenExpiresAtStub != nil {
+		return fake.RefreshTokenExpiresAtStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.refreshTokenExpiresAtReturns.result1, fake.refreshTokenExpiresAtReturns.result2
+}
+
+func (fake *FakePluginContext) RefreshTokenExpiresAtCallCount() int {
+	fake.refreshTokenExpiresAtMutex.RLock()
+	defer fake.refreshTokenExpiresAtMutex.RUnlock()
+	return len(fake.refreshTokenExpiresAtArgsForCall)
+}
+
+func (fake *FakePluginContext) RefreshTokenExpiresAtReturns(result1 time.Time, result2 error) {
+	fake.RefreshTokenExpiresAtStub = nil
+	fake.refreshTokenExpiresAtReturns = struct {
+		result1 time.Time
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePluginContext) RefreshTokenExpiresAtReturnsOnCall(i int, result1 time.Time, result2 error) {
+	fake.RefreshTokenExpiresAtStub = nil
+	if fake.refreshTokenExpiresAtReturnsOnCall == nil {
+		fake.refreshTokenExpiresAtReturnsOnCall = make(map[int]struct {
+			result1 time.Time
+			result2 error
+		})
+	}
+	fake.refreshTokenExpiresAtReturnsOnCall[i] = struct {
+		result1 time.Time
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePluginContext) IAMTokenForChildAccount(accountID string) (string, error) {
+	fake.iAMTokenForChildAccountMutex.Lock()
+	ret, specificReturn := fake.iAMTokenForChildAccountReturnsOnCall[len(fake.iAMTokenForChildAccountArgsForCall)]
+	fake.iAMTokenForChildAccountArgsForCall = append(fake.iAMTokenForChildAccountArgsForCall, struct {
+		accountID string
+	}{accountID})
+	fake.recordInvocation("IAMTokenForChildAccount", []interface{}{accountID})
+	fake.iAMTokenForChildAccountMutex.Unlock()
+	if fake.IAMTokenForChildAccountStub != nil {
+		return fake.IAMTokenForChildAccountStub(accountID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.iAMTokenForChildAccountReturns.result1, fake.iAMTokenForChildAccountReturns.result2
+}
+
+func (fake *FakePluginContext) IAMTokenForChildAccountCallCount() int {
+	fake.iAMTokenForChildAccountMutex.RLock()
+	defer fake.iAMTokenForChildAccountMutex.RUnlock()
+	return len(fake.iAMTokenForChildAccountArgsForCall)
+}
+
+func (fake *FakePluginContext) IAMTokenForChildAccountArgsForCall(i int) string {
+	fake.iAMTokenForChildAccountMutex.RLock()
+	defer fake.iAMTokenForChildAccountMutex.RUnlock()
+	return fake.iAMTokenForChildAccountArgsForCall[i].accountID
+}
+
+func (fake *FakePluginContext) IAMTokenForChildAccountReturns(result1 string, result2 error) {
+	fake.IAMTokenForChildAccountStub = nil
+	fake.iAMTokenForChildAccountReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePluginContext) IAMTokenForChildAccountReturnsOnCall(i int, result1 string, result2 error) {
+	fake.IAMTokenForChildAccountStub = nil
+	if fake.iAMTokenForChildAccountReturnsOnCall == nil {
+		fake.iAMTokenForChildAccountReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.iAMTokenForChildAccountReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePluginContext) ExportTarget() ([]byte, error) {
+	fake.exportTargetMutex.Lock()
+	ret, specificReturn := fake.exportTargetReturnsOnCall[len(fake.exportTargetArgsForCall)]
+	fake.exportTargetArgsForCall = append(fake.exportTargetArgsForCall, struct{}{})
+	fake.recordInvocation("ExportTarget", []interface{}{})
+	fake.exportTargetMutex.Unlock()
+	if fake.ExportTargetStub != nil {
+		return fake.ExportTargetStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.exportTargetReturns.result1, fake.exportTargetReturns.result2
+}
+
+func (fake *FakePluginContext) ExportTargetCallCount() int {
+	fake.exportTargetMutex.RLock()
+	defer fake.exportTargetMutex.RUnlock()
+	return len(fake.exportTargetArgsForCall)
+}
+
+func (fake *FakePluginContext) ExportTargetReturns(result1 []byte, result2 error) {
+	fake.ExportTargetStub = nil
+	fake.exportTargetReturns = struct {
+		result1 []byte
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePluginContext) ExportTargetReturnsOnCall(i int, result1 []byte, result2 error) {
+	fake.ExportTargetStub = nil
+	if fake.exportTargetReturnsOnCall == nil {
+		fake.exportTargetReturnsOnCall = make(map[int]struct {
+			result1 []byte
+			result2 error
+		})
+	}
+	fake.exportTargetReturnsOnCall[i] = struct {
+		result1 []byte
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePluginContext) ImportTarget(data []byte) error {
+	var dataCopy []byte
+	if data != nil {
+		dataCopy = make([]byte, len(data))
+		copy(dataCopy, data)
+	}
+	fake.importTargetMutex.Lock()
+	ret, specificReturn := fake.importTargetReturnsOnCall[len(fake.importTargetArgsForCall)]
+	fake.importTargetArgsForCall = append(fake.importTargetArgsForCall, struct {
+		data []byte
+	}{dataCopy})
+	fake.recordInvocation("ImportTarget", []interface{}{dataCopy})
+	fake.importTargetMutex.Unlock()
+	if fake.ImportTargetStub != nil {
+		return fake.ImportTargetStub(data)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.importTargetReturns.result1
+}
+
+func (fake *FakePluginContext) ImportTargetCallCount() int {
+	fake.importTargetMutex.RLock()
+	defer fake.importTargetMutex.RUnlock()
+	return len(fake.importTargetArgsForCall)
+}
+
+func (fake *FakePluginContext) ImportTargetArgsForCall(i int) []byte {
+	fake.importTargetMutex.RLock()
+	defer fake.importTargetMutex.RUnlock()
+	return fake.importTargetArgsForCall[i].data
+}
+
+func (fake *FakePluginContext) ImportTargetReturns(result1 error) {
+	fake.ImportTargetStub = nil
+	fake.importTargetReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePluginContext) ImportTargetReturnsOnCall(i int, result1 error) {
+	fake.ImportTargetStub = nil
+	if fake.importTargetReturnsOnCall == nil {
+		fake.importTargetReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.importTargetReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePluginContext) CorrelationID() string {
+	fake.correlationIDMutex.Lock()
+	ret, specificReturn := fake.correlationIDReturnsOnCall[len(fake.correlationIDArgsForCall)]
+	fake.correlationIDArgsForCall = append(fake.correlationIDArgsForCall, struct{}{})
+	fake.recordInvocation("CorrelationID", []interface{}{})
+	fake.correlationIDMutex.Unlock()
+	if fake.CorrelationIDStub != nil {
+		return fake.CorrelationIDStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.correlationIDReturns.result1
+}
+
+func (fake *FakePluginContext) CorrelationIDCallCount() int {
+	fake.correlationIDMutex.RLock()
+	defer fake.correlationIDMutex.RUnlock()
+	return len(fake.correlationIDArgsForCall)
+}
+
+func (fake *FakePluginContext) CorrelationIDReturns(result1 string) {
+	fake.CorrelationIDStub = nil
+	fake.correlationIDReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakePluginContext) CorrelationIDReturnsOnCall(i int, result1 string) {
+	fake.CorrelationIDStub = nil
+	if fake.correlationIDReturnsOnCall == nil {
+		fake.correlationIDReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.correlationIDReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakePluginContext) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -1500,6 +3248,72 @@ func (fake *FakePluginContext) Invocations() map[string][][]interface{} {
 	defer fake.commandNamespaceMutex.RUnlock()
 	fake.cLINameMutex.RLock()
 	defer fake.cLINameMutex.RUnlock()
+	fake.checkForUpdateMutex.RLock()
+	defer fake.checkForUpdateMutex.RUnlock()
+	fake.cLIVersionMutex.RLock()
+	defer fake.cLIVersionMutex.RUnlock()
+	fake.isInteractiveMutex.RLock()
+	defer fake.isInteractiveMutex.RUnlock()
+	fake.mCCPEndpointMutex.RLock()
+	defer fake.mCCPEndpointMutex.RUnlock()
+	fake.hTTPTimeoutDurationMutex.RLock()
+	defer fake.hTTPTimeoutDurationMutex.RUnlock()
+	fake.refreshIAMTokenForAccountMutex.RLock()
+	defer fake.refreshIAMTokenForAccountMutex.RUnlock()
+	fake.isClassicCLIMutex.RLock()
+	defer fake.isClassicCLIMutex.RUnlock()
+	fake.isIBMCloudCLIMutex.RLock()
+	defer fake.isIBMCloudCLIMutex.RUnlock()
+	fake.passcodeEndpointMutex.RLock()
+	defer fake.passcodeEndpointMutex.RUnlock()
+	fake.loginURLMutex.RLock()
+	defer fake.loginURLMutex.RUnlock()
+	fake.reloadMutex.RLock()
+	defer fake.reloadMutex.RUnlock()
+	fake.listResourceInstancesMutex.RLock()
+	defer fake.listResourceInstancesMutex.RUnlock()
+	fake.resolveServiceInstanceMutex.RLock()
+	defer fake.resolveServiceInstanceMutex.RUnlock()
+	fake.resolveServiceInstanceInResourceGroupMutex.RLock()
+	defer fake.resolveServiceInstanceInResourceGroupMutex.RUnlock()
+	fake.iMSUsernameMutex.RLock()
+	defer fake.iMSUsernameMutex.RUnlock()
+	fake.iMSAPIKeyMutex.RLock()
+	defer fake.iMSAPIKeyMutex.RUnlock()
+	fake.startTokenRefresherMutex.RLock()
+	defer fake.startTokenRefresherMutex.RUnlock()
+	fake.resolveResourceGroupMutex.RLock()
+	defer fake.resolveResourceGroupMutex.RUnlock()
+	fake.defaultRegionMutex.RLock()
+	defer fake.defaultRegionMutex.RUnlock()
+	fake.refreshAllTokensMutex.RLock()
+	defer fake.refreshAllTokensMutex.RUnlock()
+	fake.stdoutMutex.RLock()
+	defer fake.stdoutMutex.RUnlock()
+	fake.stderrMutex.RLock()
+	defer fake.stderrMutex.RUnlock()
+	fake.isCompletionMutex.RLock()
+	defer fake.isCompletionMutex.RUnlock()
+	fake.defaultResourceGroupMutex.RLock()
+	defer fake.defaultResourceGroupMutex.RUnlock()
+	fake.setDefaultResourceGroupMutex.RLock()
+	defer fake.setDefaultResourceGroupMutex.RUnlock()
+	fake.accountRoleMutex.RLock()
+	defer fake.accountRoleMutex.RUnlock()
+	fake.warnMutex.RLock()
+	defer fake.warnMutex.RUnlock()
+	fake.infoMutex.RLock()
+	defer fake.infoMutex.RUnlock()
+	fake.refreshTokenExpiresAtMutex.RLock()
+	defer fake.refreshTokenExpiresAtMutex.RUnlock()
+	fake.iAMTokenForChildAccountMutex.RLock()
+	defer fake.iAMTokenForChildAccountMutex.RUnlock()
+	fake.exportTargetMutex.RLock()
+	defer fake.exportTargetMutex.RUnlock()
+	fake.importTargetMutex.RLock()
+	defer fake.importTargetMutex.RUnlock()
+	fake.correlationIDMutex.RLock()
+	defer fake.correlationIDMutex.RUnlock()
 	return fake.invocations
 }
 
@@ -77,7 +77,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _i18nResourcesDe_deAllJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x95\x4d\x6f\xda\x4e\x10\xc6\xef\x7c\x8a\x11\x17\x5f\x08\x52\xae\xdc\xf8\x13\x43\xa2\xe4\x0f\x14\x43\x23\xb5\xf4\xb0\xd8\x83\xd9\x64\x3d\x4b\xf7\x05\x14\x2c\x7f\xad\x9e\x72\xcb\x17\xab\xd6\x86\xbc\xd5\x4b\x68\xa4\x5e\x90\x61\x77\x9e\x79\x1e\xef\xce\x8f\xef\x0d\x80\xbc\x01\x00\xd0\xe4\x49\xb3\x03\xcd\x39\x85\x64\x50\x01\x03\xb2\xd9\x02\x55\xb3\x55\xad\x1a\xc5\x48\x0b\x66\xb8\xa4\x6a\xdb\x00\x17\x48\x10\x71\x04\xe4\x84\xf0\x8d\xad\x84\x7b\x6a\x37\x1b\x00\x45\xeb\xbd\x6c\x97\x00\x95\x92\x0a\x64\x1c\x5b\xa5\x30\x81\xed\x0a\x09\x62\x85\xcc\x70\x4a\x41\xc8\x14\x96\x5c\x20\x04\x79\xde\x1e\x33\xb3\x2a\x8a\xa0\x33\xa7\x3c\x6f\x87\xae\xac\x28\xe6\x34\x27\x8f\x97\xff\x90\x67\x10\x2a\x6d\x50\x08\x24\x48\x50\xc1\x58\x49\x23\xef\xa5\x10\x09\x33\xc8\x5f\x8b\x02\xd7\xc6\xf9\x84\x3e\xae\x84\xcb\x69\x97\x29\x1a\x85\x06\xe9\xcf\x7e\x27\x47\x71\xce\x13\x9b\xad\x5d\x14\x85\x3f\x2d\x6a\xf3\x4e\xcd\xef\xbd\x34\xdc\xa5\xa5\x54\x09\x2a\x4b\x29\xec\xec\xeb\x38\xee\xed\x6a\x88\xd6\xc8\xe3\x15\x2a\x66\xf5\xce\xa6\xfa\xf4\x14\x9f\xcd\xa0\xd7\x92\x34\xfe\x6d\x08\xb3\x95\xca\xc0\x02\x77\x4f\x8f\xa9\xe0\xf1\xaa\xfc\x79\x9f\xc5\x45\xfb\x27\x61\x7a\xd2\x8a\x04\x48\x1a\x50\xc8\x12\x58\x2a\x99\x01\xa7\xb5\x35\x1d\xf0\x18\x3e\x56\x51\xdb\x22\x14\x6c\xad\x31\xe9\x78\xf4\xbe\xa2\xd2\x46\xb9\x4c\xd4\xa9\x17\xe8\x77\xaf\x6e\xc2\x0b\x4f\x79\x3f\xbc\xbc\x19\x84\x51\xef\xf2\xa6\x3b\x08\x87\xf5\x02\x57\xb4\x61\x82\x27\x60\xe4\x3d\x92\x37\xd8\x8c\xd2\xa7\x47\x61\x78\x8a\x1a\xa6\xfb\x9d\xb5\x72\xa3\x6b\x8f\xc2\xe8\xba\xbe\x60\x2c\x90\x69\x04\x2c\xe1\x10\x3c\x04\x2d\x08\xc8\x7d\x3c\xa0\x0e\x40\x2a\x08\x48\x06\x6d\x8f\xe6\x0b\x2a\x82\xbb\xe7\xc2\x3b\x16\x80\x74\xd7\x23\x20\xe4\x14\x1c\x61\xc7\x9b\xd6\x07\x2e\xc1\x02\xcd\x16\x91\xe0\x1c\x8c\x84\x3c\x6f\xf7\xa4\x25\x53\x14\x1f\x7b\x78\xc1\xd5\x6e\xcb\xb5\x3b\x33\x38\x07\x4b\xc9\x2b\x91\xd3\xcd\x54\x87\xb2\x14\xb2\xc2\x58\xe5\xed\x44\x0f\x87\xa3\x82\x81\x40\x6e\xee\x65\x96\xb1\xdd\x71\x8a\xd6\x36\xff\x5c\xcf\x0f\x78\xfd\xa6\xd3\x86\x09\x8b\xa7\x35\x20\xb8\x45\x65\x8e\x08\x4f\xc2\x2f\xb3\x30\x9a\xfa\x26\xa9\x3b\xec\x8f\x26\x17\xe1\x64\x36\x1c\x78\x26\x69\x12\x46\xe3\xd1\x30\x0a\xfd\x0a\xd3\xdb\xd1\x64\xea\xab\xc6\x4c\x1a\x04\x8d\x6a\x83\xaa\x62\x60\x1b\x22\xc3\x8c\xd5\x10\xcb\x04\x3b\xee\x16\x54\xdf\x7b\x32\xc1\xa2\x68\xed\x41\xf9\xbc\x58\xc2\xe8\xb0\x96\xa1\xd6\x2c\xad\x16\xfe\xaf\x9e\x8b\xc2\x37\xe5\xcf\x74\x83\x04\x33\x58\xa2\x72\xaf\x2b\x2a\x9d\x1c\x3c\x78\x2c\x54\xa5\xf5\x16\x86\x2c\x5e\x39\xf4\x98\x77\x26\x6a\xe3\xcf\x88\x2d\x04\xba\x89\xd1\x6c\x83\xb0\x16\x36\xe5\x04\xb1\xa4\x25\x4f\xbd\x50\x39\xe0\x7a\xff\xd7\x2a\x6c\x7a\xc6\xe9\xec\xba\x2c\xb2\xaa\xdc\x06\xe4\x1c\x40\xf6\xf4\xab\xc4\xfe\x9e\x3a\x8d\x1f\xbf\x03\x00\x00\xff\xff\x71\xd3\x48\x1f\x5f\x08\x00\x00")
+var _i18nResourcesDe_deAllJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x5a\x4d\x73\xdb\x38\xd2\xbe\xe7\x57\x74\xe5\xa2\x8b\xad\x9a\xcc\xbc\x87\xb7\x7c\xd3\xda\xb2\xc7\xe5\xcf\xb5\xec\xa4\x66\x36\x7b\x80\xc8\x26\x89\x31\x08\x70\x00\x50\x8a\xac\xe2\xdf\xda\xd3\xdc\xf2\xc7\xb6\x1a\xa0\x64\xcb\x26\x24\x48\xb6\x67\x73\x41\xe4\x10\xdd\xcf\xd3\xf8\xec\x0f\xfc\xeb\x03\xc0\xfc\x03\x00\xc0\x47\x9e\x7e\x3c\x80\x8f\x5f\xe5\x50\x5a\xd4\xc0\x40\xd6\xe5\x18\xf5\xc7\x3d\xff\xd5\x6a\x26\x8d\x60\x96\x2b\xe9\xbb\x9d\xe0\x18\x25\x8c\x38\x02\x72\x89\xf0\x3b\x2b\x04\xfd\xea\x7f\xfc\x00\xd0\xec\x3d\x57\x3b\x90\x80\x5a\x2b\x0d\x2a\x49\x6a\xad\x31\x85\x69\x81\x12\x12\x8d\xcc\x72\x99\x83\x50\x39\x64\x5c\x20\xf4\xe6\xf3\xfe\x35\xb3\x45\xd3\xf4\x0e\xbe\xca\xf9\xbc\x3f\x24\xb1\xa6\xf9\x2a\xbf\xca\x00\x97\x7f\x20\x2f\x61\xa8\x8d\x45\x21\x50\x42\x8a\x1a\xae\xb5\xb2\xea\x5e\x09\x91\x32\x8b\xfc\xa9\x52\xe0\xc6\x12\x4f\x38\xc6\x42\x90\x9d\x75\x96\xa3\xd5\x68\x51\xbe\xc4\x8b\x36\x85\x98\xa7\x75\x59\x91\x29\x1a\xff\xac\xd1\xd8\x67\xda\xc2\xdc\x1d\xe1\x81\xcc\x94\x4e\x51\xd7\x32\x87\x87\xfa\xa9\x39\x34\xba\x06\x46\x15\xf2\xa4\x40\xcd\x6a\xf3\x50\xe7\x26\xde\x8a\x5d\x6d\x30\x95\x92\x06\xb7\x35\xc2\x4e\x95\xb6\x30\xc6\x87\xef\x7f\xe5\x82\x27\x85\xb3\xad\xb5\x85\x4c\x7b\x2f\x63\x6a\x89\xdf\x2a\x4c\x2c\xa6\xcf\xec\x3a\x80\x47\xf9\x00\xfb\x68\xf1\x6e\xf0\xda\x16\x4a\xf3\x07\xa7\x0e\x32\xc6\x45\x2b\x75\xa8\x52\x0c\x63\x6e\x90\xda\x05\xca\xa1\x1e\xa1\x49\x34\xaf\xa8\xc7\xae\xe0\x1d\x7a\x22\xe8\x98\x3a\x49\x10\x53\x4c\xfb\xf0\x9b\xaa\x21\x61\x12\x12\xa1\x0c\x82\x2d\xb8\x81\x29\x97\xa9\x9a\x02\x93\x29\x68\xb4\xb5\x96\x60\x15\xd8\x02\xc1\xa2\x2e\xb9\x64\xa2\x1f\xc5\xf5\xd5\x20\x9d\x86\x1c\x0a\x55\xa7\x70\xac\x6a\x99\xea\x19\x28\x9d\x07\xb8\xbc\xec\x17\xa1\xce\x54\x2c\xc1\x28\x85\xbe\x67\x58\xe5\xa2\xdf\xe0\xfa\x14\x50\xa6\x95\xe2\xd2\x02\x37\x20\x95\x05\x83\x76\x1d\xc6\x26\xd1\x6e\x50\x25\x33\xae\x4b\xa7\x89\x3a\xd3\xb9\xc6\xe9\xa8\xe0\x12\xa4\x92\xfb\x9c\xee\x09\x96\x58\x3e\x41\x28\x55\x8a\x7b\x50\x1b\x84\xfd\xfd\x4c\xe9\x04\x69\x7e\xcd\x3d\xaf\x80\x07\x89\xbd\x95\xfa\x00\xf9\x5a\xa4\x6e\x68\x34\xb2\x14\x32\xad\x4a\xe0\xb2\xaa\xed\x01\x04\xf9\x84\x25\x3a\x21\x8e\x30\x63\xb5\xa0\xee\x39\x99\xa0\x32\xb7\xd6\x58\x92\xa8\x3a\x66\x62\xa2\xc5\x3b\xc1\x87\x82\x55\x06\xd3\x83\x80\xf2\xcf\xa8\x8d\xd5\x74\x63\xc8\x83\x6e\xf6\xc3\x76\x19\x98\x17\xd7\x2e\x51\x57\xb5\x25\x46\x74\x7b\xee\x01\xb7\x30\x65\x06\x04\x33\x16\xea\x8a\xfe\x2f\x05\x66\xe9\x94\xb8\xf3\x7f\x0d\x6c\xf0\xac\x79\x73\x98\x6d\x8d\x21\x95\x34\x11\x19\x6d\x81\xed\x49\xae\x8a\x07\xc0\x27\x5c\x2b\x59\xa2\xb4\x30\x61\x9a\xb3\xb1\x40\x1a\x9c\x4b\x56\x62\xd3\x6c\x5e\x08\xf1\xf2\xdd\xf0\xdf\x2a\x4e\xc7\x96\x5f\x3f\x1a\x33\x8d\xa6\x00\xab\xee\xd1\x6d\xab\x5a\xde\x4b\x35\x0d\xdd\xdc\x91\xc2\x9d\xc0\xc7\x83\xd3\xf3\xe1\x51\x40\xf1\xf1\xf0\xd7\xf3\x93\xe1\xe8\xf0\xd7\xf3\xc1\xc9\xf0\xb2\x9b\xf9\xb1\xbb\x79\x68\x2b\xb3\x34\x85\x12\xc9\xdd\x34\xee\xcf\x24\x41\x63\x20\xd7\xaa\xae\xdc\x92\x39\xa1\x5f\xa7\x47\xe4\x13\xd2\xc8\x5c\xf8\xae\xc1\x45\xf7\x06\x8a\x37\x10\x5e\x8c\xd4\xe9\xe0\xc2\x0f\x75\x84\x9f\x11\x2b\x1d\x09\x7d\x37\x18\xbc\x02\xba\x5b\xba\x13\x9a\x58\xc6\xdf\x37\xa1\xde\xdd\xaa\x2f\x8f\xaf\x42\x47\x98\xff\xd6\x2d\x46\x07\xb9\x3f\xa1\x8d\x4d\xb9\x04\xfc\x46\xbe\x87\x71\x3b\x40\xf0\x92\xbb\x53\x65\x3e\xef\x9f\xd3\xef\xa6\x81\xf1\xcc\xa2\x09\xe1\xec\xa6\x2c\x40\x6c\xc2\x04\x4f\x81\xad\x78\x2d\xcb\xe1\xa0\x15\xb7\x38\x6b\x9a\xa6\x17\x24\xb4\x95\x92\xb5\x44\x12\x55\x96\xe4\x74\xf5\x96\xe7\x49\x2f\x62\xb9\xc4\x4a\xaf\x85\x4e\x6b\xed\x99\x93\xf4\x67\x26\x6a\x6c\x9a\x5e\x1f\xee\x0c\x2e\x63\x4b\x98\x72\x5b\x00\x83\x5a\xfa\x19\xeb\x49\xd3\xdb\x83\x5e\xed\xda\xd2\xb5\xae\x29\xa9\x29\x7a\xa0\x34\xf4\xd2\xde\x1e\x60\x3f\xef\x43\xef\x97\x9f\xca\x5e\x7f\x83\x05\x7f\x13\x89\xb5\x03\x21\x59\x89\xce\xb7\xdb\x71\x16\x36\xcb\xaf\x85\xff\xb3\x66\xd2\x72\x3b\xdb\x3c\x04\x12\x94\x0b\x1c\x98\x78\x1c\x8c\x33\x4e\x66\x5f\xb8\xf6\xc4\xb5\xb7\xae\xbd\x76\xed\x3d\x35\x17\xd4\x9c\x50\x73\xeb\xa7\xe8\x7a\x39\x3a\x3f\x9f\xf0\x8d\x53\xf4\xbf\xe7\xb7\x76\xf8\x8c\x65\x16\x81\x4b\x77\xb6\xac\x6e\xc9\x45\x90\xbc\xc1\xc0\x18\x0d\x6b\x29\x58\xa6\x73\xb4\x5b\xac\x98\x0e\x81\xf5\x00\xfe\x1a\x09\x68\xbd\x93\xf9\xf7\xbf\x84\xe5\x39\x1a\xb8\x6d\x7b\x76\xaa\xbb\xa8\x85\xe5\x95\x20\x3f\xc2\xa8\x9a\x82\x00\x77\xd1\x1a\xb7\x82\x57\x4e\x11\x98\xa2\x46\xef\x53\xf9\xa8\xc1\x16\xcf\xa5\xe0\xf4\x08\xb8\x34\x16\x59\xc8\x6b\x7b\x37\xb8\xf5\xc6\x19\xd4\x13\x9e\xd0\x84\x1a\xcb\x64\x82\x9b\xf0\x4c\x85\x09\xcf\x66\x5d\x98\x4a\x2f\xd9\x1c\xde\x5c\xc6\x9a\xfb\xfe\x04\x3a\x07\x80\x54\xaf\x60\x24\x4a\x5a\xc6\xa5\x01\xde\x2e\xa3\xa4\x60\x9a\x25\x94\x3c\xa4\x6e\x87\x05\xd3\x6e\x27\x5f\x49\x31\x03\x81\xd6\xa2\x36\x7b\x90\xf2\x9c\x5b\xe3\x82\xf4\x62\x56\x15\x28\x0d\x30\x8d\xc0\x84\x50\x53\x0c\xd9\xfe\xf7\x60\xc7\x99\x5d\xd6\x86\x32\x5c\x40\x32\x3a\x61\x06\x63\x39\xbf\x14\xdc\x0e\xd0\x60\xc5\x34\xc5\x41\x30\x9e\x81\xe1\x32\x17\x08\xee\x5e\xf0\x16\xb9\x6e\xce\xdb\xb2\x4c\x5b\x9a\x5a\x94\x69\x7b\x72\xae\xcd\x42\xbc\x23\xe0\x16\x06\x12\xf3\x76\x56\x5b\x90\xad\xe8\x76\x88\x6f\x09\xee\xad\x68\xe9\xfb\xe5\xb1\x35\x83\x2e\x1d\x61\x1a\x4b\xb1\x31\x02\x96\x95\x9d\xad\xc3\x7b\xd9\xb9\x5b\xb1\x82\xd5\xac\x12\x3e\x89\x2e\xb9\xa1\x8d\x93\xf1\xbc\xd6\xe1\xad\x16\xaf\x20\x44\xa0\x8d\xb2\x7c\xbc\xe5\x92\x21\xf3\x79\x7f\xe0\x7f\x52\x10\xd7\x86\x5a\xc6\xb0\x3c\x9c\x21\xdd\x5e\xcf\x1a\x3a\x4e\xd8\xdf\x8a\xeb\x0c\x7f\xd1\x33\xa8\x72\xe5\x16\x4f\x54\xba\x9b\x87\xb0\x8b\xa6\x10\xa5\x14\x05\xe6\x6e\xb3\x3e\x8b\xe2\xe5\xe2\xe0\x77\xf2\xe4\x66\x9f\x0e\x2e\xc2\x84\xb6\xd4\x13\xa0\x63\xa9\xa0\x93\xbb\x5c\x61\x10\xea\x69\x9f\xa0\x9a\x8a\x52\xb7\xd6\xb6\xd1\xbc\x5f\x10\x49\xc1\x45\x1a\x58\x13\x8b\x54\x06\x52\xf6\xb0\xd2\xdc\x60\xe4\x6a\x7b\x07\xa8\x4e\xa3\xae\xce\x02\x14\xae\xce\xba\x47\xe1\xfa\xec\x70\xe8\x17\xc6\x04\x35\xcf\x38\xea\xc8\xdb\x2f\x80\xb3\xbb\xbe\x58\x7a\x8b\xfb\xe3\xff\x7e\xa1\x64\xc7\xa7\x9f\xff\xff\x51\x9f\x01\xa1\x64\x1e\xcf\x6c\xb3\xaa\x6e\x52\x02\x99\x69\x67\x06\x7a\x33\x0a\x00\x24\x35\x33\x34\x3e\x04\x90\x2a\x18\x97\x3c\xd6\x35\x7b\x7f\x2c\x05\xff\x60\x3d\x50\x54\xcb\xea\x49\xe4\xb2\xb7\xa6\xd0\xb9\x02\xbd\x0c\x60\xc6\x68\xa7\x88\x12\x3e\x91\x19\xe4\x1c\xd1\x22\x6a\x9a\xcd\x1c\x1e\x6b\xab\x0f\x53\x6e\x28\x9f\x0b\x9f\xa0\x96\xe9\x13\x25\xf1\x64\xfc\xe4\x66\x42\xf9\x9a\xab\xe7\x16\xc9\x61\x11\x03\xc0\x89\x40\x6e\xef\x29\xaf\xf0\xb0\xbe\xe4\xdb\x09\xbe\x1b\xe6\xef\x5b\x20\x4d\x28\x84\x8c\x03\x90\xf0\x05\xb5\x5d\xab\xb8\xce\xb9\x5c\xb9\xeb\xb9\x81\x71\xcd\x45\x7b\xcb\x8f\x8e\xce\x68\xdd\x1b\x0a\x07\x29\x7c\xf5\x3f\x9b\x86\x4a\xc2\x49\x41\xf9\x2f\x25\x68\xd9\xd8\x82\x2d\xce\x4f\x4a\xaa\xa0\x4c\x31\x7d\x2a\x78\xc1\xe5\x52\xb6\x0f\x3e\xad\xee\xfa\x57\x9e\x41\x5b\xc8\x12\xcc\xa2\xb1\x0b\xc1\x90\x91\x3f\x3a\xeb\xd8\xa1\x6e\x2b\x42\x86\x38\x1e\x9e\x9f\xb6\xf9\xf0\xc3\xf3\xd3\x10\x07\xda\xda\x04\xa6\xf7\x60\x5c\x5b\x37\x62\xae\x8c\x2b\x97\xe0\x34\x10\x4f\x2d\x5e\x61\x4d\x9a\xc9\xb1\xb5\x7a\x06\x2c\x67\x7c\x9b\x01\xfe\x01\xb8\x76\x0f\xab\xe6\x13\x92\x59\xa6\x0f\x55\xb6\x0c\x20\x69\xac\x47\xfe\x37\x0d\x37\x97\x8b\x5a\x14\x7d\xb8\x71\x3f\x63\x2b\x28\x6f\x0e\xd3\x6d\x4c\x3d\x16\x3c\x79\x77\x5b\xde\x18\xa5\xd3\x94\x9b\xe1\x3f\xef\x86\xa3\xdb\x50\xf2\x7b\x70\x79\x7c\x75\x73\x34\xbc\xb9\xbb\x3c\x09\xe4\xc0\x6f\x86\xa3\xeb\xab\xcb\xd1\x30\xac\xe1\xf6\xcb\xd5\xcd\x6d\x48\xfa\x91\xf6\x62\x05\xb7\xb9\x7a\x77\x47\xf4\xe1\x33\xfd\xd3\x5a\xe7\xa2\x64\xe7\xdb\xf8\x81\x0c\x17\x5e\x5e\xad\x36\x40\xb6\x54\x96\xe2\x5f\x3d\x41\xed\xdf\x77\xf4\x61\x64\x99\xad\x29\x9e\x49\xbd\x87\xe7\xff\xf6\x2f\x18\xf6\xda\x57\x1c\xcb\x8f\x2e\x51\xba\xf8\x56\x7a\x07\x2d\xca\x2f\x7c\x7c\x91\x02\x29\x96\x90\xa1\xa6\x5b\x83\x96\x00\x2e\x39\x04\x28\x78\xd1\x6e\x0a\x97\x2c\x29\xa8\x3a\x6b\x63\x3c\xc6\x9b\xd5\x9c\xcd\xd3\xc1\x8d\x59\xce\xd1\xe2\x9d\xe0\xa3\x67\xc9\xa6\xad\xe1\xb7\x50\xd0\x4d\xa0\x50\x53\x72\x56\x7e\xa2\x7d\x38\x9f\xf7\x6f\x95\x65\x22\x38\x5f\xa1\xde\x6b\x55\xfb\xa9\xd3\xb6\x69\xf6\x69\xa2\x64\xda\x34\xcf\xc4\xd7\x83\x6d\x96\xef\x84\xbf\xa5\x0b\x5d\x25\x4c\xd0\x1b\x96\xe4\x9e\x76\x8a\xca\x32\xca\xb5\xcc\xe7\xfd\xab\x2c\x33\x48\xce\x9d\xab\x72\xd9\x62\xb9\xfc\x5d\xdf\xbd\xc5\x4d\xed\x33\x6f\xe4\x15\xf8\x24\xae\xe9\xc3\x68\x26\x93\x42\x2b\xc9\x1f\xfc\x4d\x61\x66\xc6\x62\xd9\x62\x44\x5d\x6f\x3f\x00\xb1\xe0\x80\x2d\x4e\x62\x6e\xc0\x62\x59\x29\xcd\x34\x17\x33\xa8\x25\x9b\x30\x2e\xa8\x74\xbe\xce\xaa\x18\xe9\x30\xb4\xcb\xe3\xab\xac\x33\x3a\x77\x4f\xfe\xa2\x33\x3a\x3b\xab\xeb\x26\xc7\x29\xfd\x4b\x6f\x29\xa6\x8c\x3b\xcf\x3e\x53\xba\x43\x6d\x1b\xc2\x8f\xb5\x9a\x9a\xe0\xc3\xce\x1d\x95\x75\x13\x5b\x4c\x28\xdd\x94\xee\x9c\xb7\x7a\x36\xc8\x2c\xea\x70\xe8\xb3\x5e\x66\x03\x8c\x73\xfe\x36\x6b\x6e\xbb\x85\x94\xb5\x6f\xe1\x5c\xed\x33\xb8\xf9\x5f\xf6\xeb\x54\x77\x27\x69\x55\x91\x27\x9c\xa2\x7f\xeb\xd6\x56\x1f\x94\x78\xcc\xf4\xf8\x9c\xc2\xe3\x35\x11\x04\xdd\x55\xdb\x06\x6a\x54\x15\x10\x93\xa5\x28\x94\x4c\xb2\x1c\x5d\xca\x70\xe9\x05\xb9\xed\xbe\x52\xdc\x8f\xab\x66\xbf\x35\x4a\xa4\x29\xcb\x42\x07\xe5\x4a\xb4\x12\xf4\x48\xf6\x1d\x6c\x79\x25\xcc\x06\x63\x0c\x9b\x2c\x63\x29\x9f\x77\x0d\x16\xe9\x16\x4f\x6a\xdb\xe7\xcf\xa2\xce\xf7\xb9\xdc\x3f\x6b\x93\xb5\xae\x1b\x48\xf2\x38\xa0\xfc\xfe\x1f\xf7\x34\x37\x54\xc5\xbb\x23\x87\x88\xee\xbf\x8e\xea\x3f\xd0\x6c\x91\xab\x06\x99\x60\xb9\x33\xe7\x58\xb0\x9c\xbe\xb4\xe7\xbe\xf7\x47\x52\x4c\x04\x0b\xe7\x98\xdf\x14\xa2\xd3\x88\x2f\x83\x9b\xcb\x53\xf2\x9d\xbb\x09\x2c\x3f\x77\x0a\xff\xa6\x6a\xdd\x66\x3d\x53\x45\xf5\x3d\x65\xa1\xa0\xa9\xa0\xed\xe5\xb2\x84\xc6\x2c\x8e\x69\xf7\x20\xd2\x9f\x90\x74\x4d\x56\xe8\xdf\x1b\x44\x39\x97\x6f\x8f\xb3\xc9\x1c\xc1\x92\x7b\xd3\x06\x2b\x5e\xe7\x93\xd8\xf5\x2d\xec\x78\x2d\x40\xa7\x01\x8e\xae\xdf\x67\x4d\xb3\xb2\x56\x68\x53\x08\x9e\x58\xd3\xd6\x5c\xe8\xf1\x0e\x37\xee\x0a\x54\x32\xce\xc3\x7f\x23\xe5\x21\xe2\xb7\xb3\xea\xb9\xde\xf6\xca\xf7\x45\x86\x28\x1f\x7a\x7b\x3d\x1f\x00\x9a\x0f\xff\xfe\xef\x00\x94\x4f\xd1\x2f\xdd\x31\x00\x00")
 
 func i18nResourcesDe_deAllJsonBytes() ([]byte, error) {
 	return bindataRead(
@@ -97,7 +97,7 @@ func i18nResourcesDe_deAllJson() (*asset, error) {
 	return a, nil
 }
 
-var _i18nResourcesEn_usAllJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x94\x4f\x6f\xda\x40\x14\xc4\xef\x7c\x8a\x11\x17\x5f\x90\xa5\x5e\x7d\x8b\xa8\x2b\x45\x69\x0b\x2d\xcd\xa9\xf4\xb0\xd8\x0f\xc7\xea\xfa\xad\xbb\x7f\x88\x22\x6b\xbf\x7b\xe5\x18\x38\xa0\xdd\xb2\x8d\x50\x2e\x08\x34\x3b\xbf\x99\xc5\x7e\xef\xe7\x0c\x18\x66\x00\x30\x6f\xeb\x79\x81\xf9\x96\x4b\xb6\xa4\x21\xc0\xae\xdb\x91\x9e\x2f\x26\xd5\x6a\xc1\x46\x0a\xdb\x2a\x0e\x1e\x9b\x01\x7e\x71\x09\xbb\x63\x90\xd6\x4a\x43\x55\x95\xd3\x9a\x6a\x3c\x3f\x11\xa3\xd2\x24\x6c\xcb\x0d\xa4\x6a\xb0\x6f\x25\x21\x1b\x86\x7c\x2d\xec\x93\xf7\x59\xb1\xe5\x61\xc8\xcb\xd1\xe6\xfd\x96\xb7\x1c\x69\x70\x1b\x76\x72\xed\x91\x54\xbb\xae\x1f\xd1\x9a\xfe\x38\x32\xf6\x82\xf6\x1f\x3d\x13\x60\x6f\x2c\x66\x7a\xc5\x86\x6e\xd5\x2c\x4c\x0b\x56\x5b\x2a\x27\x6b\xb0\xb2\xd0\x24\x6a\xec\xb5\xea\xd0\x72\xef\x6c\x81\x48\xfc\xbf\x1c\xc1\x88\x52\x8a\xde\x50\x5d\x44\x78\x67\x39\x68\xfe\x74\x77\xff\xb9\xfc\x18\xb1\x1e\xc5\xa0\xf1\x9e\x0f\x42\xb6\x35\xac\xfa\x4d\x1c\xbd\xcc\xe5\xa9\x20\x6a\xf5\x10\x71\xaf\x1e\xc2\x86\xb5\x24\x61\x08\xf4\x3a\x6a\xd9\x4b\xb6\x40\xc6\xe3\xc7\x0b\x99\x0c\x4a\x23\x63\x95\xe5\x11\x66\x9a\xf7\x7a\xec\x69\xc2\xb1\x23\xfb\x4c\xc4\xf8\x00\xab\x30\x0c\xf9\x52\x39\xb6\xde\x27\xe5\x5f\x87\xa4\x14\x99\xfe\xe2\xbd\x54\xd3\x84\x4f\xc8\xc4\xfc\x88\x37\x3d\xf6\x0d\x69\xe9\x21\x07\x21\x1d\x25\xb1\x8f\x27\x83\xc8\xef\xe5\xb7\xc7\x72\xf3\x23\x36\x20\x67\x39\x62\xde\xac\x57\x5f\x37\x65\xdc\x7d\xd2\xc3\x76\xea\x94\x25\x18\xd2\x07\xd2\xd3\x5e\xc9\xb1\xb1\xc2\x3a\x83\x4a\xd5\x54\x8c\x4f\x7b\xfa\xbd\x54\x35\x79\xbf\x38\x2e\x9f\xb3\xf8\xba\x60\x4e\x5a\x47\xc6\x88\x66\x12\xbe\x4c\xdf\xbd\x8f\x35\x7b\x8f\xe8\xe0\xa5\x1f\x59\xec\x24\x8d\xaf\xb2\x11\x07\x42\x2f\x5d\xd3\x32\x2a\xc5\xfb\xb6\x89\xae\x8a\x2b\xa6\x31\x68\xf6\xeb\x6f\x00\x00\x00\xff\xff\x04\xbf\x88\xc2\x98\x07\x00\x00")
+var _i18nResourcesEn_usAllJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x5a\x4b\x73\xdb\x38\x12\xbe\xe7\x57\x74\xe5\xa2\x8b\xa2\x9a\xc7\x1e\xb6\x7c\x53\xd9\x4e\xca\x95\xf8\xb1\xb1\x3d\x5b\x53\x9b\x3d\x40\x64\x53\x44\x19\x04\x38\x00\x28\x45\xa3\xe2\x7f\xdf\x6a\x80\xa2\x2d\x1b\x10\x21\x59\xce\xe6\xc2\xc8\x21\xfa\xfb\xbe\xc6\xb3\xbb\xc1\xff\xbc\x03\x58\xbf\x03\x00\x78\xcf\xf3\xf7\x27\xf0\xfe\x9b\x3c\x97\x16\x35\x30\x90\x4d\x35\x43\xfd\x7e\xec\xdf\x5a\xcd\xa4\x11\xcc\x72\x25\x83\xcd\xde\x01\xb4\xe3\xe7\x60\x53\x09\xa8\xb5\xd2\xa0\xb2\xac\xd1\x1a\x73\x58\x96\x28\x21\xd3\xc8\x2c\x97\x73\x10\x6a\x0e\x05\x17\x08\xa3\xf5\x7a\x72\xc3\x6c\xd9\xb6\xa3\x93\x6f\x72\xbd\x9e\x9c\x93\x59\xdb\x7e\x93\xdf\x64\x44\xc1\x71\xb0\x93\x65\x93\xca\xbc\xa9\x6a\x82\xd6\xf8\x57\x83\xc6\x3e\x43\xdb\x43\x67\x02\xd8\x81\xc2\x4c\xad\xa4\xc1\x63\x29\x0b\xa3\xc5\xa4\x35\x12\xbf\xd7\x98\x59\xcc\x9f\xe1\x9e\xc0\xa3\x7d\x5c\x4b\x9a\x79\x98\xbc\xb1\xa5\xd2\xfc\x6f\x07\x07\x05\xe3\xa2\xb3\x3a\x55\x39\xc6\x39\x07\xac\x0e\xa1\x72\xac\x67\x68\x32\xcd\x6b\x6a\x71\x28\x79\x00\x27\x41\x8e\x69\xb2\x0c\x31\xc7\x7c\x02\x7f\xaa\x06\x32\x26\x21\x13\xca\x20\xd8\x92\x1b\x58\x72\x99\xab\x25\x30\x99\x83\x46\xdb\x68\x09\x56\x81\x2d\x11\x2c\xea\x8a\x4b\x26\x26\x49\x5a\x5f\x4d\x12\x74\xe4\x54\xa8\x26\x87\x8f\xaa\x91\xb9\x5e\x81\xd2\xf3\x88\x96\x97\xed\x12\xe0\x4c\xcd\x32\x4c\x02\xf4\x2d\xe3\x90\x9b\x76\xd3\x9b\x0b\x40\x99\xd7\x8a\x4b\x0b\xdc\x80\x54\x16\x0c\xda\x5d\x1c\x43\xa6\x61\x52\x25\x0b\xae\x2b\x87\x44\x8d\x69\xb7\xe0\xb4\x54\xb9\x04\xa9\xe4\x07\x4e\x9b\x35\xcb\x2c\x5f\x20\x54\x2a\xc7\x31\x34\x06\xe1\xc3\x87\x42\xe9\x0c\x69\x7c\xcd\x03\xaf\x81\x47\x85\x1d\x0b\x3e\x22\xbe\x11\xb9\xeb\x1a\x8d\x2c\x87\x42\xab\x0a\xb8\xac\x1b\x7b\x02\x51\x3d\x71\x8b\x20\xc5\x19\x16\xac\x11\xd4\x7c\x4e\x2e\xa8\xc2\xcd\x35\x96\x65\xaa\x49\x19\x98\x64\xf3\x20\xf9\xb9\x60\xb5\xc1\xfc\x24\x02\xde\xbf\x0e\x1b\x77\x53\xc0\xbc\x38\xa5\x48\xb6\x6a\x2c\xa9\xc9\x99\xc5\x31\x70\x0b\x4b\x66\x40\x30\x63\xa1\xa9\xe9\xff\x72\x60\x96\x76\x88\x7b\xff\xd7\xd4\x46\xf7\x99\xa3\xd3\xec\xeb\x0c\x41\xd2\x20\x14\x34\xfd\xf7\x17\xb9\x6d\x1e\x21\x5f\x70\xad\x64\x85\xd2\xc2\x82\x69\xce\x66\x02\xa9\x73\xae\x58\x85\x6d\x3b\x3c\x09\xd2\xed\xc3\xf4\xdf\x6b\x4e\x5b\x96\x9f\x3b\x1a\x0b\x8d\xa6\x04\xab\x1e\xd0\x2d\xa9\x46\x3e\x48\xb5\x8c\x9d\xc1\x89\xc6\x41\xe2\x8f\xd3\x8b\x2f\xe7\x67\x11\xe0\xee\x65\xd8\xd0\x9d\x36\xb4\x7c\x59\x9e\x43\x85\x14\xc0\x19\xf7\x67\x96\xa1\x31\x30\xd7\xaa\xa9\xdd\x54\xf9\x44\xbf\x2e\xce\x28\x2c\xa3\x1e\xb9\xf4\x4d\xa3\x93\xed\x08\xc0\x03\x82\x37\x3d\x74\x31\xbd\xf4\x5d\x9c\x10\x5b\xa4\x5a\x27\x52\xdf\x4f\xa7\xaf\xa0\x0e\x5b\x07\xa9\x49\x65\xfa\x19\x13\x6b\x1d\x86\xbe\xfa\x78\x1d\xdb\xb6\xfc\xbb\xb0\x19\x6d\xde\x7e\x57\x36\x36\xe7\x12\xf0\x3b\xc5\x1b\xc6\xcd\x7c\xc1\x2b\xee\x76\x93\xf5\x7a\xf2\x85\x7e\xb7\x2d\xcc\x56\x16\x4d\x8c\xe7\x30\xb0\x88\xb0\x05\x13\x3c\x07\xb6\x15\xa9\xf4\xdd\x41\x33\x6e\xb3\xc7\xb4\xed\x28\x2a\x68\x2f\x90\x9d\x42\x32\x55\x55\x14\x68\x8d\xfa\x7d\x64\x94\x30\x5d\x52\xad\x77\x52\xe7\x8d\xf6\xca\xc9\xfa\x0f\x26\x1a\x6c\xdb\xd1\x04\xee\x0d\xf6\xd9\x1a\x2c\xb9\x2d\x81\x41\x23\xfd\x88\x8d\xa4\x19\x8d\x61\xd4\xb8\x67\xe5\x9e\xee\x51\xd1\xa3\x1c\x81\xd2\x30\xca\x47\x63\xc0\xc9\x7c\x02\xa3\xdf\x7f\xa9\x46\x93\x01\x0f\x7e\x90\x88\x9d\x1d\x21\x59\x85\x2e\x9e\x3b\x70\x14\x86\xed\x77\xd2\xff\xd5\x30\x69\xb9\x5d\x0d\x77\x81\x04\xe5\x92\x05\x26\x1e\x3b\xe3\x33\x27\xb7\x2f\xdd\xf3\x93\x7b\xde\xb9\xe7\x8d\x7b\x3e\xd0\xe3\x92\x1e\x9f\xe8\x71\xe7\x87\xe8\xa6\xef\x9d\xdf\x3e\xf1\xc1\x21\xfa\xff\xeb\xdb\xd9\x7d\xc6\x32\x8b\xc0\xa5\xdb\x5b\xb6\x97\xe4\x26\x31\x1d\x70\x30\x05\x61\xa7\x04\xcb\xf4\x1c\xed\x1e\x33\x26\x60\xb0\x9b\xc0\x1f\x23\x43\xa8\x5d\xab\x20\xd4\x65\x23\x2c\xaf\x05\xc5\x0e\x46\x35\x14\xf4\xbb\x43\xd6\xb8\xd9\xbb\xb5\x83\xc0\x12\x35\xfa\x38\xca\x67\x09\xb6\x7c\x6e\x05\x17\x67\xc0\xa5\xb1\xc8\x62\x91\xda\x9b\xd1\xed\x76\xce\xa0\x5e\xf0\x8c\x06\xd3\x58\x26\x33\x1c\xe2\x33\x35\x66\xbc\x58\x85\x38\x95\xee\xd5\x9c\x7e\xbd\x4a\x75\xf7\xed\x05\x04\x3b\x80\xa0\xb7\x38\x32\x25\x2d\xe3\xd2\x00\xef\x26\x47\x56\x32\xcd\x32\x2a\xc5\x51\xb3\xd3\x92\x69\xb7\x8a\xaf\xa5\x58\x81\x40\x6b\x51\x9b\x31\xe4\x7c\xce\xad\x71\x49\x79\xb9\xaa\x4b\x94\x06\x98\x46\x60\x42\xa8\x25\xc6\x7c\xff\x31\xdc\x69\x6e\x57\x8d\xb1\x30\x43\x20\x1b\x9d\x31\x83\xa9\x9a\x5f\x1a\xee\x47\x68\xb0\x66\x9a\x72\x1f\x98\xad\xc0\x70\x39\x17\x08\xee\x4c\xf0\x1e\xb9\x66\x2e\xd2\xb2\x4c\x5b\x1a\x5a\x94\x79\xb7\x6b\xee\xac\x3a\xbc\x21\xe1\x1e\x0e\x92\xf2\x6e\x54\x3b\x92\xbd\xe4\x06\xcc\xf7\x24\xf7\x5e\x74\xf2\xfd\xf4\xd8\x5b\x41\x08\x23\x2e\xa3\x37\x9b\x21\x60\x55\xdb\xd5\x2e\xbe\x97\x8d\xc3\xc0\x0a\xb6\xab\x48\xf8\x24\xa3\xe4\x86\x16\x4e\xc1\xe7\x8d\x8e\x2f\xb5\x74\x80\x98\x80\x2e\xc3\xf2\xb9\x96\x2b\x7e\xac\xd7\x93\xa9\xff\x49\x09\x5c\x97\x66\x19\xc3\xe6\xf1\x8a\xe8\xfe\x38\x3b\xe4\x38\x63\x7f\x22\xee\x72\xfc\x45\xcb\x28\xe4\xd6\x09\x9e\xa9\xfc\xb0\xe8\xe0\x10\xa4\x98\xa4\x1c\x05\xce\xdd\x62\x7d\x96\xb9\xcb\xcd\xc6\xef\xec\x29\xc4\xbe\x98\x5e\xc6\x05\xed\x89\x13\x91\x63\xe9\x3e\x65\xee\x6a\x83\x51\xaa\xa7\x6d\xa2\x30\x35\x95\x6a\xad\xed\x32\x79\x3f\x21\xb2\x92\x8b\x3c\x32\x27\x36\xe5\x0b\xa4\x6a\x61\xad\xb9\xc1\xc4\xd9\xf6\x06\x54\x41\xa7\xae\x3f\x47\x24\x5c\x7f\x0e\xf7\xc2\xcd\xe7\xd3\x73\x3f\x31\x16\xa8\x79\xc1\x51\x27\x9e\x7e\x11\x9e\xc3\xf1\x52\xe5\x6d\xce\x8f\x7f\xfc\x4e\x85\x8e\x5f\x7f\xfb\xe7\x23\x9e\x01\xa1\xe4\x3c\x5d\xd9\x30\x54\x58\x94\x40\x66\xba\x91\x81\xd1\x8a\x82\x7f\x49\x8f\x15\x1a\x1f\xfe\x4b\x15\xcd\x49\xd2\x6c\x87\x69\xfb\xc4\x65\x86\x76\x89\x28\xe1\x57\x72\x81\x02\x23\x9a\x40\x6d\x9b\xc4\x3f\x0c\x92\x22\xc4\x0f\x6a\x21\x94\xbf\x8e\xf4\x90\x89\xfc\x11\xdb\x74\xda\x03\xd8\xd2\x49\x16\x94\x2b\x26\x61\x77\x2d\x23\x90\xcd\x9c\xcb\xad\x23\x9d\x1b\x98\x35\x5c\x74\x87\xf9\xed\xd9\x67\x9a\xde\x86\x32\x3e\xca\x50\xfd\xcf\xb6\xa5\x3b\xcf\xac\xa4\x12\x97\x12\x39\x6a\xb0\x25\xdb\x6c\x93\x54\x37\x41\x99\x63\xfe\xd4\xf0\x92\xcb\xde\x76\x02\xbe\x62\xee\xda\xd7\x5e\x41\x77\x3f\x25\x98\x45\x63\x37\x86\x71\xf7\x7e\x6e\xd5\xa9\x5d\xdd\x5d\xf4\x18\xd2\x78\xfa\xe5\xa2\x2b\x75\x9f\x7e\xb9\x88\x69\xa0\x55\x48\x64\x7a\x0c\xb3\xc6\xba\x1e\x73\x57\xd0\xb2\x27\xa7\x8e\x78\xea\xf1\x96\x6a\x42\xa6\xf8\xd5\xea\x15\xb0\x39\xe3\xfb\x74\xf0\x4f\xa0\x35\xdc\xad\x9a\x2f\xc8\xa6\xaf\x10\xaa\xa2\xcf\x13\x49\xff\xad\xff\x4d\x2e\x70\xb9\xb9\x62\xa2\x17\x5f\xdd\xcf\xd4\xcb\x91\xa3\xd3\x84\x9d\x69\x66\x82\x67\x6f\xee\xcb\x91\x59\x82\xae\x7c\x3d\xff\xd7\xfd\xf9\xed\x5d\xac\xbe\xdd\xbf\x8e\x18\xdf\xde\x5c\x5f\xdd\x9e\xc7\xad\x37\xef\xc3\xe6\x8f\x9a\x37\xd3\xb7\xab\xc5\xbb\x8d\x79\x02\x7f\xd0\x3f\x9d\x6b\x2e\x13\x76\xf1\x8b\xef\xc5\xf8\xc5\xca\xab\x61\x23\x62\x2b\x65\x29\xc7\xd5\x0b\xd4\xfe\xa3\x8b\x09\xdc\x5a\x66\x1b\xca\x59\x72\x1f\xc5\xf9\xbf\xfd\x57\x09\xe3\xee\xcb\x8c\xfe\xa5\x2b\x84\x6e\xde\x55\x3e\x08\x4b\x8a\xfd\x7e\x08\x75\xc4\xe9\xad\x6a\xcc\xd3\x2e\x4d\x99\xc1\xc9\xe6\x41\xf2\xdb\x67\x65\xa4\xbd\xe9\xf7\x00\x08\x0b\x28\xd5\x92\xc2\x91\x5f\x68\xe9\xad\xd7\x93\x3b\x65\x99\x88\x8e\x52\xac\xf5\x4e\x68\x3f\x70\xda\xb6\xed\x07\x9a\x21\x32\x6f\xdb\x67\xe6\xbb\xc9\x86\xed\x83\xf4\x77\x74\x86\xab\x8c\x09\xfa\x1a\x25\x7b\xa0\xf5\xa1\x8a\x82\xaa\x28\xeb\xf5\xe4\xba\x28\x0c\xd2\x1d\x92\xbb\xbb\xb2\x65\x3f\xf3\x5c\xdb\xf1\xe6\x70\xf6\x35\x35\x0a\x04\x7c\xd1\xd5\x4c\xe0\x76\x25\xb3\x52\x2b\xc9\xff\xf6\x87\x83\x59\x19\x8b\x55\xc7\x91\x74\xa2\xfd\x04\xc2\xa2\x1d\xb6\xd9\x7c\xb9\x01\x8b\x55\xad\x34\xd3\x5c\xac\xa0\x91\x6c\xc1\xb8\xa0\x8b\xf0\x5d\x5e\xa5\x58\xc7\xa9\x5d\x75\x5e\x15\xc1\xbc\xdb\x7d\x12\x97\x5c\xab\x39\x18\x2e\x2c\x8e\x53\x61\x97\xbe\x8c\x58\x32\xee\xe2\xef\x42\xe9\x00\x6c\x97\x9c\xcf\xb4\x5a\x9a\xe8\x77\x92\x07\x82\x85\x85\x6d\x06\x94\x0e\x47\xb7\xbb\x5b\xbd\x9a\x16\x16\x75\x3c\xb1\xd9\x6d\x33\x40\xe3\xe2\xbd\x61\xe4\xae\x59\x0c\xac\xfb\xaa\xcd\xdd\x68\x46\x17\xff\xcb\x76\x41\xb8\x7b\x49\xb3\x8a\x82\xdf\x1c\xfd\x57\x6b\xdd\xbd\x82\x12\x8f\x35\x1c\x5f\x2d\x78\x3c\x24\xa2\xa4\x87\xa2\x0d\x48\xa3\x7a\xbf\x58\xf4\xa6\x50\x31\xc9\xe6\xe8\x8a\x81\x7d\xe0\xe3\x96\xfb\xd6\x95\x7d\xda\x1d\xf5\xb1\x59\x12\x5d\xe9\xaf\x30\xa8\x0a\xa2\x95\x10\xa8\x1f\x31\x8f\xe7\xcb\x2b\x69\x06\x9c\x31\x6c\xd1\xa7\x4f\xbe\xa2\x7a\x02\x83\xd2\x82\x46\x61\x22\x8a\x3a\xe8\xa4\x0b\xdc\xde\x03\x8d\x0b\x85\x62\x50\x08\x36\x77\xc2\x3f\x0a\x36\xa7\x37\xdd\x0e\xef\x23\x8f\x1c\x33\xc1\xe2\x75\xe2\xa3\x52\x04\x9d\xf8\xf7\xf4\xeb\xd5\xc5\xd5\xa7\x58\xf4\xdb\xbf\x0e\x1a\xff\xa9\x1a\xdd\x55\x2e\x73\x45\x77\x74\xca\x42\x49\xfd\x47\x0b\xc9\x55\xfa\x8c\xd9\x6c\xc8\xee\x23\x46\xbf\x17\xd2\x81\x58\xa3\xff\x5e\x20\x29\x78\x3c\x3e\xcf\x90\x3b\x82\x65\x0f\xa6\xcb\x44\x3c\xe6\x93\xc4\xf4\x18\x7e\xbc\x96\x20\xe8\x80\x93\xeb\x57\x54\xdb\x6e\xcd\x15\x9a\xc9\x82\x67\xd6\x74\xf7\x26\xf4\xf1\x0d\x37\xee\xb0\x53\x32\x2d\x82\x3f\x12\x78\x4c\xf8\xdd\xaa\x7e\x8e\xdb\x1d\xee\xfe\xa2\x20\x29\x5a\xde\x1f\xe7\x1d\x40\xfb\xee\xbf\xff\x1b\x00\xa3\x4d\x6e\x5e\x16\x31\x00\x00")
 
 func i18nResourcesEn_usAllJsonBytes() ([]byte, error) {
 	return bindataRead(
@@ -117,7 +117,7 @@ func i18nResourcesEn_usAllJson() (*asset, error) {
 	return a, nil
 }
 
-var _i18nResourcesEs_esAllJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x55\xbd\x6e\x22\x49\x10\xce\x79\x8a\x12\xc9\x24\x08\xc9\x29\x99\x85\xe7\x24\x74\x3e\xe3\x03\x3b\x3a\x2e\x68\xa6\x8b\xa1\x77\x9b\x2a\x5c\xdd\x3d\x96\x85\xe6\x61\xf6\x11\x56\xce\x36\xe5\xc5\x56\x3d\x8d\xf7\x87\xa5\xbd\xd8\xda\x04\x31\xaa\xf9\x7e\xaa\x4a\xf5\xcd\x7f\x3d\x80\x5d\x0f\x00\xa0\x6f\x74\x7f\x04\xfd\x05\x95\xe4\x51\x40\x01\x85\xcd\x12\xa5\x3f\x48\x55\x2f\x8a\x9c\x55\xde\x30\x1d\x5e\x73\x95\x98\xa5\x82\x40\x40\xfb\x2f\x1b\x14\xee\xf7\x00\xda\xc1\x31\xe1\x25\x01\x8a\xb0\x00\x57\x55\x10\x41\x0d\x8f\x6b\x24\xa8\x04\x95\x37\x54\x83\xe5\x1a\x56\xc6\x22\x14\xbb\xdd\xf0\x56\xf9\x75\xdb\x16\xa3\x05\xed\x76\xc3\x32\xc2\xda\x76\x41\x0b\xca\xb8\x98\x23\xac\x15\x6c\x85\x75\xa8\x8c\xe6\xe8\x25\x69\x29\xdb\x09\x08\xa0\x05\x25\xd5\xda\x34\x0c\x1a\x41\xb0\x36\xce\x0b\xbf\xae\x75\x76\x1b\xd1\xb5\x0e\x9b\x6d\x6c\x43\xf0\x21\xa0\xf3\x47\x6c\xef\xf0\xdd\xb0\xad\x94\x80\x55\xe0\xd8\x9a\xca\xf8\xa0\x8f\x49\xdf\x69\xd0\x6d\x99\x1c\xfe\x49\x87\x91\x33\x76\xad\xce\x72\x38\xe6\x60\x35\x10\x7b\x10\x54\x1a\x56\xc2\x1b\x30\xb4\x0d\x7e\x04\x19\x17\xaf\x21\x4e\x4a\x94\x56\x6d\x1d\xea\x51\x86\xef\x2e\x3e\xc6\xe9\x18\xcd\xa3\xd3\x0c\x7f\x5d\x4e\xae\xcb\xab\x0c\xbe\x9c\xcd\xa6\xb3\xd3\xb8\x09\x35\xca\x1a\x0d\x9e\x3f\x22\x65\x1b\x9a\xe3\xfe\xb3\xb2\x40\x0c\xcd\xfe\x93\x35\x5a\xe5\x1a\x99\xfe\x9d\x1d\x89\x08\x56\x3e\x73\x6c\xb7\x16\x95\x43\xc0\xee\x84\x8b\xa7\x62\x00\x05\xc5\x9f\x27\x74\x05\xb0\x40\x41\x5c\x0c\x73\xcd\xb9\x2d\x56\x66\x65\x1e\x02\xfe\x0a\x3d\x20\x7f\x2f\xfa\x92\x1b\xb0\x44\xff\x88\x48\x70\x01\x9e\x61\xb7\x1b\x8e\x39\x90\x6f\xdb\x73\xd4\xbf\x47\x4a\x24\x15\x84\x0b\x78\xfa\x89\xe2\x1c\x1b\x69\x1d\x2b\xcb\x29\x66\x92\xab\x37\xaa\xaf\x2c\x7b\x45\x1e\x0f\xdb\xe2\xb7\x28\xbf\x4b\xf0\x0d\x3a\x8d\xb2\x01\xcf\xa4\x6f\x94\x65\xc9\x90\xce\xca\x7f\xef\xcb\xf9\x5d\xee\x64\xe6\xd3\xeb\xc9\x78\x72\x77\x7f\x95\xb9\x97\x59\x39\xbf\x9d\xde\xcc\xcb\x1c\x3e\xd6\x23\xff\x65\x0e\x8f\x1b\xf6\x08\x0e\xa5\x41\x49\x19\x33\x84\xb9\x57\x3e\x38\xa8\x58\xe3\x28\x2e\x3e\x3d\x8f\x59\x63\xdb\x0e\x0e\x41\xf4\xad\xd8\xa5\xce\x4b\x6d\x83\xce\xa9\x3a\x15\xfe\x49\xff\xdb\x36\x37\xa4\x8e\x47\xa3\xed\xd4\x8d\x66\x01\x89\x6e\x78\x08\xe3\xfd\xb3\x36\x75\xf7\xcd\x88\xf1\xa6\xf9\x84\x8d\xea\x87\x77\x22\xd3\x29\x33\xe4\xd4\x87\x63\x33\x27\xc7\x70\x4f\x6a\x69\x31\x9e\x8a\x53\x0d\xc2\xd6\x86\xda\x10\x54\x4c\x2b\x53\x67\xd3\xe4\x86\xc1\xa5\x9c\x66\x1d\x43\xba\x0e\x4a\x74\x4a\xe6\x84\x0c\xa2\x2a\xb3\x7f\xa6\xae\xc9\xc4\x99\xd6\xd0\xfb\xff\x6b\x00\x00\x00\xff\xff\xb7\xcb\x30\x13\xf9\x07\x00\x00")
+var _i18nResourcesEs_esAllJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x5a\xcd\x72\xdb\x38\x12\xbe\xe7\x29\xba\x72\xd1\xc5\x51\x4d\x66\xf6\xb0\xe5\x9b\xca\x76\x52\xaa\xc4\x3f\x6b\xd9\xb3\x35\xb5\xd9\x03\x44\x34\x49\xac\x41\x80\x01\x40\x29\x1a\x15\x1f\x66\x1f\x61\x6b\x6e\x7b\xcd\x8b\x6d\x35\x40\xc9\x96\x4d\x48\x90\xa2\xcc\xe6\xc2\xc8\x21\xfa\xfb\xbe\xc6\x6f\x77\x83\xff\x78\x05\xb0\x7c\x05\x00\xf0\x5a\xf0\xd7\xa7\xf0\xfa\x93\xba\x50\x0e\x0d\x30\x50\x4d\x35\x45\xf3\xfa\x24\xbc\x75\x86\x29\x2b\x99\x13\x5a\x75\xcd\x6c\x66\xc4\x94\x41\xa3\x40\x7d\xfd\x6f\x85\x46\xbf\x7e\x05\xd0\x9e\x3c\x07\x1c\x29\x40\x63\xb4\x01\x9d\x65\x8d\x31\xc8\x61\x5e\xa2\x82\xcc\x20\x73\x42\x15\x20\x75\x01\xb9\x90\x08\x83\xe5\x72\x78\xc3\x5c\xd9\xb6\x83\xd3\x4f\x6a\xb9\x1c\x5e\x90\x59\xdb\x7e\x52\x9f\x54\x44\xc5\x04\xa1\x64\x50\x1b\xcd\x9b\x4c\x70\x4d\x5a\x02\x17\x93\x9e\xc0\x00\x4a\x60\x26\x2b\xc5\x4c\x03\x47\x30\x58\x08\xeb\x8c\xde\xce\x95\xec\x06\xa9\xe6\x4d\x55\x93\x1b\x06\x3f\x37\x68\xdd\x33\xb4\x03\x74\xcf\xb4\xcc\x98\x01\xc9\xc0\x6a\x29\x32\xe1\x1a\xfe\x1c\xf4\x40\x81\xb6\xd6\xca\xe2\x31\x15\x1a\xb4\x35\x79\xcd\x52\x15\x36\x0a\xbf\xd4\x98\x39\xe4\xcf\xc4\x9e\xc2\xa3\x7d\x44\x52\xb2\x79\x3f\x79\xe3\x4a\x6d\xc4\xef\x1e\x0e\x72\x26\x64\x67\x75\xa6\x39\xc6\x39\x77\x58\x1d\x42\xe5\x59\xcf\x91\x96\x4f\x4d\x2d\x0e\x25\xef\xc1\x49\x90\x63\x9b\x2c\x43\xe4\xc8\x87\xf0\x9b\x6e\x20\x63\x0a\x32\xa9\x2d\x82\x2b\x85\x85\xb9\x50\x5c\xcf\x81\x29\x0e\x06\x5d\x63\x14\x38\x0d\xae\x44\x70\x68\x2a\xa1\x98\x1c\x26\x69\xfd\x66\x92\x5e\x47\xce\xa4\x6e\x38\xbc\xd3\x8d\xe2\x66\x01\xda\x14\x11\x2d\x2f\xdb\x25\xc0\xd9\x9a\x65\x98\x04\x18\x5a\xc6\x21\x57\xed\x46\x37\x63\x40\xc5\x6b\x2d\x94\x03\x61\x41\x69\x07\x16\xdd\x36\x8e\x5d\xa6\xfd\xa4\x5a\xe5\xc2\x54\x1e\x89\x1a\xd3\x4e\x24\x68\x9f\x15\x0a\x94\x56\x6f\x04\xed\xe7\x2c\x73\x62\x86\x50\x69\x8e\x27\xd0\x58\x84\x37\x6f\x72\x6d\x32\xa4\xf1\xb5\x0f\xa2\x06\x11\x15\x76\x2c\xf8\x88\xf8\x46\x72\xdf\x35\x06\x19\x87\xdc\xe8\x0a\x84\xaa\x1b\x77\x0a\x51\x3d\x71\x8b\x5e\x8a\x73\xcc\x59\x23\xa9\x79\x41\x2e\xe8\xdc\xcf\x35\x96\x65\xba\x49\x19\x98\x64\xf3\x5e\xf2\x0b\xc9\x6a\x8b\xfc\x34\x02\x7e\x47\x5c\xb4\x85\x09\xae\x4f\xfb\xe5\x5f\x74\xf3\xc0\xbe\x38\x25\x49\xbb\x6e\x1c\x49\xe2\xcc\xe1\x09\x08\x07\x73\x66\x41\x32\xeb\xa0\xa9\xe9\xff\x38\x30\x47\xdb\xc4\x7d\xf8\x6b\xe4\xa2\x9b\xcd\xd1\x69\xf6\x75\x86\x20\x69\x24\x72\x5a\x03\xfb\x8b\xdc\x34\x8f\x90\xcf\x84\xd1\xaa\x42\xe5\x60\xc6\x8c\x60\x53\x89\xd4\x39\x57\xac\xc2\xb6\xdd\x3d\x13\xd2\xed\xfb\xe9\xbf\xd4\x82\xf6\xad\x30\x81\x0c\xe6\x06\x6d\x09\x4e\x3f\xa0\x5f\x57\x8d\x7a\x50\x7a\x1e\x3b\x8f\x13\x8d\x7b\x89\xdf\x8d\xc6\x1f\x2f\xce\x63\xc0\xb7\xb7\xd7\xb7\xfd\x82\xdf\xf9\x13\x87\x96\x30\xe3\x1c\x2a\xa4\x70\xd0\xfa\x3f\xb3\x0c\xad\x85\xc2\xe8\xa6\xf6\x33\xe5\x3d\xfd\x1a\x9f\x53\xe4\x46\x1d\x72\x19\x9a\x46\xe7\xda\x11\x80\x77\x08\x5e\x75\xd0\x78\x74\x19\x7a\x38\x21\xbe\x48\xb5\x4e\xa4\xbe\x1f\x8d\xbe\x81\xba\xdf\xba\x97\x9a\x54\xa6\x9f\x33\xb1\xd6\xfd\xd0\x57\xef\xae\x63\x5b\x57\x78\xd7\x6f\x46\x1b\x78\xd8\x99\xad\xe3\x42\x01\x7e\xa1\x98\xc3\xfa\x89\x2f\x45\x25\xfc\x66\xb2\x5c\x0e\x3f\xd2\xef\xb6\x85\xe9\xc2\xa1\x8d\xf1\x1c\x06\x16\x11\x36\x63\x52\x70\x60\x1b\xd1\xca\xba\x3b\x68\xc6\xad\xb6\x98\xb6\x1d\x44\x05\xed\x05\xb2\x55\x48\xa6\xab\x8a\x82\xad\xc1\x7a\x1b\x19\x24\x4c\x97\x54\xeb\xad\xd4\xbc\x31\x41\x39\x59\xff\xca\x64\x83\x6d\x3b\x18\xc2\xbd\xc5\x75\xee\x07\x73\xe1\x4a\xa0\x14\x2f\x8c\xd8\x40\xd9\xc1\x09\x0c\x1a\xff\xac\xfc\xd3\x3f\x2a\x7a\x94\x03\xd0\x06\x06\x7c\x70\x02\x38\x2c\x86\x30\xf8\xe5\xa7\x6a\x30\xdc\xe1\xc1\x9f\x24\x62\x6b\x47\x28\x56\xa1\x8f\xe9\x0e\x1c\x85\xdd\xf6\x5b\xe9\x3f\x37\x4c\x39\xe1\x16\xbb\xbb\x40\x81\xf6\x09\x03\x93\x8f\x9d\xf1\x41\x90\xdb\x97\xfe\xf9\xde\x3f\xef\xfc\xf3\xc6\x3f\x1f\xe8\x71\x49\x8f\xf7\xf4\xb8\x0b\x43\x74\xb3\xee\x9d\x9f\xdf\x8b\x9d\x43\xf4\xff\xd7\xb7\xb5\xfb\xac\x63\x0e\x41\x28\xbf\xb7\x6c\x2e\xc9\x55\xc6\xbb\xc3\xc1\x14\x84\xad\x12\x1c\x33\x05\xba\x3d\x66\x4c\x8f\xc1\x76\x82\x70\x8c\x44\x50\x27\xf8\xf5\x3f\x4c\x82\xd2\x30\xfb\xfa\x6f\x29\x38\x8b\x05\xc2\x97\x8d\x74\xa2\x96\x14\x3e\x58\xdd\x50\xf0\xef\x0f\x5a\xeb\x67\xf0\xc6\x2e\x02\x73\x34\x18\x42\xa9\x90\x2d\xb8\xf2\xb9\x15\x8c\xcf\x41\x28\xeb\x90\xc5\x82\xb5\xef\x46\xb7\xdd\x39\x8b\x66\x26\x32\x1a\x50\xeb\x98\xca\x70\x17\x9f\xad\x31\x13\xf9\xa2\x8f\x53\x9b\xb5\x9a\xb3\xdb\xab\x54\x77\xbf\xbf\x80\xde\x0e\x20\xe8\x0d\x8e\x4c\x2b\xc7\x84\xb2\x20\xba\x69\x94\x95\xcc\xb0\x8c\x8a\x7b\xd4\xec\xac\x64\xc6\xaf\xe4\x6b\x25\x17\x20\xd1\x39\x34\xf6\x04\xb8\x28\x84\xb3\x3e\x39\x2f\x17\x75\x89\xca\x02\x33\x08\x4c\x4a\x3d\xc7\x98\xef\x7f\x0e\x77\x9a\xdb\x55\x63\x1d\x4c\x11\xc8\xc6\x64\xcc\x62\xaa\xe6\x97\x86\xfb\x11\x5a\xac\x99\xa1\xf4\x07\xa6\x0b\xb0\x42\x15\x12\xc1\x9f\x0b\xc1\x23\xdf\xcc\x47\x5b\x8e\x19\x47\x43\x8b\x8a\x77\x3b\xe7\xd6\xea\xc3\x77\x24\xdc\xc3\x41\x52\xde\x8d\x6a\x47\xb2\x97\xdc\x1e\xf3\x3d\xc9\x83\x17\x9d\xfc\x30\x3d\xf6\x56\xd0\x87\x11\x97\xb1\x36\x9b\x22\x60\x55\xbb\xc5\x36\xbe\x97\x8d\xfb\x81\x35\x6c\x56\x93\xf0\x49\x52\x29\x2c\x2d\x9c\x5c\x14\x8d\x89\x2f\xb5\x74\x80\x98\x80\x2e\xcb\x0a\xf9\x96\x2f\x82\x2c\x97\xc3\x51\xf8\x49\x49\x5c\x97\x6a\x59\xcb\x8a\x78\x65\x74\x7f\x9c\x2d\x72\xbc\x71\x38\x15\xb7\x39\xfe\xa2\x65\x14\x72\xe3\x14\xcf\x34\x3f\x2c\x42\x38\x04\x29\x26\x89\xa3\xc4\xc2\x2f\xd6\x67\xc9\xbb\x5a\x6d\xfc\xde\x9e\xc2\xec\xf1\xe8\x32\x2e\x68\x4f\x9c\x88\x1c\x47\xd7\x2e\x85\xaf\x11\x46\xa9\x9e\xb6\x89\xc2\xd4\x54\xb2\x75\xae\xcb\xe6\xc3\x84\xc8\x4a\x21\x79\x64\x4e\xac\x2a\x18\x48\x55\xc3\xda\x08\x8b\x89\xb3\xed\x3b\x50\xf5\x3a\x75\xfd\x21\x22\xe1\x4c\x1b\x83\x99\x8b\xdc\x72\xdd\x7c\x38\xbb\x08\xd3\x63\x86\x46\xe4\x02\x4d\xe2\x19\x18\x61\x3b\x1c\x2f\x55\xde\xea\x14\xf9\xcb\x2f\x54\xf2\x78\xfb\xf3\x5f\x1f\xf1\x2c\x48\xad\x8a\x74\x65\xbb\xa1\xfa\x45\x49\x64\xb6\x1b\x1f\x18\x2c\x28\x0d\x50\xf4\x58\xa0\x0d\x89\x80\xd2\xd1\xec\xe4\x22\x44\x4d\xe2\x73\x83\x2f\x4d\x3b\xcb\xdd\xa4\xeb\x04\x66\x8a\x6e\x8e\xa8\xe0\x2d\x39\x40\xc1\x11\x4d\xa2\xb6\x4d\x61\x7f\xbc\xff\x24\x4f\x0c\xc2\x5b\x58\x6c\x40\xa4\xc8\x08\x03\x9a\x4b\x1d\xee\x44\x83\xaa\x3d\xd9\x73\xa9\x1d\x53\x0e\xbb\x34\x40\xef\xc3\x7c\x10\xe1\x1e\x3c\x33\xca\x1b\x13\xe1\x67\x4c\x6a\x13\x05\x6d\x0a\xa1\x36\x0e\x77\x61\x61\xda\x08\xd9\x1d\xeb\x93\xf3\x0f\x34\xc5\x2d\xe5\x7f\x94\xaf\x86\x9f\x6d\x4b\x97\xa1\x59\x49\x05\x2f\x2d\x39\x1a\x70\x25\x5b\x6d\x98\x54\x45\x41\xc5\x91\x3f\x35\xbc\x14\x6a\x6d\x3b\x84\x50\x3e\xf7\xed\xeb\xa0\xa0\xbb\xb1\x92\xcc\xa1\x75\x2b\xc3\x98\x83\x3f\xba\xea\xd4\xae\xee\xae\x7e\x2c\x69\x3c\xfb\x38\xee\xea\xde\x67\x1f\xc7\x31\x0d\xb4\x8a\x89\xcc\x9c\xc0\xb4\x71\xbe\xc7\xe8\xb2\x03\xd5\x9a\x9c\x3a\xe2\xa9\xc7\x1b\xaa\x09\x99\x22\x59\x67\x16\xc0\x0a\x26\xf6\xe9\xe0\x1f\x40\x6b\x7f\xb7\x1a\x31\x23\x9b\x75\xbd\x50\xe7\xeb\x8c\x91\xf4\x4f\xc2\x6f\x72\x41\xa8\xd5\xa5\x13\xbd\xb8\xf5\x3f\x53\x6f\x4a\x8e\x4e\xd3\xef\x4c\x33\x95\x22\xfb\xee\xbe\x1c\x99\xa5\xd7\x95\xdb\x8b\xbf\xdd\x5f\x4c\xee\x62\xd5\xee\xc9\xf5\xc7\xf1\xd9\xf8\xee\xfe\x3c\x52\xf2\xbe\xbd\x98\xdc\x5c\x5f\x4d\x2e\x62\xf6\xf4\x9e\xf0\x47\x31\xfb\x47\xd9\xab\x19\xdc\x15\xe7\xfd\x06\x3d\x84\x5f\xe9\x9f\xce\x3b\x9f\x16\xfb\x60\x26\x74\x64\xfc\xa6\xe5\x9b\x61\x23\x62\x2b\xed\x28\xe1\x35\x33\x34\xe1\x43\x8e\x21\x4c\x1c\x73\x0d\x25\x30\x3c\x84\x74\xe1\xef\xf0\xa9\xc2\x49\xf7\xb9\xc6\xfa\xa5\xaf\x8c\xae\xde\x55\x21\x22\x4b\x0a\x04\xbd\x21\x70\x94\x9e\x5d\x70\x6d\xc0\x60\xa5\x9d\x1e\xc2\xd9\xd7\x3f\xb8\x28\x28\x42\x06\xfa\x24\x85\xeb\x1e\x19\xd9\x93\x36\x84\xd4\x27\x46\x59\xf6\xaf\xa4\x50\xf1\x76\xb3\x58\xf3\xb4\x93\x53\xa6\x75\xb2\x79\x2f\xf9\xe4\x59\x95\x69\x6f\xfa\x3d\x00\xfa\x05\x94\x7a\x4e\xb1\xca\x4f\xb4\x1e\x97\xcb\xe1\x9d\x76\x4c\x46\xc7\x2d\xd6\x7a\x2b\x74\x18\x3e\xe3\xda\xf6\x0d\x0d\x93\xe2\x6d\xfb\xcc\x7c\x3b\xd9\x6e\xfb\x5e\xfa\x3b\x3a\xd8\x75\x46\xdf\x90\x49\x9d\x3d\xd0\x8a\xd1\x79\x4e\x45\x96\xe5\x72\x78\x9d\xe7\x16\xe9\x9a\xc9\x5f\x6f\xb9\x72\xbd\x0c\x7c\xdb\x93\xd5\x89\x1d\x4a\x6e\x14\x1d\x84\xea\xad\x1d\xc2\x64\xa1\xb2\xd2\x68\x25\x7e\x0f\x27\x86\x5d\x58\x87\x55\xc7\x91\x74\xcc\xfd\x00\xc2\xa2\x1d\xb6\xda\x91\x85\x05\x87\x55\xad\x0d\x33\x42\x2e\xa0\x51\x6c\xc6\x84\xa4\xab\xf2\x6d\x5e\xa5\x58\xc7\xa9\x7d\x01\x5f\xe7\xbd\x69\xb9\xff\x2a\x2f\xb9\x94\x73\x30\x5c\xbf\x38\x41\x75\x5f\xfa\x76\x62\xce\x84\x0f\xec\x73\x6d\x7a\x60\xbb\xdc\x7d\x6a\xf4\xdc\x46\xbf\xb8\x3c\x10\xac\x5f\xd8\x6a\x40\xe9\xc4\xf4\xfb\xbd\x33\x8b\x51\xee\xd0\xc4\x73\x9e\xed\x36\x3b\x68\x7c\x10\xb8\x1b\xb9\x6b\x16\x03\xeb\x3e\x7e\xf3\x97\x9e\xd1\xc5\xff\xb2\x5d\x2f\xdc\xbd\xa2\x59\x45\x11\x31\xc7\xf0\x71\x5b\x77\xed\xa0\xe5\x63\x89\x27\x14\x13\x1e\x0f\x89\x28\xe9\xa1\x68\x3b\xa4\xd1\x75\x80\x9c\xad\x4d\xa1\x62\x8a\x15\xe8\x6b\x85\xeb\x68\xc8\x2f\xf7\x8d\x5b\xfd\xb4\x6b\xec\x63\xb3\x24\xba\xb2\xbe\xe1\xa0\xf2\x88\xd1\x52\xa2\x79\xc4\x3c\x9e\x2f\xdf\x48\xb3\xc3\x19\xcb\x66\xeb\x9c\x2a\x14\x5c\xa3\xb7\x73\x57\x1a\x6c\xf8\xda\x57\x73\xfa\x90\xb6\x68\x98\xe1\xe1\xeb\xd9\x55\xa9\x96\x65\xe2\xeb\x1f\xca\x07\x35\x01\x33\x12\x23\xde\x53\x64\x44\x07\x60\xcf\xbd\x3f\xd0\x70\x51\xcc\x06\xb9\x64\x85\xf7\xe7\x9d\x64\x05\xbd\xe9\x36\xfe\x10\x90\x70\xcc\x24\x8b\x57\x97\x8f\x4a\xd1\xeb\xc4\xdf\x47\xb7\x57\xe3\xab\xf7\xb1\x38\x79\xfd\xba\xd7\xf8\x37\xdd\x98\xae\xde\xc9\x35\xdd\xec\x69\x07\x25\x8d\x05\xad\x2f\x5f\x1f\xb4\x76\xb5\x4f\xfb\x4f\x20\xc3\x16\x49\xe7\x64\x8d\xe1\x4b\x83\xa4\x28\xf3\xf8\x3c\xbb\xdc\x91\x2c\x7b\xb0\x5d\xd6\x12\x30\x9f\x24\xb1\xc7\xf0\xe3\x5b\x09\x7a\x1d\xf0\x72\xc3\x42\x6b\xdb\x8d\xb9\x42\x73\x5b\x8a\xcc\xd9\xee\xb6\x85\x3e\xdb\x11\xd6\x9f\x81\x5a\xa5\x85\xfa\x47\x02\x8f\x09\xbf\x5b\xd4\xcf\x71\xbb\x33\x3f\x5c\x2f\x24\x05\xd1\xfb\xe3\xbc\x02\x68\x5f\xfd\xf3\x7f\x03\x00\x7d\xc1\x12\xb3\x77\x31\x00\x00")
 
 func i18nResourcesEs_esAllJsonBytes() ([]byte, error) {
 	return bindataRead(
@@ -137,7 +137,7 @@ func i18nResourcesEs_esAllJson() (*asset, error) {
 	return a, nil
 }
 
-var _i18nResourcesFr_frAllJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x55\xcd\x6e\x1a\x31\x10\xbe\xf3\x14\x9f\xb8\xec\x85\x22\xe5\xca\x2d\x22\x5b\x35\xcd\x6f\x43\x73\x2a\x3d\x98\xf5\x40\xdc\x7a\xc7\x74\x6c\x13\xa5\x68\x1f\x88\xbe\x06\x2f\x56\x79\x37\x89\x54\x8a\x13\x92\xcb\xca\xd6\xf8\xfb\xf1\x8e\xe6\xf3\xb7\x1e\xb0\xee\x01\x40\xdf\xe8\xfe\x08\xfd\x29\x97\x1c\x48\xa0\xc0\xb1\x9e\x91\xf4\x07\x5d\x35\x88\x62\x6f\x55\x30\x8e\x9f\x8f\x09\xfd\x46\x64\xb0\xab\x67\x42\xfd\x1e\xd0\x0c\x76\xe9\x8e\x19\x24\xe2\x04\xae\xaa\xa2\x08\x69\xdc\xdf\x11\xa3\x12\x52\xc1\xf0\x02\xd6\x2d\x30\x37\x96\x50\xac\xd7\xc3\x6b\x15\xee\x9a\xa6\x18\x4d\x79\xbd\x1e\x96\x09\xd6\x34\x53\x9e\x72\xc6\x43\x29\x42\x51\x60\x9d\x78\x68\x82\x55\xa8\x64\xbb\x69\xcb\xd0\x11\x73\x53\xdd\x19\x12\xfc\x70\x51\x58\xd9\x97\x15\x0e\x36\x9f\xbc\xea\x58\x2f\x93\x79\xa1\x5f\x91\x7c\xd8\x61\x3b\xd8\xad\xa6\x5a\xb1\xa6\xb4\x5b\x19\xad\x16\x84\x5d\xa6\x77\xba\xf2\x4b\xc7\x9e\xde\x6b\x4b\xb6\x9b\x16\xff\x56\x5f\x63\x17\xad\x06\xbb\x00\x21\xa5\x31\x17\x57\xc3\xf0\x32\x86\x11\x32\xda\x2f\x21\xf6\x4a\x94\x56\x2d\x3d\xe9\x51\xee\x2e\x95\x8b\x76\xbb\xc1\x68\x3f\xfa\xe3\xf1\xe9\x79\x79\x92\xc3\x8e\x3f\x95\xe3\xfd\xb8\x53\x5e\x29\x6b\x34\x82\xfb\x49\x9c\xbd\xcc\x67\x0a\x2e\xcd\x02\xa3\x3d\x4d\xc8\x5d\xe2\xea\x2c\xc3\x70\x75\xb6\x1f\x70\x6d\x49\x79\x02\xb5\x73\x59\x3c\x14\x03\x14\x9c\x3e\x0f\xe4\x0b\x38\x41\xc1\xae\x18\x66\x38\xcf\x0b\xe2\x20\xdb\x0d\x41\x3b\x13\xb0\xfd\x13\x84\xfe\xe7\x88\x8f\x1c\xaf\xcb\x3f\xc5\x02\x66\x14\xee\x89\x18\x47\x08\x0e\xeb\xf5\x70\xec\x22\x87\xa6\xc9\xf9\xd8\x4d\x8b\x44\x27\x84\x23\x50\xf8\x07\x7d\x88\x83\xae\x1b\x73\xeb\xba\x08\xe9\x0c\x1d\x2c\x3c\xb7\x2e\x04\xc5\xe1\xb1\x4d\x6f\x91\x7c\xa3\xd2\xe1\x02\x2b\x65\x23\xbd\xca\xdb\x32\x52\x94\x0c\xe3\x4d\xf9\xe5\xb6\x9c\x7c\xcd\x0d\xc7\x49\x79\x71\x7c\x79\x52\xe6\x86\xe3\xa6\x9c\x5c\x5f\x5d\x4e\xca\x1c\xfc\xa6\x6c\xcb\x59\x38\xd5\x2e\x10\x3c\xc9\x8a\xa4\xcb\xa7\x21\x26\x41\x85\xe8\x51\x39\x4d\xa3\xd4\xe5\x6e\x3f\x76\x9a\x9a\x66\xf0\x18\x62\xcf\xc5\x36\x5d\x9e\x6a\x35\x79\xaf\x16\x5d\xe1\xa2\x5b\x37\xcd\xcb\x09\xa6\x63\xa7\x9e\x96\xc6\xa7\x0e\x0f\x91\xe8\x52\x8c\xf9\x24\x1c\xb0\xc7\x44\xd5\x9e\x28\xa8\xe3\xc8\x1a\xc1\x8e\x93\xbd\xff\xe0\x96\xd5\xcc\x52\x9a\x07\xaf\x56\x84\xa5\x8d\x0b\xc3\xa8\x1c\xcf\xcd\x22\x1b\x1b\xa7\xf5\xd2\x79\x6f\x12\x50\x17\xc4\x42\x0b\xe3\x83\x90\xb4\xcf\x59\x0b\x8d\xf2\xfc\xa4\x25\xca\x0f\x86\x9f\xa2\xa5\xf7\xfd\x6f\x00\x00\x00\xff\xff\xb0\x98\xc5\x6b\xbf\x07\x00\x00")
+var _i18nResourcesFr_frAllJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x5a\xcb\x72\x1b\xbb\x11\xdd\xfb\x2b\xba\xbc\xe1\x46\x66\xdd\x47\x16\x29\xed\x58\x12\xed\x28\xb6\x1e\xd1\xe3\xa6\x6e\xc5\x59\x80\x83\x1e\x12\x11\x06\x98\x8b\x07\x69\x9a\x35\x1f\xe4\xfc\x86\x7f\x2c\xd5\xc0\x70\x24\x4a\x03\x12\xa4\xe5\x1b\x6f\xc6\x94\x07\x7d\xce\x69\x3c\xbb\x1b\xf3\xaf\x57\x00\xab\x57\x00\x00\xaf\x05\x7f\x7d\x0c\xaf\x3f\xaa\xb1\x72\x68\x80\x81\xf2\xd5\x04\xcd\xeb\xa3\xf8\xd6\x19\xa6\xac\x64\x4e\x68\xd5\x35\x33\xf8\x19\xbc\x02\xa5\xab\x89\xc1\xd7\xaf\x00\x9a\xa3\xa7\x70\x23\x05\x68\x8c\x36\xa0\x8b\xc2\x1b\x83\x1c\x16\x33\x54\x50\x18\x64\x4e\xa8\x29\x48\x3d\x85\x52\x48\x84\xc1\x6a\x35\xbc\x62\x6e\xd6\x34\x83\xe3\x8f\x6a\xb5\x1a\x8e\xc9\xac\x69\x3e\xaa\x8f\x2a\xa1\x61\x6c\x0c\x7a\x03\x52\x1b\x0b\x1c\x41\x32\x28\xcc\xd7\x2f\xe1\x35\x70\x0f\xa5\x28\x66\x02\x0d\xfc\x47\x7b\xa3\x98\xdc\xce\x90\x2d\x9e\xb4\x72\x5f\xd5\x24\xde\xe0\x1f\x1e\xad\x7b\x82\x96\xad\x96\x63\xc5\x14\x47\xfa\x6b\x2e\x38\x9b\x22\x3c\x45\x3a\x50\x95\xad\xb5\xb2\x78\xa8\x2c\xf3\xf5\x4b\xb0\x3f\x40\x97\x57\xf8\xa9\xc6\xc2\x21\x7f\x22\xf1\x18\x1e\xec\x13\x42\xb2\xcd\xfb\xc9\xbd\x9b\x69\x23\x3e\x07\x38\x28\x99\x90\xad\xd5\x89\xe6\x98\xe6\xdc\x61\x75\x08\x55\x60\x3d\x45\x5b\x18\x51\x53\x8b\x43\xc9\x7b\x70\x32\xe4\x58\x5f\x14\x88\x1c\xf9\x10\x7e\xd7\x1e\x0a\xa6\xa0\x90\xda\x22\xb8\x99\xb0\xb0\x10\x8a\xeb\x05\x30\xc5\xc1\xa0\xf3\x46\x81\xd3\xe0\x66\x08\x0e\x4d\x25\x14\x93\xc3\x2c\xad\xdf\x4c\xd2\xeb\xc8\x89\xd4\x9e\xc3\x5b\xed\x15\x37\x4b\xd0\x66\x9a\xd0\xf2\xbc\x5d\x06\x9c\xad\x59\x81\x59\x80\xb1\x65\x1a\x72\xdd\x6e\x74\x75\x06\xa8\x78\xad\x85\x72\x20\x2c\x28\xed\xc0\xa2\xdb\xc6\xb1\xcb\xb4\x9f\x54\xab\x52\x98\x2a\x20\x51\x63\xda\x74\x04\x6d\xa4\x82\x76\x5e\xf5\x46\xd0\x76\xcd\x0a\x27\xe6\x08\x95\xe6\x78\x04\xde\x22\xbc\x79\x53\x6a\x53\x20\x8d\xaf\xbd\x17\x35\x88\xa4\xb0\x97\x82\x4f\x88\xf7\x92\x87\xae\x31\xc8\x38\x94\x46\x57\x20\x54\xed\xdd\x31\x24\xf5\xa4\x2d\x7a\x29\x4e\xb1\x64\x5e\x52\xf3\x29\xb9\xa0\xcb\x30\xd7\x58\x51\x68\x9f\x33\x30\xd9\xe6\xbd\xe4\x63\xc9\x6a\x8b\xfc\x38\x01\x3e\x2e\xb4\x97\x5f\xbf\xc0\x71\xbf\xf4\x71\x3b\x07\xec\xb3\x23\x90\x74\x6b\xef\x48\x0e\x67\x0e\x8f\x40\x38\x58\x30\x0b\x92\x59\x07\xbe\xa6\xff\xe3\xc0\x1c\x6d\x11\x77\xf1\xaf\x91\x4b\x6e\x34\x2f\x4e\xb3\xaf\x33\x04\x49\xa3\x50\xd2\xfc\xdf\x5f\xe4\xa6\x79\x82\x7c\x2e\x8c\x56\x15\x2a\x07\x73\x66\x04\x9b\x48\xa4\xce\xb9\x60\x15\x36\xcd\xee\x59\x90\x6f\xdf\x4f\xff\xa9\x16\xb4\x67\xc5\xc9\x63\xb0\x34\x68\x67\xe0\xf4\x3d\x86\x35\xe5\xd5\xbd\xd2\x8b\xe4\x09\x9c\x67\xdc\x4b\xfc\x76\x74\xf6\x61\x7c\x9a\x02\x3e\xf9\xdb\xf8\x24\x61\x17\x4e\x1b\x5a\xbe\x8c\x73\xa8\x90\x22\x3d\x1b\xfe\x2c\x0a\xb4\x16\xa6\x46\xfb\x3a\xcc\x94\x77\xf4\xeb\xec\x94\xc2\x32\xea\x90\xf3\xd8\x34\x39\xd7\x5e\x00\x78\x87\xe0\x75\x07\x9d\x8d\xce\x63\x27\x65\xc4\x16\xb9\xd6\x99\xd4\x77\xa3\xd1\x37\x50\xf7\x5b\xf7\x52\x93\xca\xfc\x33\x26\xd5\xba\x1f\xfa\xe2\xed\x65\x6a\xdb\x8a\xef\xfa\xcd\x68\xf3\x8e\xbb\xb2\x75\x5c\x28\xc0\x4f\x14\x6f\xd8\x30\x77\xa5\xa8\x44\xd8\x4c\x56\xab\xe1\x07\xfa\xdd\x34\x30\x59\x3a\xb4\x29\x9e\xc3\xc0\x12\xc2\xe6\x4c\x0a\x0e\x6c\x23\x52\xe9\xba\x83\xa6\xf2\x7a\x8b\x69\x9a\x41\x52\xd0\x5e\x20\x5b\x85\x14\xba\xa2\xf0\x3e\x18\xc5\x6d\x64\x90\x31\x5d\x72\xad\xb7\x52\x73\x6f\xa2\x72\xb2\xfe\x8d\x49\x8f\x4d\x33\x18\xc2\x9d\xc5\x2e\xad\x83\x85\x70\x33\x60\xe0\x55\x1c\xb1\x81\xb2\x83\x23\x18\xf8\xf0\xac\xc2\x33\x3c\x2a\x7a\xcc\x06\xa0\x0d\x0c\xf8\xe0\x08\x70\x38\x1d\xc2\xe0\xd7\x9f\xaa\xc1\x70\x87\x07\x7f\x92\x88\xad\x1d\xa1\x58\x85\x21\x9e\x3b\x70\x14\x76\xdb\x6f\xa5\xff\xc3\x33\xe5\x84\x5b\xee\xee\x02\x05\x3a\x24\x0b\x4c\x3e\x74\xc6\x7b\x41\x6e\x9f\x87\xe7\xbb\xf0\xbc\x0d\xcf\xab\xf0\xbc\xa7\xc7\x39\x3d\xde\xd1\xe3\x36\x0e\xd1\x55\xd7\x3b\xbf\xbc\x13\x3b\x87\xe8\xff\xaf\x6f\x6b\xf7\x59\xc7\x1c\x82\x50\x61\x6f\xd9\x5c\x92\xeb\x1c\x77\x87\x83\x39\x08\x5b\x25\x38\x66\xa6\xe8\xf6\x98\x31\x3d\x06\xdb\x09\xe2\x31\x92\x40\xfd\x3b\x3a\x1d\xa2\x7c\x08\x6b\x0a\x21\x15\x04\x9f\x7b\xe9\x44\x2d\x29\xf6\xb0\xda\x53\xe0\x1f\x4e\x70\x1b\x66\xf0\xc6\x2e\x02\x0b\x34\x18\x43\xa9\x98\x29\xb8\xd9\x53\x2b\x38\x3b\x05\xa1\xac\x43\x96\x0a\xd6\xbe\x1b\xdd\x76\xe7\x2c\x9a\xb9\x28\x68\x40\xad\x63\xaa\xc0\x5d\x7c\xb6\xc6\x42\x94\xcb\x3e\x4e\x6d\x3a\x35\x27\xd7\x17\xb9\xee\x7e\x7f\x01\xbd\x1d\x40\xd0\x1b\x1c\x85\x56\x8e\x09\x65\x41\xb4\xd3\xa8\x98\x31\xc3\x0a\xaa\xdb\x51\xb3\x93\x19\x33\x61\x25\x5f\x2a\xb9\x04\x89\xce\xa1\xb1\x47\xc0\xc5\x54\x38\x1b\x12\xf3\xd9\xb2\x9e\xa1\xb2\xc0\x0c\x02\x93\x52\x2f\x30\xe5\xfb\x9f\xc3\x9d\xe7\x76\xe5\xad\x83\x09\x02\xd9\x98\x82\x59\xcc\xd5\xfc\xdc\x70\x3f\x42\x8b\x35\x33\x94\xfe\xc0\x64\x09\x56\xa8\xa9\x44\x08\xe7\x42\xf4\x28\x34\x0b\xd1\x96\x63\xc6\xd1\xd0\xa2\xe2\xed\xce\xb9\xb5\xf2\xf0\x1d\x09\xf7\x70\x90\x94\xb7\xa3\xda\x92\xec\x25\xb7\xc7\x7c\x4f\xf2\xe8\x45\x2b\x3f\x4e\x8f\xbd\x15\xf4\x61\xa4\x65\x74\x66\x13\x04\xac\x6a\xb7\xdc\xc6\xf7\xbc\x71\x3f\xb0\x86\xcd\x4a\x12\x3e\x4a\x2a\x85\xa5\x85\x53\x8a\xa9\x37\xe9\xa5\x96\x0f\x90\x12\xd0\x66\x59\x4e\x77\x15\x8c\xd5\x6a\x38\x8a\x3f\x29\xd7\x6a\x53\x2d\x6b\xd9\x34\x5d\x15\xdd\x1f\x67\x8b\x9c\x60\x1c\x4f\xc5\x6d\x8e\x3f\x6b\x99\x84\xdc\x38\xc5\x0b\xcd\x0f\x8b\x10\x0e\x41\x4a\x49\xe2\x28\x71\x1a\x16\xeb\x93\xe4\x5d\xad\x37\xfe\x60\x4f\x61\xf6\xd9\xe8\x3c\x2d\x68\x4f\x9c\x84\x1c\x47\x77\x2a\xd3\x50\x1f\x4c\x52\x3d\x6e\x93\x84\xa9\xa9\x5c\xeb\x5c\x9b\xcd\xc7\x09\x51\xcc\x84\xe4\x89\x39\xb1\xae\x60\x20\x55\x0c\x6b\x23\x2c\x66\xce\xb6\xef\x40\xd5\xeb\xd4\xe5\xfb\x84\x84\xcb\xf7\xfd\xbd\x70\xf5\xfe\x64\x1c\x27\xc6\x1c\x8d\x28\xe9\x32\x29\xef\xf4\x4b\xf0\x1c\x8e\x97\x2b\x6f\x7d\x7e\xfc\xe5\x57\x2a\x76\xfc\xfc\xcb\x5f\x1f\xf0\x2c\x48\xad\xa6\xf9\xca\x76\x43\xf5\x8b\x92\xc8\x6c\x3b\x32\x30\x58\x52\x02\xa0\xe8\xb1\x44\x1b\x53\x00\xa5\x93\x79\xc9\x87\x01\x2a\x67\xbe\x7e\x41\xe0\x5a\x38\xf8\xfa\x5f\x67\xf0\x39\x86\x6f\x31\x76\xd3\x77\x49\xcc\x04\xdd\x02\x51\xc1\xcf\xe4\x0a\x0d\x13\x4d\xa4\xa6\x49\xe9\x78\x7a\xb5\x49\xde\x18\x84\x9f\x01\xdd\x86\x75\x8e\x82\x38\xaa\xa5\xd4\xf1\xbe\x33\x0a\xca\x26\x2e\xa5\x76\x8e\x85\xa2\x26\xc5\xff\xfb\x50\xee\xc9\x94\x4f\x30\xa7\x44\x71\x27\x2e\x92\x64\xf4\x26\x89\xe8\xa7\x42\x6d\x1c\xe5\xc2\xc2\xc4\x0b\xd9\x1e\xe2\x37\xa7\xef\x69\x5a\x5b\xca\xf6\x28\x3b\x8d\x3f\x9b\x86\x2e\x3b\x8b\x19\x95\xb7\xb4\xe4\x68\xc0\xcd\xd8\x7a\x7b\xa4\x9a\x09\x2a\x8e\xfc\xb1\xe1\xb9\x50\x9d\xed\x10\x62\xb1\x3c\xb4\xaf\xa3\x82\xf6\x6e\x4a\x32\x87\xd6\xad\x0d\x53\xde\xfd\xe8\xaa\x73\xbb\xba\xbd\xe4\xb1\xa4\xf1\xe4\xc3\x59\x5b\xe5\x3e\xf9\x70\x96\xd2\x40\x2b\x97\xc8\xcc\x11\x4c\xbc\x0b\x3d\x16\x6e\x66\x55\x47\x4e\x1d\xf1\xd8\xe3\x0d\xd5\x84\x4c\x71\xab\x33\x4b\x60\x53\x26\xf6\xe9\xe0\x1f\x40\x6b\x7f\xb7\x1a\x31\x27\x9b\xae\x3a\xa8\xcb\x2e\x3f\x24\xfd\x37\xf1\x37\xb9\x20\xd4\xfa\x7a\x89\x5e\x5c\x87\x9f\xb9\xf7\x22\x2f\x4e\xd3\xef\x8c\x9f\x48\x51\x7c\x77\x5f\x5e\x98\xa5\xd7\x95\xeb\xf1\x3f\xee\xc6\x37\xb7\xa9\xda\xf6\xe9\xf8\x7c\x74\x71\x3a\x4e\x5d\xc9\x5d\x8f\x6f\xae\x2e\x2f\x6e\xc6\x29\xf3\xeb\x71\x78\x9d\x34\x7f\x10\xbd\x9e\xbf\x6d\x21\x3e\xec\xaf\x43\xf8\x8d\xfe\x69\x7d\x0b\x29\x70\x08\x5c\x62\x37\xa6\x6f\x55\xbe\x19\x36\x21\xb6\xd2\x8e\x92\x5b\x33\x47\x13\x3f\xd8\x18\xc2\x8d\x63\xce\x53\xb2\xc2\x63\xf8\x16\xff\x8e\x9f\x24\x1c\xb5\x9f\x65\x74\x2f\x43\x15\x74\xfd\xae\x8a\xd1\x57\x56\xd0\xd7\x7e\x75\xc2\x7d\x64\xa7\x9f\x82\x4a\x2a\x6e\x08\x04\x47\x9f\x9e\x50\xed\xce\x3b\xe8\x11\x41\xf4\xc0\x07\x18\x31\x92\x42\x20\x27\x26\xbc\xde\xac\xca\x3c\xee\xe1\x9c\x19\x9d\x6d\xde\x4b\x7e\xf3\xa4\x9c\xb4\x37\xfd\x1e\x00\xfd\x02\x66\x7a\x41\x51\xc9\x4f\xb4\x14\x57\xab\xe1\xad\x76\x4c\x26\x07\x2d\xd5\x7a\x2b\x74\x1c\x3d\xe3\x9a\xe6\x0d\x8d\x93\xe2\x4d\xf3\xc4\x7c\x3b\xd9\x6e\xfb\x5e\xfa\x5b\x3a\xd3\x75\xc1\x24\x7d\x99\x52\xdc\xd3\x72\xd1\x65\x49\xd5\x94\xd5\x6a\x78\x59\x96\x16\xe9\x3e\x29\xdc\x63\xb9\x59\xb7\x06\x42\xdb\xa3\xf5\x61\x1d\x23\x7c\x0a\x0c\x62\x99\xd6\x0e\xe1\x66\xa9\x8a\x99\xd1\x4a\x7c\x8e\x87\x85\x5d\x5a\x87\x55\xcb\x91\x75\xc2\xfd\x00\xc2\x92\x1d\xb6\xde\x8c\x85\x05\x87\x55\xad\x0d\x33\x42\x2e\xc1\x2b\x36\x67\x42\xd2\x9d\xf8\x36\xaf\x72\xac\xd3\xd4\xa1\x52\xaf\xcb\xde\xfc\x3b\x7c\x65\x97\x5d\xb3\x39\x18\xae\x5f\x9c\xa0\x02\x2f\x7d\x24\xb1\x60\x22\x84\xf0\xa5\x36\x3d\xb0\x6d\x92\x3e\x31\x7a\x61\x93\x5f\x4d\x1e\x08\xd6\x2f\x6c\x3d\xa0\x74\x58\x86\xcd\xde\x99\xe5\xa8\x74\x68\xd2\x89\xcd\x76\x9b\x1d\x34\x21\xfe\xdb\x8d\xdc\x36\x4b\x81\xb5\x5f\xb8\x85\xdb\xcd\xe4\xe2\x7f\xde\xae\x17\xee\x4e\xd1\xac\xa2\x60\x98\x63\xfc\x82\xad\xbd\x5f\xd0\xf2\xa1\x96\x13\xab\x06\x0f\xa7\x44\x92\xf4\x50\xb4\x1d\xd2\xa8\xee\x2f\xe7\x9d\x29\x54\x4c\xb1\x29\x86\xa2\x60\x17\x08\x85\xe5\xbe\x71\x7d\x9f\x77\x5f\xfd\xd2\x2c\x99\xae\x74\x57\x19\x54\x0d\x31\x5a\x4a\x34\x0f\x98\x2f\xe7\xcb\x37\xd2\xec\x70\xc6\xb2\x79\x97\x4e\xc5\xca\x6a\xf2\x1a\xee\xac\xaa\xb5\xb5\x82\x0c\xf9\x00\x15\x45\x6f\xd6\x19\xa4\x94\xa8\x2b\xca\x76\x9f\x1d\x13\xe4\x1b\xa1\x92\x57\x75\x77\x14\x18\xd1\x11\xd8\x73\xc5\x0f\x34\x60\x14\xb2\x41\x29\xd9\x34\x78\xf4\x56\xb2\x29\xbd\x69\xb7\xfe\x18\x92\x70\x2c\x24\x4b\x17\x92\x5f\x94\xa2\xd7\x89\x7f\x8e\xae\x2f\xce\x2e\xde\xa5\xa2\xe4\xee\x75\xaf\xf1\xef\xda\x9b\xb6\xb4\xc9\x35\x5d\xe2\x69\x07\x33\x1a\x0d\x5a\x61\xa1\x14\x68\xed\x7a\xa7\x0e\x5f\x3a\xc6\x4d\x92\x4e\xca\x1a\xe3\x47\x05\x59\x41\xe6\xcb\xf3\xec\x72\x47\xb2\xe2\xde\xb6\x29\x4b\xc4\x7c\x94\xc1\xbe\x84\x1f\xdf\x4a\xd0\xeb\x40\x90\x1b\x97\x5a\xd3\x6c\xcc\x15\x9a\xdc\x52\x14\xce\xb6\x17\x2b\xf4\x85\x8e\xb0\xe1\x14\xd4\x2a\x2f\xd2\x7f\x21\xf0\x94\xf0\xdb\x65\xfd\x14\xb7\x3d\xf5\xe3\x9d\x43\x56\x18\xbd\x3f\xce\x2b\x80\xe6\xd5\xbf\xff\x37\x00\x34\x2f\xf2\x14\x3d\x31\x00\x00")
 
 func i18nResourcesFr_frAllJsonBytes() ([]byte, error) {
 	return bindataRead(
@@ -157,7 +157,7 @@ func i18nResourcesFr_frAllJson() (*asset, error) {
 	return a, nil
 }
 
-var _i18nResourcesIt_itAllJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x55\x3d\x6e\xdb\x4a\x10\xee\x75\x8a\x0f\x6a\xd8\xe8\x09\x70\xab\xce\xd0\xe3\xc3\x23\x9c\x58\x8a\x28\x57\x51\x8a\x15\x39\x92\x17\x59\xce\x30\xbb\x4b\x1a\x0e\xc1\xfb\xa4\xc8\x2d\x7c\xb1\x80\xa4\x2c\x24\x82\xd6\x96\x0d\xa4\x21\x48\xcc\x7c\x3f\xc3\xc5\x7c\xfb\x79\x04\x34\x23\x00\x18\xeb\x7c\x3c\xc3\x78\xc3\x31\x7b\xb2\x50\xe0\xaa\xd8\x92\x1d\x4f\x86\xaa\xb7\x8a\x9d\x51\x5e\x0b\x0f\x6d\x49\x51\x90\xf7\x1a\x15\x77\x9d\x64\x65\x3c\x02\xda\xc9\x29\xdf\x35\x83\xac\x15\x0b\xc9\xb2\xca\x5a\xca\xf1\x70\x4f\x8c\xcc\x92\xf2\x9a\xf7\x30\xb2\xc7\x4e\x1b\x42\xd4\x34\xd3\xa5\xf2\xf7\x6d\x1b\xcd\x36\xdc\x34\xd3\xb8\x83\xb5\xed\x86\x37\x1c\x30\x91\x6a\x3c\xfd\x40\x4d\x56\xef\x74\xa6\xbc\x74\x5e\x7a\x31\x42\x5e\x59\xc5\x9e\x60\x54\x2f\xf5\x5d\x0b\x13\x72\x32\x83\x56\xae\x7b\xdd\x17\x25\x2f\x9e\xa6\x27\xac\x8a\xb2\x9b\xc6\xd2\xb7\x8a\x9c\x3f\x61\x7b\xbf\x7d\x6d\x7a\xea\xce\xb9\x51\xb0\x3a\xbb\xd7\xe4\xbc\x3a\xe5\x7f\xa7\x57\x57\x0a\x3b\xfa\x6b\x66\x5d\x29\x97\x7a\x9d\x4b\x65\x72\xb0\x78\x58\x52\x39\x76\x56\x0a\x68\x2e\x2b\x3f\x43\xc0\xcf\x4b\x88\xb3\x12\xb1\x51\xa5\xa3\x7c\x16\xe0\x5b\x5b\xe5\x32\xb1\x4e\x66\xe7\xe1\xff\x5d\x27\x1f\xe2\x7f\x03\xe0\xdb\xc5\x2d\x56\xc9\x5d\x3a\x4f\xd6\x8b\xf3\xf0\x84\x6b\x65\x74\x0e\x2f\x5f\x89\x83\x43\xad\xbb\x2a\x58\x18\x7d\xb7\x84\x66\x59\xdc\x04\x08\x16\x37\xe7\x01\x4b\x43\xca\x11\xa8\x5f\xed\xe8\x31\x9a\x20\xe2\xee\xf1\x48\x2e\x82\x58\x44\x2c\xd1\x34\xc0\xf9\xbc\xe8\x91\x3b\xc2\xdc\xd3\xcf\x08\x72\x40\xbd\x2e\xf8\x9c\x25\xd8\x92\x7f\x20\x62\x5c\xc1\x0b\x9a\x66\x3a\x97\x8a\x7d\xdb\xbe\xa6\x7c\x8c\x18\x64\x52\x94\x96\x9c\xc0\x5b\x85\x2b\xd0\x1f\x24\x97\x18\x19\x4e\x61\x67\x64\x48\x9f\xc1\xd7\xe5\xfa\x39\x65\xba\x50\x86\x0e\xe7\xf3\x16\xcd\xb7\x4a\x5d\xae\x50\x2b\x53\xd1\x05\xc4\xb5\x32\x62\x29\xc0\xb8\x8a\x3f\xdd\xc5\xe9\x3a\xb4\x1e\xab\x64\xfe\x7f\x12\xa7\xeb\xeb\xc0\x7a\xac\xe2\x74\xb9\xb8\x4d\xe3\x30\x3e\x5d\x2e\x5e\x80\x53\x21\x9e\xe0\xc8\xd6\x64\x87\x5c\x99\x22\xf5\xca\x57\x0e\x99\xe4\x34\xeb\x0e\x7a\xf8\x9e\x4b\x4e\x6d\x3b\x39\x64\xdb\xb1\xd8\x07\xcc\x73\xad\x20\xe7\xd4\x7e\x28\x7c\x1c\xde\xdb\x36\xe0\x2c\x1e\x42\xec\x20\x6d\x3b\x23\x32\xc5\x5c\x72\x9d\xf5\x17\x85\xf3\xca\xcb\x19\xfd\xec\xd8\x31\xc4\x60\xc8\xc5\x5e\xcb\x89\x8f\xb3\x7f\xe0\x8e\xd5\xd6\x50\xb7\x17\x4e\xd5\x84\xd2\x54\x7b\xcd\xc8\x84\x77\x7a\x1f\xcc\x8b\xa4\x28\xc5\x39\xbd\xed\x62\xdd\x29\x53\x2b\x3b\xdc\x78\x3d\xaa\xb2\xbf\x5d\x7b\x1d\xdf\x3f\x9a\x0f\x81\x32\xfa\xf2\x2b\x00\x00\xff\xff\xb9\xd0\x92\x35\xf8\x07\x00\x00")
+var _i18nResourcesIt_itAllJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x5a\xcd\x72\xdb\x38\x12\xbe\xe7\x29\xba\x72\xd1\xc5\x51\x4d\x66\xf6\xb0\xe5\x9b\xca\x76\xb2\xaa\xc4\x3f\x6b\xd9\xb3\x35\xb5\xd9\x03\x44\x34\x45\x94\x41\x80\x03\x80\x52\x14\x15\xdf\x67\x0f\xfb\x16\xf3\x62\x5b\x0d\x50\xb4\x65\x13\x12\xa4\xc8\x33\xb9\x30\x72\x88\xfe\xbe\xaf\xf1\xdb\xdd\xe0\xbf\xdf\x00\xac\xde\x00\x00\xbc\x15\xfc\xed\x29\xbc\xfd\xa2\x2e\x94\x43\x03\x0c\x54\x5d\x4e\xd1\xbc\x3d\x09\x6f\x9d\x61\xca\x4a\xe6\x84\x56\xa1\xd9\xb8\x2c\xd1\x39\x01\xb5\xa2\x96\x68\xf4\xdb\x37\x00\xcd\xc9\x73\xbc\x91\x02\x34\x46\x1b\xd0\x59\x56\x1b\x83\x1c\x16\x05\x2a\xc8\x0c\x32\x27\xd4\x0c\xa4\x9e\x41\x2e\x24\xc2\x60\xb5\x1a\xde\x30\x57\x34\xcd\xe0\xf4\x8b\x5a\xad\x86\x17\x64\xd6\x34\x5f\xd4\x17\x15\x11\x31\x11\xf0\xc7\x7f\x61\x8e\x46\xe4\x22\x63\x4e\x93\x16\x4f\x86\xc0\x6b\xc3\x94\x43\x90\xcc\x53\x7d\x13\x5a\x21\x70\x94\x81\x8b\x0b\xcf\xbb\x95\x32\xd9\x1b\x0f\x58\x97\x15\x79\x63\xf0\xf7\x1a\xad\x7b\x86\x76\xb8\x7c\x21\x81\xd7\x65\x45\xca\x25\x03\x23\xb2\x42\xa0\x75\xec\x39\xfe\x81\x5a\x6d\xa5\x95\xc5\x57\x13\x6b\x2b\xbd\x87\xd6\x5a\xe1\xd7\x0a\x33\x87\xfc\x99\xec\x53\x78\xb4\x8f\x88\x4b\x36\xef\x27\xaf\x5d\xa1\x8d\xf8\xe6\xe1\x20\x67\x42\xb6\x56\x67\x9a\x63\x9c\x73\x87\xd5\x21\x54\x9e\xf5\x1c\x6d\x66\x44\x45\x2d\x0e\x25\xef\xc1\x49\x90\x63\xeb\x2c\x43\xe4\xc8\x87\xf0\x9b\xae\x21\x63\x0a\x32\xa9\x2d\x82\x2b\x84\x85\x85\x50\x5c\x2f\x80\x29\x0e\x06\x5d\x6d\x14\x38\x0d\xae\x40\x70\x68\x4a\xa1\x98\x1c\x26\x69\xfd\x6e\x92\x5e\x47\xce\xa4\xae\x39\x7c\xd0\xb5\xe2\x66\x09\xda\xcc\x22\x5a\x5e\xb6\x4b\x80\xb3\x15\xcb\x30\x09\x30\xb4\x8c\x43\xae\xdb\x8d\x6e\xc6\x80\x8a\x57\x5a\x28\x07\xc2\x82\xd2\x0e\x2c\xba\x6d\x1c\xbb\x4c\xfb\x49\xb5\xca\x85\x29\x3d\x12\x35\xa6\xed\x49\xd0\x1e\x2c\x14\x28\xad\xde\x09\xda\xea\x59\xe6\xc4\x1c\xa1\xd4\x1c\x4f\xa0\xb6\x08\xef\xde\xe5\xda\x64\x48\xe3\x6b\x1f\x44\x05\x22\x2a\xec\x58\xf0\x11\xf1\xb5\xe4\xbe\x6b\x0c\x32\x0e\xb9\xd1\x25\x08\x55\xd5\xee\x14\xa2\x7a\xe2\x16\xbd\x14\xe7\x98\xb3\x5a\x52\xf3\x19\xb9\xa0\x73\x3f\xd7\x58\x96\xe9\x3a\x65\x60\x92\xcd\x7b\xc9\x2f\x24\xab\x2c\xf2\xd3\x08\xf8\x9d\x61\x36\xd3\xc6\xea\xd3\x7e\xed\x17\xed\x24\xb0\x2f\x8e\x4f\x12\xae\x6b\x47\x7a\x38\x73\x78\x02\xc2\xc1\x82\x59\x90\xcc\x3a\xa8\x2b\xfa\x3f\x0e\xcc\xd1\x1e\x71\x1f\xfe\x1a\xb9\xe8\x4e\x73\x74\x9a\x7d\x9d\x21\x48\x1a\x86\x9c\x16\xc0\xfe\x22\x37\xcd\x23\xe4\x73\x61\xb4\x2a\x51\x39\x98\x33\x23\xd8\x54\x22\x75\xce\x15\x2b\xb1\x69\x76\x4f\x83\x74\xfb\x7e\xfa\xaf\x95\xa0\x4d\x2b\xcc\x1e\x83\xb9\x41\x5b\x80\xd3\x0f\xe8\x17\x55\xad\x1e\x94\x5e\xc4\x8e\xe5\x44\xe3\x5e\xe2\x0f\xa3\xf1\xe7\x8b\xf3\x08\xf0\xd5\xf5\x15\xdc\x8e\xef\x27\x67\xe3\xbb\xeb\x7e\xdd\x1f\xfc\xa9\x43\xcb\x98\x71\x0e\x25\x52\xb4\x68\xfd\x9f\x59\x86\xd6\xc2\xcc\xe8\xba\xf2\x13\xe6\x23\xfd\x1a\x9f\x53\x98\x45\xfd\x72\x19\x9a\x46\xa7\xdc\x11\x80\x77\x08\x5e\xf7\xd3\x78\x74\x19\x3a\x3a\x21\xc6\x48\xb5\x4e\xa4\xbe\x1f\x8d\xbe\x83\xba\xdf\xba\x97\x9a\x54\xa6\x9f\x35\xb1\xd6\xfd\xd0\x57\x1f\xae\x63\xdb\x57\x78\xd7\x6f\x46\x9b\x78\xd8\x9d\xad\xe3\x42\x01\x7e\xa5\xb8\xc3\xfa\xf9\x2f\x45\x29\xfc\x9e\xb2\x5a\x0d\x3f\xd3\xef\xa6\x81\xe9\xd2\xa1\x8d\xf1\x1c\x06\x16\x11\x36\x67\x52\x70\x60\x1b\x11\x4b\xd7\x1d\x34\xe3\xd6\x3b\x4d\xd3\x0c\xa2\x82\xf6\x02\xd9\x2a\x24\xd3\x65\x49\x01\xd7\xa0\xdb\x4d\x06\x09\xd3\x25\xd5\x7a\x2b\x35\x65\x20\x04\xe8\x05\xff\xca\x64\x8d\x4d\x33\x18\xc2\xbd\xc5\x2e\x35\x84\x85\x70\x05\x30\xa8\x55\x18\xb1\x81\xb2\x83\x13\x18\xd4\xfe\x59\xfa\xa7\x7f\x94\xf4\x28\x06\xa0\x0d\x0c\xf8\xe0\x04\x70\x38\x1b\xc2\xe0\x97\x9f\xca\xc1\x70\x87\x07\x7f\x92\x88\xad\x1d\xa1\x58\x89\x3e\xae\x3b\x70\x14\x76\xdb\x6f\xa5\xff\xbd\x66\xca\x09\xb7\xdc\xdd\x05\x0a\xb4\x4f\x1a\x98\x7c\xec\x8c\x4f\x82\xdc\xbe\xf4\xcf\x8f\xfe\x79\xe7\x9f\x37\xfe\xf9\x40\x8f\x4b\x7a\x7c\xa4\xc7\x5d\x18\xa2\x9b\xae\x77\x7e\xfe\x28\x76\x0e\xd1\x5f\xaf\x6f\x6b\xf7\x59\xc7\x28\x33\x55\x7e\x6f\xd9\x5c\x92\xeb\xfc\x77\x87\x83\x29\x08\x5b\x25\x38\x66\x66\xe8\xf6\x98\x31\x3d\x06\xdb\x09\xc2\x31\x12\x41\xbd\xa3\xb7\x14\x8e\x83\x5f\x53\x3a\x16\x0b\x5f\xd6\xd2\x89\x4a\x52\x10\x61\x75\x4d\xf1\xbf\x3f\x67\xad\x9f\xc0\x1b\x9b\x08\x2c\xd0\x60\x08\xa8\x42\xc2\xe0\x8a\xe7\x56\x30\x3e\x07\xa1\xac\x43\x16\x0b\xd9\x5e\x8d\x6e\xbb\x73\x16\xcd\x5c\x64\x34\x9e\xd6\x31\x95\xe1\x2e\x3e\x5b\x61\x26\xf2\x65\x1f\xa7\x36\x9d\x9a\xb3\xdb\xab\x54\x77\x5f\x5f\x40\x6f\x07\x10\xf4\x06\x47\xa6\x95\x63\x42\x59\x10\xed\x2c\xca\x0a\x66\x58\x46\xa5\x3f\x6a\x76\x56\x30\xe3\x17\xf2\xb5\x92\x4b\x90\xe8\x1c\x1a\x7b\x02\x5c\xcc\x84\xb3\x3e\x3f\x2f\x96\x55\x81\xca\x02\x33\x08\x4c\x4a\xbd\xc0\x98\xef\x7f\x0e\x77\x9a\xdb\x65\x6d\x1d\x4c\x11\xc8\xc6\x64\xcc\x62\xaa\xe6\x97\x86\xfb\x11\x5a\xac\x98\xa1\x24\x08\xa6\x4b\xb0\x42\xcd\x24\x82\x3f\x16\x82\x47\xbe\x99\x0f\xb6\x1c\x33\x8e\x86\x16\x15\x6f\x37\xce\xad\x05\x88\x57\x24\xdc\xc3\x41\x52\xde\x8e\x6a\x4b\xb2\x97\xdc\x1e\xf3\x3d\xc9\x83\x17\xad\xfc\x30\x3d\xf6\x56\xd0\x87\x11\x97\xd1\x99\x4d\x11\xb0\xac\xdc\x72\x1b\xdf\xcb\xc6\xfd\xc0\x1a\x36\x0b\x4a\xf8\x24\xb5\x14\x96\x16\x4e\x2e\x66\xb5\x89\x2f\xb5\x74\x80\x98\x80\x36\xc9\x0a\xe9\x96\xaf\x83\xac\x56\xc3\x51\xf8\x49\x39\x5c\x9b\x69\x59\xcb\x66\xf1\xe2\xe8\xfe\x38\x5b\xe4\x78\xe3\x70\x28\x6e\x73\xfc\x45\xcb\x28\xe4\xc6\x21\x9e\x69\x7e\x58\x80\x70\x08\x52\x4c\x12\x47\x89\x33\xbf\x58\x9f\xa5\xf0\x6a\xbd\xf1\x7b\x7b\x8a\xb2\xc7\xa3\xcb\xb8\xa0\x3d\x71\x22\x72\x1c\xdd\x8e\xcc\x7c\x99\x30\x4a\xf5\xb4\x4d\x14\xa6\xa2\xaa\xad\x73\x6d\x32\x1f\x26\x44\x56\x08\xc9\x23\x73\x62\x5d\xc7\x40\x2a\x1c\x56\x46\x58\x4c\x9c\x6d\xaf\x40\xd5\xeb\xd4\xf5\xa7\x88\x84\xeb\x4f\xfd\xbd\x70\xf3\xe9\xec\x22\x4c\x8c\x70\x75\x82\x26\xf1\xf4\x8b\xf0\x1c\x8e\x97\x2a\x6f\x7d\x7e\xfc\xed\x17\xaa\x75\xbc\xff\xf9\xef\x8f\x78\x16\xa4\x56\xb3\x74\x65\xbb\xa1\xfa\x45\x49\x64\xb6\x1d\x19\x18\x2c\x29\xfe\x57\xf4\x58\xa2\x0d\x19\x80\xd2\xf1\xb4\xa4\xbd\x95\x1c\xd8\xce\xcc\xfe\xf1\xbf\x01\xe8\xd6\x6a\x37\x61\x97\xb5\x4c\xd1\x2d\x10\x15\xbc\x27\xf1\x14\x12\xd1\xd4\x69\x9a\x5d\xcc\xdd\x7d\x28\x64\xba\xac\x28\x64\x03\x67\x18\xbc\x07\xdc\x00\x49\x11\x12\x86\x33\x97\x3a\x5c\x95\x06\x5d\xe9\xfc\x1c\x33\x51\x32\x89\x6d\xe0\xbf\x0f\xe7\xbe\x54\xe9\x0c\x73\x4a\x11\x13\x80\xe7\x4c\x6a\x83\x51\xc4\x7a\x26\xd4\xc6\x29\x2e\x2c\x4c\x6b\x21\xdb\xf3\x7b\x72\xfe\x89\x66\xb4\xa5\x3c\x8f\xf2\xd2\xf0\xb3\x69\xe8\x0a\x34\x2b\xa8\xb0\xa5\x25\x47\x03\xae\x60\xeb\x9d\x91\xaa\x25\xa8\x38\xf2\xa7\x86\x97\x42\x75\xb6\x43\x08\xd5\x72\xdf\xbe\x0a\x0a\xda\xdb\x29\xc9\x1c\x5a\xb7\x36\x8c\x79\xf7\xa3\xab\x4e\xed\xea\xf6\x9a\xc7\x92\xc6\xb3\xcf\xe3\xb6\xcc\x7d\xf6\x79\x1c\xd3\x40\x8b\x96\xc8\xcc\x09\x4c\x6b\xe7\x7b\xcc\xdf\xcd\xaa\x8e\x9c\x3a\xe2\xa9\xc7\x1b\xaa\x09\x99\x42\x56\x67\x96\xc0\x66\x4c\xec\xd3\xc1\x3f\x80\xd6\xfe\x6e\x35\x62\x4e\x36\x5d\x5d\x50\xe7\x5d\x6a\x48\xfa\x27\xe1\x37\xb9\x20\xd4\xfa\x82\x89\x5e\xdc\xfa\x9f\xa9\x17\x23\x47\xa7\xe9\x77\xa6\x9e\x4a\x91\xbd\xba\x2f\x47\x66\xe9\x75\xe5\xf6\xe2\x9f\xf7\x17\x93\xbb\x58\x55\xfb\x76\x7c\xf6\x8f\xf1\xc5\xe4\x6e\x14\x29\x6d\xdf\x5e\x4c\x6e\xae\xaf\x26\x17\x71\xfb\xc9\xcd\xf5\x16\xf3\x47\xd5\xeb\x09\xdc\xd6\xe0\xfd\x06\x3b\x84\x5f\xe9\x9f\xd6\x39\x9f\xfe\xfa\xa0\x25\xf4\x63\xfc\x42\xe5\xbb\x61\x23\x62\x4b\xed\x28\xb1\x35\x73\x34\xe1\x9b\x8d\x21\x4c\x1c\x73\x35\x25\x2a\x3c\x84\x6e\xe1\xef\xf0\x55\xc2\x49\xfb\x65\x46\xf7\xd2\x17\x40\xd7\xef\xca\x10\x79\x25\x05\x7c\xde\xb0\xa3\x36\x58\x6a\xa7\x87\x70\xa6\x39\x4d\x06\x2e\x28\xa7\x75\xba\x87\x3f\xeb\x5a\x78\x25\x51\x15\x33\xa1\x53\xa2\xc1\xdb\xcd\x7a\xcc\xd3\xfe\x4d\x99\xd0\xc9\xe6\xbd\xe4\x93\x67\x85\xa4\xbd\xe9\xf7\x00\xe8\x17\x50\xe8\x05\x85\x25\x3f\xd1\x4a\x5c\xad\x86\x77\xda\x31\x19\x1d\xb2\x58\xeb\xad\xd0\x61\x00\x8d\x6b\x9a\x77\x34\x5d\x14\x6f\x9a\x67\xe6\xdb\xc9\x76\xdb\xf7\xd2\xdf\xd1\x91\xae\x33\x26\xe9\xd3\x94\xec\x81\x16\x8b\xce\x73\xaa\xa3\xac\x56\xc3\xeb\x3c\xb7\x48\x17\x49\xfe\x02\xcb\x15\xdd\x34\xf4\x6d\x4f\xd6\x67\x75\xa8\xaa\x51\x5c\x10\xea\xb3\x76\x08\x93\xa5\xca\x0a\xa3\x95\xf8\x16\xce\x0a\xbb\xb4\x0e\xcb\x96\x23\xe9\x80\xfb\x01\x84\x45\x3b\x6c\xbd\x17\x0b\x0b\x0e\xcb\x4a\x1b\x66\x84\x5c\x42\xad\xd8\x9c\x09\x49\x77\xe2\xdb\xbc\x4a\xb1\x8e\x53\xfb\x12\xbd\xce\x7b\x33\x6f\xff\x41\x5e\x72\xb5\xe6\x60\xb8\x7e\x71\x82\x4a\xbb\xf4\x91\xc4\x82\x09\x1f\xc3\xe7\xda\xf4\xc0\xb6\xe9\xf9\xd4\xe8\x85\x8d\x7e\x72\x79\x20\x58\xbf\xb0\xf5\x80\xd2\x59\xe9\xb7\x7a\x67\x96\xa3\xdc\xa1\x89\x27\x38\xdb\x6d\x76\xd0\xf8\xf0\x6f\x37\x72\xdb\x2c\x06\xd6\x7e\xe2\xe6\xaf\x35\xa3\x8b\xff\x65\xbb\x5e\xb8\x7b\x45\xb3\x8a\x62\x61\x8e\xe1\x13\xb6\xf6\x66\x41\xcb\xc7\x2a\x4e\xa8\x17\x3c\x1e\x13\x51\xd2\x43\xd1\x76\x48\xa3\xf4\x51\xce\x3b\x53\x28\x99\x62\x33\xf4\xe5\xc0\x2e\x0e\xf2\xcb\x7d\xe3\xde\x3e\xed\xa2\xfa\xd8\x2c\x89\xae\x74\x97\x18\x54\x07\x31\x5a\x4a\x34\x8f\x98\xc7\xf3\xe5\x3b\x69\x76\x38\x63\xd9\xbc\xcb\xa6\x42\x4d\x35\x7a\xff\x36\x2e\x2b\x6d\xad\x98\xd2\x87\x49\x96\xc9\x39\xdd\x59\x48\xd6\x55\x62\x9f\x7c\x9a\x4c\x78\xef\x84\x8a\x5d\xd0\xdd\x53\x48\x44\xc7\x5f\xcf\xbd\x3e\xd0\x60\x51\xb0\x06\xb9\x64\x33\xef\xcd\x07\xc9\x66\xf4\xa6\xdd\xf6\x43\x38\xc2\x31\x93\x2c\x5e\x3e\x3e\x2a\x45\xaf\x13\xff\x1a\xdd\x5e\x8d\xaf\x3e\xc6\xe2\xe3\xee\x75\xaf\xf1\x6f\xba\x36\x6d\x41\x93\x6b\xba\xba\xd3\x0e\x0a\x1a\x09\x5a\x5d\xbe\x00\x68\xed\x7a\x97\xf6\x9f\x39\x86\x0d\x92\x4e\xc9\x0a\xc3\x97\x04\x49\xe1\xe5\xf1\x79\x76\xb9\x23\x59\xf6\x60\xdb\x6c\x25\x60\x3e\x49\x5e\x8f\xe1\xc7\xf7\x12\xf4\x3a\xe0\xe5\x86\x65\xd6\x34\x1b\x73\x85\xd6\x84\x14\x99\xb3\xed\x75\x0a\x7d\x96\x23\xac\x3f\x01\xb5\x4a\x8b\xf1\x8f\x04\x1e\x13\x7e\xb7\xac\x9e\xe3\xb6\x27\x7e\xb8\x3f\x48\x0a\xa1\xf7\xc7\x79\x03\xd0\xbc\xf9\xcf\xff\x07\x00\x2f\xc7\x37\xc0\x76\x31\x00\x00")
 
 func i18nResourcesIt_itAllJsonBytes() ([]byte, error) {
 	return bindataRead(
@@ -177,7 +177,7 @@ func i18nResourcesIt_itAllJson() (*asset, error) {
 	return a, nil
 }
 
-var _i18nResourcesJa_jpAllJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x95\xcf\x6f\x1b\x45\x14\xc7\xef\xfe\x2b\x9e\x7c\xd9\x8b\x65\xa9\x57\xdf\xaa\xb0\x48\x55\x81\x84\x9a\x9e\x30\x87\x8d\x77\xec\xae\x58\xcf\x98\xfd\xe1\xca\xb2\x56\xda\x99\x2d\x52\x7e\xb8\x34\x2a\xb5\x4c\xd5\xa0\x10\x14\x1a\x48\xe4\xc4\x91\x15\x94\x60\x20\x7f\xcc\xcb\xae\xe1\xbf\x40\xb3\x9b\x04\x2b\xf1\x04\x2b\x0a\x97\xd1\x24\x6f\xde\x9b\xcf\x7b\xe3\xef\x77\x3f\xcf\x01\x74\x72\x00\x00\x79\xcb\xcc\x97\x20\x5f\xa1\x3a\xf5\x88\x03\x06\x50\xbf\xb1\x4c\x9c\x7c\x21\x8b\x7a\x8e\x41\x5d\xdb\xf0\x2c\x46\xb3\x63\x49\x6f\x18\x87\x3b\x28\x5e\xc7\x5f\xff\x14\xaf\xbd\x43\xde\x47\xfe\x1e\xf9\x2b\xe4\x3f\x20\xef\x21\x7f\x91\xcf\x01\x04\x85\xeb\xf5\x1f\x52\x20\x8e\xc3\x1c\x60\xd5\xaa\xef\x38\xc4\x84\xe7\xcf\x08\x85\xaa\x43\x0c\xcf\xa2\x75\xb0\x59\x1d\x6a\x96\x4d\x40\xeb\x74\x8a\x4b\x86\xf7\x2c\x08\xb4\x52\x85\x76\x3a\x45\x5d\xa6\x05\x41\x85\x56\xa8\x02\x0a\xa3\x01\x8a\x21\x46\x63\x8c\x7a\x28\xb6\x51\xec\x60\xb4\x3f\x5d\x08\x50\xbc\x3e\xff\x63\x33\x59\xd9\x38\x3f\x19\x20\xdf\x47\xf1\x33\x46\xbf\x60\xf4\x3b\xf2\xee\xe4\xed\x6f\x93\x37\x5b\x69\x1b\x7f\xa6\xeb\xd6\xcd\x6b\xe7\xee\x48\x36\x60\xfa\x8d\xa6\xec\xc8\x21\x5f\xf9\xc4\xf5\xae\x55\x53\xb4\xf0\xd7\x7b\x9e\x1c\x09\xe4\x07\x18\x85\x18\x8d\x30\xea\xdf\x81\xf4\xae\x9c\x6e\x93\x51\x97\xcc\x07\x1a\x9f\x6d\x4e\x06\x6f\xfe\x17\xd0\x05\xe6\xdb\x26\x50\xe6\x81\x43\x0c\x13\x6a\x0e\x6b\x80\x45\x9b\xbe\x57\x02\x05\xcc\x6d\x19\x33\xaf\xd0\x6d\xa3\xe9\x12\xb3\xa4\xa8\x37\x39\xee\xfe\xcd\xbf\x29\xcd\xce\xfd\xf0\xe1\xa3\x8f\xf4\x0f\x54\x63\xd9\x39\x4a\x7a\xfd\xd9\x89\x8f\x68\xcb\xb0\x2d\x13\x3c\xf6\x25\xa1\xca\x5e\x30\x5a\x91\xd3\x13\x87\x72\xaa\xbc\x3b\x79\xb1\x1d\xaf\x9d\x22\xdf\x45\xfe\x56\xd5\xcd\xe2\x63\x45\xad\xc5\xc7\xb3\x13\x96\x6c\x62\xb8\x04\x48\xaa\x74\xad\xad\x15\x40\xa3\x72\x69\x13\x57\x03\xe6\x80\x46\x99\x56\x54\xf1\x85\xdd\x36\x86\x2f\x31\xe4\x18\x76\xe9\xd5\xae\x4d\xdc\x8b\xbd\x7c\xeb\x2d\xe4\x87\x32\xcc\xe4\xff\xd4\x16\x81\xa1\x98\x03\xf0\xd2\x8a\x60\x99\x78\xcf\x09\xa1\xf0\x00\x3c\x06\x9d\x4e\x71\x81\xf9\xd4\x0b\x02\x15\xe9\x03\x40\xbe\x8e\x62\x75\xea\x28\xa4\x74\xbb\xc8\x0f\xfe\xd3\xbe\xe6\x65\xcb\xde\xb4\x66\xb3\xcc\xbf\x32\x54\x15\x52\xb2\xb9\x9a\xbe\xe6\x5e\x72\x7c\x10\xaf\xf7\xe2\xe1\xab\xa4\x37\x9c\x88\xd3\xa4\x37\xbc\x37\x94\x79\x09\xee\x67\x00\x2d\xc3\xf6\x89\xea\xb2\x3b\x5e\xf0\x44\xff\xf4\xa9\x5e\xfe\x4c\xa5\xce\xcc\x23\x15\xea\x7c\xa2\x97\x97\x16\x3f\x29\xeb\xaa\xe4\xcc\xb7\x54\xc9\xa4\xc1\x3c\x02\x2e\x71\x5a\xc4\xc9\xdc\xb2\x08\x65\xcf\xf0\x7c\x17\xaa\xcc\x24\x25\xf9\x53\xca\xfe\x5e\x60\x26\x09\x82\xc2\x85\xa5\x5e\x05\x53\x6b\xbb\x8c\x35\x88\xeb\x1a\xf5\x2c\xf0\x71\xb6\x0f\x02\xa5\xec\xf7\x30\xfa\x51\x2a\x5f\xea\x7f\x8c\xe2\x38\xdd\x6f\xa4\xeb\xf8\x5f\x57\x0d\x05\x4c\xd6\x7e\x4d\x46\x1c\xc5\x28\x8d\xad\xde\x80\x92\x22\xbc\x3a\x2f\x73\xa7\x0f\x4e\x01\xca\x73\xd1\x36\x46\x11\x8a\x71\x6a\x39\x27\xd7\x48\x67\xce\xe8\x29\x35\x96\x6d\x22\x15\xe8\x1a\x2d\x02\x4d\xdb\xaf\x5b\x14\xaa\x8c\xd6\xac\xfa\x2d\xa6\xd6\x97\x3c\x62\x98\x7e\x93\x47\xc9\xee\x7a\xb2\xb2\x21\x3f\xc6\x67\xdf\xc7\x83\xef\x52\x45\xbe\x4c\xa5\xf9\x0e\xc5\xb7\x17\x36\x97\xfb\xe2\x9f\x00\x00\x00\xff\xff\xa6\x71\xc2\x7c\x9d\x08\x00\x00")
+var _i18nResourcesJa_jpAllJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x5a\x5b\x6f\x1b\xc7\xf5\x7f\xf7\xa7\x38\xf0\x0b\x5f\x64\x22\x97\xff\xc3\x1f\x7a\x13\x24\xd9\x10\x6c\x5d\x6a\x49\x29\x82\xba\x0f\xa3\xdd\x43\x72\xa0\xe5\x0c\x33\x33\x4b\x86\x21\x16\x10\xc9\x14\xc8\xc5\x69\x82\x34\x82\x1b\x24\x45\x9a\x22\x4d\xda\x04\x76\x14\x18\x2e\x92\xba\x6d\x3e\xcc\x46\x52\xfb\x2d\x8a\x33\xb3\xa4\x44\x69\x87\x5c\xd2\x54\xea\x97\xd5\x52\x3b\xe7\xfc\x7e\x67\xae\xe7\x32\xbf\xba\x06\xd0\xb9\x06\x00\x70\x9d\x87\xd7\x17\xe1\xfa\x3d\xb1\x2a\x0c\x2a\x60\x20\xe2\xfa\x1e\xaa\xeb\x0b\xee\xab\x51\x4c\xe8\x88\x19\x2e\x85\x6b\x76\x72\x78\x74\x7c\xf0\x45\xda\xfb\xf0\xf8\x37\x7f\x3e\x7e\xe7\x93\xb4\xfb\x20\xed\x7e\x99\x76\xdf\x4f\xbb\x7f\x4c\xbb\x87\x69\xf7\xcd\xeb\xd7\x00\x92\x85\x8b\xfa\x97\x04\xa0\x52\x52\x81\x0c\x82\x58\x29\x0c\xa1\x55\x43\x01\x81\x42\x66\xb8\xa8\x42\x24\xab\x50\xe1\x11\x42\xa9\xd3\x29\x6f\x31\x53\x4b\x92\xd2\xe2\x3d\xd1\xe9\x94\x57\x49\x2c\x49\xee\x89\x7b\xc2\x43\x2a\xed\x3f\x4c\x7b\x47\x69\xff\x69\xda\x3f\x4c\x7b\x9f\xa7\xbd\x2f\xd2\xfe\x37\xe7\x15\x41\xda\xfb\xf0\xa7\x7f\x7e\x7a\xf2\xd6\x07\x3f\x7d\xff\x30\xed\x7e\x93\xf6\xfe\x92\xf6\xff\x9a\xf6\xff\x91\x76\xef\x9f\x7e\xfc\xf7\xd3\x8f\x3e\xb3\x66\xfc\xcb\x3e\x3f\xbb\x0c\x5b\xd8\x22\x32\x20\x8c\xeb\x0d\xb2\x48\xe1\x6b\x31\x6a\x73\x41\x9b\xc7\x84\x7f\x7f\xd9\x3d\xf9\xae\x97\x76\x1f\xa5\xfd\x83\xb4\xff\x38\xed\x3f\x98\x81\xe9\xac\x3c\x75\x43\x0a\x8d\xc5\x88\x1e\xff\xf8\xe9\xe9\xc3\x8f\xae\x8a\x68\x2c\xf0\xf5\x06\x06\x06\xc3\x0b\x9c\x17\xe1\x4c\xde\xc3\xac\xb0\x78\x3e\x78\x6c\x6a\x52\xf1\x37\xac\x3a\xa8\x30\x1e\x65\x52\xcb\x32\x44\x3f\xe6\x04\xa9\x59\xa0\x2c\xea\x0a\xea\x40\xf1\x06\xb5\x98\x15\x3c\x47\x4f\x01\x3a\x3a\x0e\x02\xc4\x10\xc3\x32\xbc\x2a\x63\x08\x98\x80\x20\x92\x1a\xc1\xd4\xb8\x86\x16\x17\xa1\x6c\x01\x13\x21\x28\x34\xb1\x12\x60\x24\x98\x1a\x82\x41\x55\xe7\x82\x45\xe5\x42\x5c\x9f\x19\x24\xd7\x90\xe5\x48\xc6\x21\xdc\x94\xb1\x08\x55\x1b\xa4\xaa\x7a\xb8\x5c\x6e\x57\x40\x9d\x6e\xb0\x00\x0b\x29\x74\x2d\xfd\x2a\x07\xed\x96\xb6\xd6\x00\x45\xd8\x90\x5c\x18\xe0\x1a\x84\x34\xa0\xd1\x8c\xc3\x98\x24\x9a\x0f\x2a\x45\x85\xab\xba\xd5\x44\x8d\x69\x5f\xe2\xb4\x0d\x70\x01\x42\x8a\x1b\x9c\xf6\x7d\x16\x18\xde\x44\xa8\xcb\x10\x17\x20\xd6\x08\x37\x6e\x54\xa4\x0a\x90\xc6\x57\xef\xf3\x06\x70\x2f\xb1\x79\xa9\xf7\x90\x8f\xa3\xd0\x76\x8d\x42\x16\x42\x45\xc9\x3a\x70\xd1\x88\xcd\x22\x78\xf9\xf8\x25\x72\x21\x56\xb0\xc2\xe2\x88\x9a\x57\xc9\x04\x59\xb1\x73\x8d\x05\x81\x8c\x8b\x0c\x4c\x61\xf1\x5c\xf0\xd5\x88\x35\x34\x86\x8b\x1e\xe5\xa7\x4f\xee\xff\xa7\xfb\xdb\xc5\x7c\xe2\xab\xd9\x0c\xd0\x97\x0e\x4e\x62\x2d\x63\x43\x64\x42\x66\x70\x01\xb8\x81\x16\xd3\x10\x31\x6d\x20\x6e\xd0\xff\x42\x60\x86\x36\x88\x5d\xf7\x6b\xc9\x78\xb7\x99\xb9\xc3\x4c\x6b\x0c\xa9\xa4\x31\xa8\xd0\xec\x9f\x9e\xe4\xa8\xb8\x07\xbc\xc9\x95\x14\x75\x14\x06\x9a\x4c\x71\xb6\x17\x21\x75\xce\x06\xab\x63\x92\x4c\x9e\x03\xc5\xe5\xf3\xe1\x5f\x6f\x70\xda\xb1\xdc\xd4\x51\x58\x51\xa8\x6b\x60\xe4\x3e\xda\x15\x15\x8b\x7d\x21\x5b\xbe\x03\xb9\xa0\x70\x2e\xf0\xcd\xa5\xb5\x3b\xab\x2b\x1e\xc5\xc7\x5f\x7c\x77\x72\xf8\x20\x9f\xf1\x4d\x7b\xd8\xd0\xea\x65\x61\x08\x75\x24\x8f\x51\xdb\x9f\x41\x80\x5a\x43\x55\xc9\xb8\x61\xa7\xca\x2d\x7a\x5b\x5b\x21\x6f\x8e\x7a\x64\xdd\x35\xf5\x4e\xb6\x39\x28\x9e\x40\x78\xd0\x43\x6b\x4b\xeb\xae\x8b\x0b\xb8\x16\x45\xa5\x0b\x42\xef\x2e\x2d\x3d\x03\x74\xbe\x74\x2e\x34\xb1\x2c\x7e\xc4\xf8\x5a\xe7\xab\xde\xb8\xb9\xe9\xdb\xb5\xdc\xb7\x7c\x31\xda\xbb\xdd\xa6\xac\x4d\xc8\x05\xe0\xeb\xe4\x6e\x68\x3b\xf3\x23\x5e\xe7\x76\x37\xe9\x74\xca\x77\xe8\x3d\x49\x60\xaf\x6d\x50\xfb\x70\x66\x53\xe6\x21\xd6\x64\x11\x0f\x81\x8d\x38\x2a\xc3\xee\xa0\x19\x37\xd8\x63\x92\xa4\xe4\x25\x34\x95\x92\xb1\x44\x02\x59\xaf\x93\x9f\x55\x1a\xee\x23\xa5\x02\xd3\xa5\xa8\xf4\x58\xe8\x30\x56\x8e\x39\x49\xbf\xc2\xa2\x18\x93\xa4\x54\x86\x5d\x8d\xc3\xf0\x10\x5a\xdc\xd4\x80\x41\x2c\xdc\x88\x95\x84\x2e\x2d\x40\x29\xb6\xcf\xba\x7d\xda\x47\x9d\x1e\xb5\x12\x48\x05\xa5\xb0\xb4\x00\x58\xae\x96\xa1\xf4\xf2\x0b\xf5\x52\x79\x82\x05\x3f\x13\x89\xb1\x1d\x21\x58\x1d\xad\x3b\x37\xe3\x28\x4c\x96\x1f\x0b\xff\x5a\xcc\x84\xe1\xa6\x3d\xb9\x0b\x04\x48\x1b\x2b\xb0\xe8\xac\x33\x6e\x73\x32\x7b\xdd\x3e\x6f\xd9\xe7\x8e\x7d\x6e\xd9\xe7\x3e\x3d\xd6\xe9\x71\x8b\x1e\x3b\x6e\x88\xb6\x86\xbd\xf3\xd2\x2d\x3e\x71\x88\xfe\xf7\xfc\xc6\x76\x9f\x36\xcc\x20\x70\x61\xf7\x96\xd1\x25\x39\x88\x79\x27\x18\x58\x44\xc3\x58\x0a\x86\xa9\x2a\x9a\x29\x66\x4c\x8e\xc0\x78\x00\x77\x8c\x78\xb4\xa6\xfd\xb7\x28\x22\xef\x7d\x4b\x91\x7a\xf7\xfe\xe9\x9b\x9f\x1f\xbf\xf3\x43\xda\xfd\x2a\xed\x7e\xec\xf3\x86\xd7\xe3\xc8\xf0\x46\x44\x9e\x84\x96\x31\x45\x00\xf6\xc8\xd5\x76\x2e\x8f\xec\x27\xd0\x42\x85\xce\xab\x72\x21\x83\xa9\x5d\x94\x82\xb5\x15\xe0\x42\x1b\x64\x3e\xbf\xed\xca\xe0\xc6\x1b\xa7\x51\x35\x79\x40\x43\xab\x0d\x13\x01\x4e\xc2\xd3\x0d\x0c\x78\xa5\x9d\x87\x29\xd5\x90\xcd\xf2\xdd\x8d\xa2\xe6\x5e\x3d\x81\xdc\x0e\x20\xd5\x23\x18\x81\x14\x86\x71\xa1\x81\x67\x13\x2a\xa8\x31\xc5\x02\xca\x04\x52\xb3\xe5\x1a\x53\x76\x4d\x6f\x8a\xa8\x0d\x11\x1a\x83\x4a\x2f\x40\xc8\xab\xdc\x68\x1b\xa1\xd7\xda\x8d\x1a\x0a\x0d\x4c\x21\xb0\x28\x92\x2d\xf4\xd9\xfe\xf3\x60\x17\x33\xbb\x1e\x6b\x03\x7b\x08\x24\xa3\x02\xa6\xb1\x28\xe7\xcb\x82\xd3\x01\x6a\x6c\x30\x45\x91\x10\xec\xb5\x41\x73\x51\x8d\x10\xec\x09\xe1\x2c\xb2\xcd\xac\xdf\x65\x98\x32\x34\xb4\x28\xc2\x6c\x0f\x1d\x9b\x82\xb8\x42\xc0\x29\x0c\x24\xe6\xd9\xa8\x66\x20\x53\xd1\xcd\x11\x9f\x12\xdc\x59\x91\xd1\x77\xd3\x63\x6a\x06\x79\x3a\xfc\x34\x86\x62\x7b\x08\x58\x6f\x98\xf6\x38\xbc\xcb\x8d\xf3\x15\x4b\x18\x4d\x29\xe1\xb9\xf8\x92\x6b\x5a\x38\x15\x5e\x8d\x95\x7f\xa9\x15\x57\xe0\x23\x90\xc5\x5b\x2e\xf2\xb2\x99\x90\x4e\xa7\xbc\xe4\x5e\x29\x9c\xcb\x82\x2e\xad\x59\xd5\x9f\x1e\x9d\x5e\xcf\x18\x3a\x56\xd8\x9d\x8f\xe3\x0c\xbf\xd4\xd2\xab\x72\xe4\x3c\x0f\x64\x38\x9b\xaf\x30\x8b\x26\x1f\xa5\x10\x23\xac\xda\xc5\x7a\x21\x8e\x17\x83\x8d\xdf\xca\x93\xc3\xbd\xb6\xb4\xee\x27\x34\xa5\x1e\x0f\x1d\x43\x45\x99\xaa\x4d\x14\x7a\xa1\xce\xb7\xf1\xaa\x69\x50\xde\xd6\x98\x2c\xae\x77\x13\x22\xa8\xf1\x28\xf4\xcc\x89\x41\x32\x03\x29\x75\xd8\x50\x5c\x63\xc1\xd9\x76\x05\x50\xb9\x46\x6d\xde\xf6\x50\xd8\xbc\x9d\xdf\x0b\x5b\xb7\x97\x57\xdd\xc4\x68\xa2\xe2\x15\x8e\xaa\xe0\xe9\xe7\xc1\x99\x5d\x5f\x51\x7a\x83\xf3\xe3\xff\x5e\xa6\xb4\xc7\x8b\x2f\xfd\xff\x99\x3e\x0d\x91\x14\xd5\xe2\xcc\x26\xab\xca\x27\x15\x21\xd3\xd9\xc8\x40\xa9\x4d\xa1\x80\xa0\x47\x1b\xb5\x0b\x06\x84\xf4\x46\x28\xe9\xc1\xfd\x76\x7a\xf0\x5e\x7a\xd0\x4d\x0f\xee\x8b\xe1\x5b\x1b\x75\xf6\x4e\x85\xa9\xcf\xd2\xee\xb7\xf4\x59\xd2\xff\xfc\xf5\xcc\xf4\xa0\x57\x80\xe0\x30\xe0\xd9\x43\xd3\x42\x14\xf0\x22\x19\x4b\x2e\x14\x4d\xb5\x24\xf1\x31\x7d\x11\xd2\xee\xbb\x69\xef\xed\x73\x4d\xc1\xb2\xfb\x2a\xed\x3e\x9a\x58\x6b\x2d\xca\xcd\xcd\x88\x4a\x24\x5d\xb1\xd5\x51\xf5\x51\x3a\xf9\xf4\x6d\x1b\x26\x7c\x7d\xf2\xe4\xd1\xf1\xbb\x87\xc7\x47\xef\x9f\x1c\x1e\x9d\xf6\x7e\x38\x39\x3c\x9a\x1b\x95\xa2\x0c\xe6\xd3\x01\x4d\x8a\x4d\x7d\x60\x33\x03\xc4\x55\x2e\x46\x5c\x08\xae\x61\x2f\xe6\x51\xe6\x3c\x6c\xaf\xdc\xa6\xe5\xa4\x29\xde\xa4\xf8\xd8\xbd\x26\x09\x95\x89\x83\x1a\x25\xd8\x64\x14\xa2\x02\x53\x63\x83\x6d\x99\xb2\x36\x28\x42\x0c\xcf\x0b\xae\x73\x31\x94\x2d\x83\xcb\xd7\xdb\xf6\x0d\xc7\x20\x2b\x8e\x45\xcc\xa0\x36\x03\x41\x9f\xb1\xcf\x3b\xeb\xa2\x5d\x9d\x55\x99\x34\x71\x5c\xbe\xb3\x96\x25\xda\x97\xef\xac\xf9\x38\xd0\x8e\x41\x60\x6a\x01\xf6\x62\x63\x7b\xcc\x96\x86\xc5\x10\x9c\x3a\xe2\xbc\xc5\x23\xac\x49\x33\xf9\xcb\x46\xb5\x81\x55\x19\x9f\xa6\x83\x9f\x03\xae\xf9\xdd\xaa\x78\x93\x64\x86\xf9\x49\x59\x19\xc6\xa5\xc4\x7f\xdb\xbd\x93\x09\x5c\x0c\xea\x5b\xf4\xe1\xae\x7d\x2d\x5a\x9a\x99\x3b\x4c\xbe\x31\xf1\x5e\xc4\x83\x2b\xb7\x65\xce\x28\xb9\xa6\xdc\x5d\xfd\xc5\xee\xea\xf6\x8e\x2f\xbb\xee\xae\x8a\x78\xf2\xeb\x77\x57\xb7\xb7\x36\x37\xb6\x57\x7d\xc2\xee\xfa\x86\x4f\xf8\x8c\xf0\x60\xee\x66\x65\x00\x7b\x7e\x94\xe1\x15\xfa\x93\xd9\x65\xc3\x6e\xeb\x2c\xb9\x2e\xf4\xd7\x74\x9e\x59\xad\x87\x6c\x5d\x1a\x0a\xa8\x55\x13\x95\xbb\x2d\x52\x86\x6d\xc3\x4c\x4c\x01\x52\xe8\x5c\x46\xf7\xdb\xdd\x87\x58\xc8\xee\x84\x0c\x3f\xda\x1c\xec\xe0\x5b\xdd\x79\x7c\x17\xbc\xbf\x7c\x83\xd2\xfe\xd7\x69\xff\x4f\x94\x59\xa3\xfc\xda\xd3\xb4\xf7\xc4\xbe\x7f\x60\x9f\x4f\xcf\x6e\xc2\x1c\xf4\xe0\xf4\x9d\xbf\x9d\x3c\xee\xa6\xbd\xc7\xf4\xbb\xff\xf6\x25\x52\xe4\xa1\x0c\xdb\xf7\x9f\x8e\x36\x3c\x47\x90\xda\xf5\x3f\x4f\xfb\xfd\xb4\xf7\x94\x54\xf5\xbe\xbf\xc0\xd4\xd3\x47\x23\x99\xa2\xf3\x23\x50\x64\xb6\x17\x16\xcf\x05\xdf\xbe\x90\xe2\x9a\x1a\x7e\x0a\x05\xf9\x04\x6a\xb2\x45\xde\xce\x0b\xb4\x4c\x3b\x9d\xf2\x8e\x34\x2c\xf2\x0e\xaa\xaf\xf5\x58\xd5\x6e\x34\x95\x49\x92\x1b\x34\xa1\x44\x98\x24\x17\xc4\xc7\x83\x4d\x96\xcf\x85\xdf\xa1\xf3\x5e\x06\x2c\xa2\x6b\x33\xc1\x3e\x2d\x27\x59\xa9\x50\x86\xa7\xd3\x29\x6f\x56\x2a\x1a\xc9\x8d\xb4\x55\x36\x53\x1b\xae\x11\xdb\x76\x61\x70\x90\xbb\x7c\x1f\x39\x0d\x2e\x89\xac\xcb\xb0\xdd\x16\x41\x4d\x49\xc1\xdf\x70\x07\x89\x6e\x6b\x83\xf5\x0c\xa3\xd0\xe9\xf7\x1c\x10\xf3\x76\xd8\x60\xa3\xe6\x1a\x0c\xd6\x1b\x52\x31\xc5\xa3\x36\xc4\x82\x35\x19\x8f\xa8\x64\x3f\xce\xaa\x22\xd2\x7e\x68\x5b\x47\x90\x95\xdc\x9c\x80\xbd\x25\x58\x38\x8f\x34\xb3\xba\x7c\x72\x9c\x92\xce\x74\x87\xa3\xc5\xb8\x0d\x0d\x2a\x52\xe5\xa8\xcd\x12\x07\x7b\x4a\xb6\xb4\xf7\x6e\xe8\x8c\xca\xf2\x89\x0d\x06\x94\x0e\x52\x7b\x18\x18\xd5\x5e\xaa\x18\x54\xfe\x50\x6a\xbc\xcc\x04\x18\xeb\x1b\x4e\xd6\x9c\x35\xf3\x29\xcb\xae\xdf\xd9\xda\xab\x77\xf1\x5f\x6e\x97\xab\x6e\x57\xd0\xac\x22\x47\x39\x44\x77\xbd\x2e\xab\x79\xc8\xe8\x2c\xbf\xe4\x32\x19\x67\xa7\x85\x17\x74\x56\x6d\x13\xa8\x51\x2d\x22\x6a\x0e\x45\xa1\xce\x04\xab\xa2\x4d\x54\x0e\x9d\x24\xbb\xdc\x47\x2e\x17\x14\xab\xa6\xcf\x1b\xa5\xa0\x29\xc3\xf2\x0a\x65\x68\x94\x8c\x22\x54\x67\x3a\xe7\x67\xcb\x33\xc2\x4c\x30\x46\xb3\xe6\x30\xd4\x72\xd9\xde\x31\x45\xc2\x07\xe4\x7f\xf4\x8e\xec\xbd\xe9\xc7\x27\x5f\xbd\x7b\xf2\xd6\x07\x74\x61\xfa\xc7\x3f\x1c\x3f\xfc\xbd\x4d\x44\xbc\x67\x33\x12\x9f\xa4\xbd\xdf\xf9\xca\x86\xbb\xe4\x86\xd0\xd1\x97\x73\xf1\x00\x68\xa0\xc8\x95\x83\x4a\xc4\xaa\xd6\x92\x9b\x11\xab\xd2\x97\x6c\xcb\x77\xae\x48\x88\x41\xc4\xfc\x49\xed\xb9\x42\xe4\x1a\xf1\xcb\xa5\xbb\x1b\x6b\x1b\xb7\x7c\xbe\xf3\xf0\x73\xae\xf0\xab\x32\x56\x59\x9a\x35\x94\x54\x50\x94\x06\x6a\x34\x0a\xb4\xb2\x6c\x5a\x52\xeb\xc1\x0e\x6d\xaf\x5f\xba\xcd\x91\x4e\xc8\x06\xba\xab\x0e\x85\x9c\xcf\xf9\xe3\x4c\x32\x27\x62\xc1\xbe\xce\xc2\x18\xa7\xf3\x5c\x54\x3b\x0f\x3b\x9e\x15\x20\xd7\x00\x4b\xd7\x2d\xb1\x24\x19\x99\x2b\xb4\x1e\x22\x1e\x18\x9d\x15\x79\xe8\xde\x10\xd7\xf6\xf4\x93\xa2\x58\x04\x30\x27\xe5\x3e\xe2\x3b\xed\xc6\x45\xbd\xd9\x69\xef\xaa\x1a\x85\xdc\xe7\xe9\xf5\x5c\x03\x48\xae\xfd\xfa\xbf\x03\x00\x5c\x77\x63\xe4\x1b\x32\x00\x00")
 
 func i18nResourcesJa_jpAllJsonBytes() ([]byte, error) {
 	return bindataRead(
@@ -197,7 +197,7 @@ func i18nResourcesJa_jpAllJson() (*asset, error) {
 	return a, nil
 }
 
-var _i18nResourcesKo_krAllJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x94\xcf\x4f\xdb\x48\x14\xc7\xef\xf9\x2b\x9e\x72\xf1\x25\x1b\x89\x6b\x6e\x88\xf5\x4a\x68\x7f\xc0\x92\xe5\xb4\xd9\x83\x89\x27\xc1\x5a\x67\x26\xeb\x1f\x41\x28\xb2\x14\x16\x17\x45\x38\x95\xa0\x4d\x5a\xb7\x8d\x69\x2a\x25\xa2\x48\x41\x0a\x29\x88\x1c\xe0\x1f\xf2\x3c\xff\x0f\x95\x63\x68\x29\xcd\xb4\x51\x69\x2f\x51\xac\x37\xdf\xf7\x3e\x6f\xde\xbc\xef\xdf\x29\x80\x7a\x0a\x00\x20\xad\xa9\xe9\x1c\xa4\x0b\x54\xa6\x16\x31\x40\x01\x6a\x57\x36\x88\x91\xce\x24\x51\xcb\x50\xa8\xa9\x2b\x96\xc6\x68\x72\x8c\x8f\x9b\x91\x3f\x01\x3c\x7a\xc4\x7b\x83\x74\x0a\xc0\xc9\xdc\xcf\xb5\x48\x81\x18\x06\x33\x80\x15\x8b\xb6\x61\x10\x15\xb6\x36\x09\x85\xa2\x41\x14\x4b\xa3\x65\xd0\x59\x19\x4a\x9a\x4e\x40\xaa\xd7\xb3\xab\x8a\xb5\xe9\x38\x52\xae\x40\xeb\xf5\xac\x1c\xcb\x1c\xa7\x40\x0b\x54\x00\x70\x47\x02\xfc\x4d\x37\xbc\x9c\x40\xd4\x6a\x61\x70\x85\x81\x0b\x78\x74\x88\xee\x59\xd4\xe9\x01\xef\xb4\x80\x7b\x7d\x0c\x5a\x80\x7e\x9f\x0f\xfc\x70\xd4\x00\x3e\xea\xe2\x6e\x10\x3d\x6b\xe2\xfe\x05\xf7\x9a\xdc\xeb\x67\xe1\xb3\xb2\x73\x77\x14\x37\xa0\xda\x95\x6a\xdc\x91\x41\xfe\xb3\x89\x69\xdd\x6b\x42\xd0\x02\xbe\x6c\xe3\xf8\x34\xe6\xe5\x8f\xfb\x51\xdb\x7d\x00\xef\xb7\xd2\x9a\x55\x46\x4d\x32\x27\x6e\x70\xc8\xbd\x8b\x1f\x88\xbb\xc4\x6c\x5d\x05\xca\x2c\x30\x88\xa2\x42\xc9\x60\x15\xd0\x68\xd5\xb6\x72\x20\x40\xfa\x92\x62\x66\x09\x59\x57\xaa\x26\x51\x73\x82\x7c\xe1\xf8\x3a\x7c\x77\x05\xe8\x75\xc3\x91\x9b\x9b\x9d\xe2\x97\xc5\xe5\xdf\xe4\x9f\x45\x77\xe4\xf5\xa3\xd6\xdb\xd9\xc2\x65\x5a\x53\x74\x4d\x05\x8b\xfd\x4b\xa8\xb0\x25\xf4\x87\x7c\xd4\xe6\x83\x73\x3c\x6e\x00\x76\xf6\x31\x68\x40\xb4\xd7\x8b\x76\x46\xa2\x9e\x56\x7e\x15\xa4\x8a\x5e\x74\x30\x98\xcc\x16\xad\xea\x44\x31\x09\x90\xe9\xae\x4b\xdb\x52\x06\x24\x1a\xff\x6c\x13\x53\x02\x66\x80\x44\x99\x94\x15\xad\xde\xc7\xe3\xe8\x37\x25\xe0\xfe\x01\xdf\x6f\x83\x84\x1d\x97\x7b\x4d\xf4\xfb\x12\x1f\x5c\xdd\x18\x43\xd4\xf1\xd1\x3b\x45\xaf\x8b\x7e\x3f\x3b\x07\xca\xad\xed\xc0\x06\xb1\xb6\x08\xa1\xb0\x00\x16\x83\x7a\x3d\xbb\xc4\x6c\x6a\x39\x8e\x88\x69\x01\x7e\xba\x73\x0a\xf0\xff\x21\x06\xe7\x18\xf8\x80\x4d\xff\x21\x34\xc9\xc8\x4a\x3a\x4b\x1c\x2b\x81\x13\x41\xdc\xce\x6e\x92\x08\xbe\x4f\xed\x79\x4b\x3e\xa8\x58\x4d\xd1\x6d\x22\xaa\x11\x8e\x9e\x24\xa6\x3a\x67\xe6\x35\xf9\xcf\x75\x39\xff\x97\x68\xc9\x12\xdb\x13\x6c\xd7\x9a\x9c\x5f\x5d\xf9\x23\x2f\x0b\xc5\x53\x13\x12\x89\x49\x85\x59\x04\x4c\x62\xd4\x88\x91\x58\x5f\x16\xf2\x96\x62\xd9\x26\x14\x99\x4a\x72\xf1\x13\x49\xbe\x97\x98\x4a\x1c\x27\x73\xe3\x8f\x1f\x82\x53\x87\xba\x8d\x55\x88\x69\x2a\xe5\x24\xf0\x7b\xf2\xdf\x71\x44\x5c\xaf\x0e\xc2\xf1\x09\xa0\xdb\xe5\x63\xf7\x2b\x5e\x88\xbb\x3b\xd1\x6e\x17\xf0\xba\xcd\x9f\x76\x67\x30\x25\xea\xbb\xf1\x4f\xb0\xf8\x49\x3b\xbe\xfe\xe3\xc6\x3d\xb0\x99\x57\xb2\x4e\x95\x0d\x9d\xc4\x3b\x64\x2a\x35\x02\x55\xdd\x2e\x6b\x14\x8a\x8c\x96\xb4\xb2\xd0\x83\xa2\x76\x8b\xbf\x1e\x86\x97\x13\x0c\x26\x10\x5e\x0c\xd1\x3d\x9b\x3e\x80\x5e\x03\x8f\x06\xb1\xed\x63\xd3\x07\x7c\xbe\x87\x41\x2b\x99\x44\xea\x9f\xf7\x01\x00\x00\xff\xff\xa1\x58\x4d\x9f\x48\x08\x00\x00")
+var _i18nResourcesKo_krAllJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x5a\x5b\x6f\x1b\xc7\x15\x7e\xf7\xaf\x38\xf0\x0b\x5f\x64\x22\x97\x3e\x14\x7e\x13\x64\xd9\x10\x6c\xd9\xaa\x2e\x29\x82\xba\x0f\xa3\xdd\x43\x72\xa0\xe5\x0c\x33\x33\x4b\x86\x21\x16\x70\x1a\x35\x10\x22\x15\x48\x5a\xa9\x65\x5b\x29\x75\x01\x19\x69\x00\x07\x50\xdc\x04\xd1\x43\xf2\x87\xc4\xe5\x7f\x28\xce\xcc\x92\x12\xa5\x1d\x72\x29\x51\x69\x5e\xc6\x94\x77\xce\xf9\xbe\x33\xb7\x73\x99\xf9\xdd\x1d\x80\xce\x1d\x00\x80\xbb\x3c\xbc\x7b\x1f\xee\x3e\x17\x8b\xc2\xa0\x02\x06\x22\xae\x6f\xa2\xba\x3b\xe7\xbe\x1a\xc5\x84\x8e\x98\xe1\x52\xb8\x6e\xbd\x37\x3b\xfd\xee\x29\xa4\x5f\xfe\xb1\xf7\xf2\xd5\xdd\x3b\x00\xc9\xdc\x65\x5d\xf3\x02\x50\x29\xa9\x40\x06\x41\xac\x14\x86\xd0\xaa\xa1\x80\x40\x21\x33\x5c\x54\x21\x92\x55\xa8\xf0\x08\xa1\xd4\xe9\x94\x57\x98\xa9\x25\x49\xe9\xfe\x73\xd1\xe9\x94\x17\x49\x2c\x49\x9e\x8b\xe7\xc2\x43\xe0\x82\x08\xf4\xfe\x7d\x78\xf6\xc3\x29\xf4\xf7\xf6\xd2\xa3\x1f\xd3\xa3\x6d\x48\xbf\xfc\x22\xdd\xfe\xb6\x7f\xf0\x12\x7a\x07\x7b\xd0\xdb\x3d\x4e\x8f\xf6\x20\xed\x1e\xf7\x5e\x75\xcf\x4e\x5e\x40\xef\xe4\x30\xfd\xe4\xa8\xff\xd7\x9d\xf4\xb3\xef\x7b\xbb\x3b\xbd\xdd\xe3\x32\x5c\x81\x2d\x6c\x11\x19\x10\xc6\xf5\x06\x59\xa4\xf0\x83\x18\xb5\xb9\x64\x84\xc7\x84\xf4\x1f\xfb\xe9\x9b\x6f\x88\x6f\xef\x4f\xc7\xfd\xfd\xed\x1b\xf0\xbd\x2e\x5b\xdd\x90\x42\x63\x41\xba\x47\x5f\xf4\x76\xbf\xbf\x5d\xba\xb1\xc0\x0f\x1b\x18\x18\x0c\x2f\x31\xbf\x0f\xe7\xf2\x1e\x7e\x85\xc5\xf3\xc1\x63\x53\x93\x8a\x7f\x64\xd5\x41\x85\xf1\x28\x93\x5a\x90\x21\xfa\x31\x27\x48\x5d\x07\xca\xa2\x3e\x40\x1d\x28\xde\xa0\x1e\xd7\x05\xcf\xd1\x53\x80\x8e\x8e\x83\x00\x31\xc4\xb0\x0c\xef\xcb\x18\x02\x26\x20\x88\xa4\x46\x30\x35\xae\xa1\xc5\x45\x28\x5b\xc0\x44\x08\x0a\x4d\xac\x04\x18\x09\xa6\x86\x60\x50\xd5\xb9\x60\x51\xb9\x10\xd7\x1b\x83\xe4\x1a\xb2\x10\xc9\x38\x84\x87\x32\x16\xa1\x6a\x83\x54\x55\x0f\x97\xab\xfd\x0a\xa8\xd3\x0d\x16\x60\x21\x85\xae\xa7\x5f\xe5\xa0\xdf\xfc\xca\x12\xa0\x08\x1b\x92\x0b\x03\x5c\x83\x90\x06\x34\x9a\x71\x18\x93\x44\xf3\x41\xa5\xa8\x70\x55\xb7\x9a\xa8\x33\x9d\x51\x9c\x0e\x03\x2e\x40\x48\x71\x8f\xd3\x79\xcf\x02\xc3\x9b\x08\x75\x19\xe2\x1c\xc4\x1a\xe1\xde\xbd\x8a\x54\x01\xd2\xfc\xea\x2d\xde\x00\xee\x25\x36\x2b\xf5\x1e\xf2\x71\x14\xda\xa1\x51\xc8\x42\xa8\x28\x59\x07\x2e\x1a\xb1\xb9\x0f\x5e\x3e\x7e\x89\x5c\x88\x07\x58\x61\x71\x44\xdd\xab\x64\x82\xac\xd8\xb5\xc6\x82\x40\xc6\x45\x26\xa6\xb0\x78\x2e\xf8\x62\xc4\x1a\x1a\xc3\xfb\x1e\xe5\x67\x6f\x7e\x3a\xfb\xef\x8f\x90\xee\x1e\x9e\x9d\x6c\xdf\xcf\xe7\xbf\x98\x2d\x04\x7d\xc5\x97\x12\x79\x19\x1b\xe2\x14\x32\x83\x73\xc0\x0d\xb4\x98\x86\x88\x69\x03\x71\x83\xfe\x2f\x04\x66\xe8\x9c\xd8\x70\x7f\xcd\x1b\xef\x69\x33\x73\x98\x69\x8d\x21\x95\x34\x15\x15\xda\x04\xd3\x93\x1c\x15\xf7\x80\x37\xb9\x92\xa2\x8e\xc2\x40\x93\x29\xce\x36\x23\xa4\xc1\x79\xca\xea\x98\x24\x93\x97\x42\x71\xf9\x7c\xf8\x0f\x1b\x9c\x0e\x2e\xb7\x82\x14\x56\x14\xea\x1a\x18\xb9\x85\x76\x63\xc5\x62\x4b\xc8\x96\xcf\x3b\x17\x14\xce\x05\x7e\x38\xbf\xf4\x64\xf1\x81\x47\x71\xba\x7b\xdc\xdf\xfb\x4f\x3e\xe3\x87\xd6\xe7\xd0\x26\x66\x61\x08\x75\xa4\x80\x51\xdb\x3f\x83\x00\xb5\x86\xaa\x92\x71\xc3\x2e\x95\x47\xf4\x6b\xe9\x01\x05\x78\x34\x22\xcb\xae\xab\x77\xb1\xcd\x40\xf1\x04\xc2\x83\x11\x5a\x9a\x5f\x76\x43\x5c\x20\xc2\x28\x2a\x5d\x10\x7a\x63\x7e\xfe\x06\xd0\xf9\xd2\xb9\xd0\xc4\xb2\xb8\xa7\xf1\xf5\xce\x57\xfd\xf4\xe1\x33\xdf\xe1\xe5\xbe\xe5\x8b\xd1\x11\xee\xce\x66\x6d\x42\x2e\x00\x3f\xa4\xa8\x43\xdb\x95\x1f\xf1\x3a\xb7\xa7\x49\xa7\x53\x7e\x42\xbf\x93\x04\x36\xdb\x06\xb5\x0f\xe7\x7a\xca\x3c\xc4\x9a\x2c\xe2\x21\xb0\x91\x78\x65\x38\x1c\xb4\xe2\x06\x67\x4c\x92\x94\xbc\x84\xa6\x52\x32\x96\x48\x20\xeb\x75\x0a\xb7\x4a\xc3\x73\xa4\x54\x60\xb9\x14\x95\x1e\x0b\x1d\xc6\xca\x31\x27\xe9\xf7\x58\x14\x63\x92\x94\xca\xb0\xa1\x71\x98\x1d\x42\x8b\x9b\x1a\x30\x88\x85\x9b\xb1\x92\xd0\xa5\x39\x28\xc5\xb6\xad\xdb\xd6\x36\x75\x6a\x6a\x25\x90\x0a\x4a\x61\x69\x0e\xb0\x5c\x2d\x43\xe9\xdd\xb7\xea\xa5\xf2\x04\x0b\x7e\x26\x12\x63\x07\x42\xb0\x3a\xda\xa8\xee\x9a\xb3\x30\x59\x7e\x2c\xfc\x07\x31\x13\x86\x9b\xf6\xe4\x21\x10\x20\x6d\xca\xc0\xa2\xf3\xc1\x78\xcc\xc9\xec\x65\xdb\x3e\xb2\xed\xba\x6d\x57\x6c\xbb\x45\xcd\x32\x35\x8f\xa8\x59\x77\x53\xb4\x32\x1c\x9d\x77\x1e\xf1\x89\x53\xf4\xff\xe7\x37\x76\xf8\xb4\x61\x06\x81\x0b\x7b\xb6\x8c\x6e\xc9\x41\x02\x3c\xc1\xc0\x22\x1a\xc6\x52\x30\x4c\x55\xd1\x4c\xb1\x62\x72\x04\xc6\x03\x38\x37\xe2\xd1\x9a\x76\x5f\xf7\x4e\xf6\x7b\xaf\xbe\x4b\xbf\x7a\x01\xe9\xc1\x67\xe9\xd1\x0b\xe8\x7f\xfa\xb2\xff\xf1\x89\x2f\x26\x5e\x8e\x23\xc3\x1b\x11\x05\x12\x5a\xc6\x94\x07\x58\x8f\xab\xed\x52\x1e\x39\x4e\xa0\x85\x0a\x5d\x50\xe5\x12\x07\x53\xbb\x2c\x05\x4b\x0f\x80\x0b\x6d\x90\xf9\xc2\xb6\x5b\x83\x1b\x6f\x9c\x46\xd5\xe4\x01\xcd\xac\x36\x4c\x04\x38\x09\x4f\x37\x30\xe0\x95\x76\x1e\xa6\x54\x43\x36\x0b\xab\x4f\x8b\x9a\x7b\xfb\x04\x72\x07\x80\x54\x8f\x60\x04\x52\x18\xc6\x85\x06\x9e\xad\xa7\xa0\xc6\x14\x0b\xa8\x0e\x48\xdd\x16\x6a\x4c\xd9\x2d\xfd\x4c\x44\x6d\x88\xd0\x18\x54\x7a\x0e\x42\x5e\xe5\x46\xdb\x3c\xbd\xd6\x6e\xd4\x50\x68\x60\x0a\x81\x45\x91\x6c\xa1\xcf\xf6\x9f\x07\xbb\x98\xd9\xf5\x58\x1b\xd8\x44\x20\x19\x15\x30\x8d\x45\x39\x5f\x15\x9c\x0e\x50\x63\x83\x29\x4a\x84\x60\xb3\x0d\x9a\x8b\x6a\x84\x60\x1d\x84\xb3\xc8\x76\xb3\x61\x97\x61\xca\xd0\xd4\xa2\x08\xb3\x23\x74\x6c\x21\xe2\x16\x01\xa7\x30\x90\x98\x67\xb3\x9a\x81\x4c\x45\x37\x47\x7c\x4a\x70\x67\x45\x46\xdf\x2d\x8f\xa9\x19\xe4\xe9\xf0\xd3\x18\x8a\x6d\x22\x60\xbd\x61\xda\xe3\xf0\xae\x76\xce\x57\x2c\x61\xb4\xb0\x84\x17\xd2\x4b\xae\x69\xe3\x54\x78\x35\x56\xfe\xad\x56\x5c\x81\x8f\x40\x96\x6e\xb9\xc4\xcb\xd6\x43\x3a\x9d\xf2\xbc\xfb\x49\xd9\x5c\x96\x73\x69\xcd\xaa\xfe\x22\xe9\xf4\x7a\xc6\xd0\xb1\xc2\xce\x3d\x8e\x33\xfc\x4a\x4f\xaf\xca\x11\x77\x1e\xc8\xf0\x7a\xa1\xc2\x75\x34\xf9\x28\x85\x18\x61\xd5\x6e\xd6\x4b\x69\xbc\x18\x1c\xfc\x56\x9e\xe2\xed\xa5\xf9\x65\x3f\xa1\x29\xf5\x78\xe8\x18\xba\xa6\xa9\xda\x72\xa1\x17\xea\x62\x1f\xaf\x9a\x06\x55\x6f\x8d\xc9\xd2\x7a\xb7\x20\x82\x1a\x8f\x42\xcf\x9a\x18\xd4\x32\x90\x0a\x88\x0d\xc5\x35\x16\x5c\x6d\xb7\x00\x95\x6b\xd4\xb3\xc7\x1e\x0a\xfd\xbf\x1f\xa4\x47\xa7\xf9\x23\xb1\xf2\x78\x61\xd1\x2d\x8e\x26\x2a\x5e\xe1\xa8\x0a\x7a\x40\x0f\xd6\xf5\xf5\x15\xa5\x37\xf0\x21\xbf\x7a\x97\x2a\x1f\x6f\xbf\xf3\xeb\x73\x7d\x1a\x22\x29\xaa\xc5\x99\x4d\x56\x95\x4f\x2a\x42\xa6\xb3\xd9\x81\x52\x9b\xb2\x01\x41\x4d\x1b\xb5\xcb\x07\x84\xf4\x26\x29\x17\xba\xa7\xdd\x9d\x12\xf4\xba\x9f\xf7\x3e\xdb\x87\x52\x7a\xb0\xdd\xdb\xdd\x49\xbb\xc7\xa5\xde\xab\x1f\xb3\x5b\xcc\xfe\x41\x37\xdd\xfd\x26\xdd\x3d\x4c\xbb\xc7\xe5\x02\x54\x86\xd9\xcd\x26\x9a\x16\xa2\x80\xb7\xc9\x2c\x0a\x98\x68\x61\x25\x89\x8f\xd3\xdb\x70\xef\x42\x2f\x48\xff\xf0\x3a\x3d\xfa\x2e\x3d\xea\x42\xba\xd3\xbd\x09\x1b\x37\xdb\x95\x48\xba\xeb\x55\x47\xae\x3c\x21\x29\x38\x75\x02\xb3\xc1\x2e\x0a\x79\x23\xb0\x26\xa5\x98\x3e\x8c\xb3\x93\x3f\xd3\x15\xe5\x14\x9a\xe3\x2a\x17\x23\x31\x00\xd7\xb0\x19\xf3\x28\xf3\xfe\x6b\x0f\x1e\xd3\x5e\xd0\x94\x2f\x52\x7e\xeb\x7e\x26\x09\xdd\xfc\x06\x35\x2a\x90\xc9\x28\x44\x05\xa6\xc6\x06\xe7\x2a\x55\x5d\x50\x84\x18\x5e\x14\x5c\xe6\x62\x28\x5b\x06\x57\x6f\xb7\xfd\x1b\x8e\x41\x76\xc7\x15\x31\x83\xda\x0c\x04\x7d\x56\xfe\xd2\x59\x17\x1d\xea\xec\xb2\x48\x13\xc7\x85\x27\x4b\x59\xa1\x7c\xe1\xc9\x92\x8f\x03\x6d\x77\x02\x53\x73\xb0\x19\x1b\x3b\x62\xf6\x86\x57\x0c\xc1\x69\x20\x2e\x5a\x3c\xc2\x9a\x34\x53\xc0\x6b\x54\x1b\x58\x95\xf1\x69\x06\xf8\x17\xc0\x35\x7f\x58\x15\x6f\x92\xcc\xb0\xbe\x28\x2b\xc3\xc4\x92\xf8\xaf\xb9\xdf\x64\x02\x17\x83\x6b\x2a\xfa\xb0\x6a\x7f\x16\xbd\x5a\x99\x39\x4c\xbe\x31\xf1\x66\xc4\x83\x5b\xb7\x65\xc6\x28\xb9\xa6\xac\x2e\xfe\x66\x63\x71\x6d\xdd\x57\x1d\x77\xaf\x3f\x3c\xf5\xf1\xd5\xc5\xb5\x95\x67\x4f\xd7\x16\xbd\xc2\xf6\x2d\x86\x4f\xf8\x9c\xf0\x60\xed\x66\x65\x7c\x7b\x48\x97\xe1\x3d\xfa\x27\xb3\xcb\xe6\xcd\x36\xda\x71\x43\xe8\xbf\x93\xb9\xb1\x5a\x0f\xd9\xba\x34\x94\x11\xab\x26\x2a\xf7\xe8\xa3\x0c\x6b\x86\x99\x98\x32\x9c\xd0\xc5\x7c\xee\x6f\xf7\xac\x61\x2e\x7b\xda\x31\xfc\x68\x6b\xa8\x83\x6f\x75\x17\xb2\x15\x8a\x14\xd3\x7f\x7e\x7e\xf6\xe6\x6b\x48\xb7\x0f\x7b\x6f\xb6\x27\xbc\x5f\x49\x3f\xf9\xb8\xff\xc9\x21\xa4\x3f\xed\xf7\xfe\x72\x98\xc3\xc9\x49\x5f\xfc\x3e\x42\xab\xf7\xf5\x3e\x79\xa1\xaf\x5e\x14\x89\x2b\x57\x47\x2b\x3b\x17\x07\xbc\xc8\xe2\x2e\x2c\x9e\x0b\xbe\x76\xa9\x24\x35\x35\xfc\x14\x0a\xf2\x09\xd4\x64\x8b\xa2\x97\xb7\x68\x57\x76\x3a\xe5\x75\x69\x58\xe4\x9d\x43\x5f\xef\xb1\xaa\xdd\xec\x29\x93\x24\xf7\x68\xfd\x88\x30\x49\x2e\x89\x8f\x07\x9b\x2c\x9f\x0b\xbf\x4e\xee\x5d\x06\x2c\xa2\xc7\x2e\xc1\x16\xed\x1e\x59\xa9\x50\x45\xa6\xd3\x29\x3f\xab\x54\x34\x52\x34\x68\x2f\xc5\x4c\x6d\xb8\x25\x6c\xdf\xb9\x81\xdf\x76\xf5\x39\x8a\x11\x5c\xcd\x57\x97\x61\xad\x2d\x82\x9a\x92\x82\x7f\xe4\xfc\x86\x6e\x6b\x83\xf5\x0c\xa3\x90\xb3\xfb\x05\x10\xf3\x0e\xd8\xe0\x5c\xe6\x1a\x0c\xd6\x1b\x52\x31\xc5\xa3\x36\xc4\x82\x35\x19\x8f\xe8\x86\x7d\x9c\x55\x45\xa4\xfd\xd0\xb6\xec\x2f\x2b\xb9\x39\xbc\x7d\xe7\x57\xb8\xee\x73\x6d\x75\xf9\xe4\x38\x15\x89\xe9\xc9\x45\x8b\x71\x1b\xea\x57\xa4\xca\x51\x9b\x25\xfa\x9b\x4a\xb6\xb4\xf7\x25\xe7\x35\x95\xe5\x13\x1b\x4c\x28\xf9\x4d\x7b\xf6\x1b\xd5\x9e\xaf\x18\x54\xfe\x64\x68\xbc\xcc\x04\x18\x1b\x0a\x4e\xd6\x9c\x75\xf3\x29\xcb\x1e\xcd\xd9\xab\x52\xef\xe6\xbf\xda\x2f\x57\xdd\x86\xa0\x55\x45\x71\x71\x88\xee\x51\x5c\x76\x47\x21\xa3\xf3\x7a\x90\xab\x3c\x9c\xbb\x09\x2f\xe8\x75\xb5\x4d\xa0\x46\x77\x07\x51\x73\x28\x0a\x75\x26\x58\x15\x6d\x61\x71\x18\x13\xd9\xed\x3e\xf2\x16\xa0\xd8\xe5\xf7\xac\x51\x0a\x9a\x32\xbc\x0e\xa1\x6a\x8a\x92\x51\x84\xea\x5c\xe7\xec\x6c\xb9\x21\xcc\x04\x63\x34\x6b\x0e\x33\x2b\x57\x9d\xf5\xde\xe9\xf5\xf7\xf7\x7a\xff\x7a\x7d\xf6\xc3\x69\x7a\x74\x0a\x67\xdf\xbf\x4e\xb7\xbf\xb5\x79\xef\xcb\x17\xe9\x97\xaf\xe8\x69\x6e\xba\xd3\x85\xf4\x6f\x9f\xa6\x47\x7b\x9e\x30\x71\x83\x62\x10\xf2\x7b\x39\x8f\x04\x80\x66\x89\xc2\x36\xa8\x44\xac\x6a\xcd\x78\x18\xb1\x2a\x7d\xc9\xce\x7b\x17\x87\x84\x18\x44\xcc\x5f\x81\x9e\x29\x44\xae\x11\xbf\x9d\x5f\x7d\xba\xf4\xf4\x91\x2f\x4e\x1e\x7e\xce\x15\x7e\x5f\xc6\x2a\xab\x89\x86\x92\x6e\xff\xa4\x81\x1a\x4d\x01\x6d\x2b\x5b\x43\xd4\x7a\x70\x3c\xdb\x17\x93\xee\x64\x24\xf7\xd8\x40\xf7\x2c\xa1\x50\xa0\x39\x7b\x9c\x49\xe6\x44\x2c\xd8\xd2\x59\xca\xe2\x74\x5e\xc8\x60\x67\x61\xc7\x4d\x01\x72\x0d\xb0\x74\xdd\xfe\x4a\x92\x91\xb5\x42\x9b\x21\xe2\x81\xd1\xd9\x8d\x0c\xbd\xf1\xe1\xda\xba\x3e\x29\x8a\x45\xfb\x33\x52\xee\x23\xbe\xde\x6e\x5c\xd6\x9b\xb9\x7a\x77\x05\x51\x28\x76\x9e\x5e\xcf\x1d\x80\xe4\xce\xef\xff\x37\x00\x5d\x58\x47\x5c\xc6\x31\x00\x00")
 
 func i18nResourcesKo_krAllJsonBytes() ([]byte, error) {
 	return bindataRead(
@@ -217,7 +217,7 @@ func i18nResourcesKo_krAllJson() (*asset, error) {
 	return a, nil
 }
 
-var _i18nResourcesPt_brAllJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x95\xbf\x6e\x13\x41\x10\xc6\x7b\x3f\xc5\xc8\xcd\x35\xc6\x52\x5a\x77\x96\x73\x11\x56\xfe\x38\xc4\x4e\x85\x29\x36\xb7\x63\x67\xc5\xde\x8c\xb3\x7f\x2e\x0a\xa7\xab\x28\x78\x0e\x94\x02\x51\x50\xd1\xd1\xde\x8b\xa1\xbd\x73\x08\x98\xdb\xc4\x41\x34\x96\xad\xb9\xef\xfb\x7e\x73\xeb\x99\x7d\xdb\x03\x28\x7b\x00\x00\x7d\x25\xfb\x23\xe8\x2f\x29\x25\x87\x06\x04\x90\xcf\xaf\xd0\xf4\x07\x6d\xd5\x19\x41\x56\x0b\xa7\x98\xda\xc7\xa6\x64\x95\x11\xe0\x73\xa0\xfa\x47\x8e\x86\xfb\x3d\x80\x6a\xb0\xeb\x37\x26\x40\x63\xd8\x00\x67\x99\x37\x06\x25\xdc\x5e\x23\x41\x66\x50\x38\x45\x6b\xd0\xbc\x86\x95\xd2\x08\x49\x59\x0e\xcf\x85\xbb\xae\xaa\x64\xb4\xa4\xb2\x1c\xa6\x41\x56\x55\x4b\x5a\x52\x04\x62\x96\xb1\x31\xe8\x03\x43\xc8\x00\xc1\x90\x19\x25\x0c\x30\x08\x73\xe3\x55\xc1\x20\xb1\x49\x78\xd2\x7c\x6f\xee\x80\x29\x7d\xbe\x09\xdc\x06\x6f\x3c\x5a\xb7\xe3\xb6\x3f\xe8\x4a\x7c\x40\xd3\xb8\x81\x14\x60\x59\xab\x4c\x39\x51\x7f\xa9\xef\x79\xd7\xf3\x1f\xf9\xec\x86\xc9\xe2\x7f\x02\x6c\xec\xac\x13\x7b\xb1\x4d\xd8\x6b\x09\xc4\x0e\x0c\x0a\x09\x2b\xc3\x39\x28\xda\x78\x37\x82\x48\xfe\x53\x8a\xce\x88\x54\x8b\x8d\x45\x39\x8a\xf8\x1d\x62\x68\x48\x49\x1e\x75\xcb\x8f\xc6\xd3\x93\xf4\x30\x06\x33\x3b\x85\xa3\xf1\xc9\xeb\x71\xb7\x76\x4a\x85\xd0\x4a\x82\xe3\xf7\x48\xd1\x8e\x16\xa1\x0a\x8a\x8a\xfa\xb3\x0e\x1c\x91\x3e\x66\xc7\xb1\x13\x39\xee\x16\x9c\x6b\x14\x16\x01\x9b\x21\x4d\xee\x92\x01\x24\x14\x3e\xee\xd0\x26\xc0\x06\x12\xe2\x64\x18\xf1\xdc\x8e\xec\x5f\x2a\xbf\x55\x3d\x1f\xf8\xb0\x15\xe0\x0a\xdd\x2d\x22\xc1\x01\x38\x86\xb2\x1c\x4e\xd8\x93\xab\xaa\x67\x92\x1f\x97\x45\xf0\x33\x08\x07\x80\x7f\xa8\xf7\x21\x68\xdf\xfe\x4a\x73\xbb\x40\x5a\xa0\xfd\x83\x57\xda\x3b\x2f\xc8\x21\x6c\x8f\xe6\x25\xa9\x4f\x87\x1d\xaa\xb5\x72\xf8\x7b\xd8\x0b\x22\x0a\xa1\x3d\x3e\xdf\x46\x21\x34\x9b\x88\xdf\x45\xfa\xe6\x32\x9d\x2f\x62\x43\x31\x9f\x9d\x4c\x27\xd3\xc5\xb8\xfe\x54\x7f\x9c\x45\x06\xe3\x22\x9d\x9f\xcf\xce\xe6\x69\xcc\xa3\xa9\xcf\x17\xe3\x98\x1c\x73\x76\x08\x16\x4d\x81\xa6\x5d\x50\x43\x98\x3b\xe1\xbc\x85\x8c\x25\x8e\xc2\x69\xb7\xbf\x27\x2c\xb1\xaa\x06\xdb\x2d\xf6\xab\xd8\xac\x96\x87\x5a\x8e\xd6\x8a\x75\x5b\x38\x6d\xbf\x57\x55\x84\x2c\x08\x41\x72\x93\xad\x24\x1b\x30\x81\x85\x87\x30\xa9\xbf\x4b\xb5\x6e\xae\x02\xdb\x24\x77\x40\x64\x8f\xcf\x04\x9e\x2e\x12\x0a\xe9\xf9\x0e\x4a\xe7\x4b\xb8\x24\x71\xa5\x31\x0c\x86\x15\x05\xc2\x46\xfb\xb5\x22\xc8\x98\x56\x6a\x1d\xdd\x17\x67\xf5\x3d\x43\xfd\x15\x36\x6c\x6d\xfd\xad\x40\x0d\x56\xe8\x42\x84\xbf\x5e\xab\xf4\xa6\xbd\x1d\x42\x8f\xc1\xf2\x95\xa2\xed\x52\xe9\xbd\xfb\x19\x00\x00\xff\xff\x6b\x47\x7a\xe8\xc6\x07\x00\x00")
+var _i18nResourcesPt_brAllJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x5a\xbb\x92\x1b\xbb\xd1\xce\xf5\x14\x5d\x4a\x98\xac\x58\xe7\xf2\x07\x7f\x6d\xc6\xda\x8b\xcc\xd2\xde\xbc\x97\xe3\x3a\x65\x39\xc0\x0e\x7a\x48\xd4\x62\x80\x11\x2e\xa4\x28\xd6\x44\x0e\xfc\x1c\xae\x13\xb8\x1c\x38\x72\xe6\x74\x5f\xcc\xd5\xc0\x90\xbb\xdc\x1d\x90\x20\x45\x1d\x2b\x19\x71\x35\xe8\xef\xfb\x1a\xd7\xee\xc6\xfc\xf9\x0d\xc0\xfc\x0d\x00\xc0\x5b\xc1\xdf\x1e\xc2\xdb\x8f\xea\x44\x39\x34\xc0\x40\xf9\xea\x1e\xcd\xdb\x83\xf8\xd6\x19\xa6\xac\x64\x4e\x68\x15\x9b\x0d\x95\x15\x86\x81\xaf\x40\x3d\xfe\xa7\x42\xa3\xdf\xbe\x01\x68\x0e\x5e\xe2\x0d\x14\xa0\x31\xda\x80\x2e\x0a\x6f\x0c\x72\x98\x8e\x51\x41\x61\x90\x39\xa1\x46\x20\xf5\x08\x4a\x21\x11\x7a\xf3\x79\xff\x8a\xb9\x71\xd3\xf4\x0e\x3f\xaa\xf9\xbc\x7f\x42\x66\x4d\xf3\x51\x7d\x54\x09\x11\x97\x85\x36\x06\x3d\x69\x20\x0e\x60\x1a\x0a\x23\x98\x01\x0d\xcc\x7c\xf2\x62\xa2\x81\x63\x60\x58\x0b\x9e\xad\x9b\x64\x72\x5f\xd5\xa4\xdb\xe0\x27\x8f\xd6\xbd\x40\xcb\x17\x5a\xb2\x2f\x68\x02\x1a\x70\x06\x56\x4b\x51\x08\xc7\x1e\xff\xf1\xf8\x9b\x7e\x89\xb9\xa3\x3e\x5b\x6b\x65\x71\x4f\x02\x0d\xda\x5a\x5b\xc7\x72\xb5\x79\x85\x9f\x6b\x2c\x1c\xf2\x17\x32\x0f\xe1\xc9\x3e\x21\x26\xdb\xbc\x9b\xdc\xbb\xb1\x36\xe2\x4b\x80\x83\x92\x09\xd9\x5a\x1d\x69\x8e\x69\xce\x0d\x56\xbb\x50\x05\xd6\x63\xb4\x85\x11\x35\xb5\xd8\x95\xbc\x03\x27\x43\x8e\xf5\x45\x81\xc8\x91\xf7\xe1\x57\xed\xa1\x60\x0a\x0a\xa9\x2d\x82\x1b\x0b\x0b\x53\xa1\xb8\x9e\x02\x53\x1c\x0c\x3a\x6f\x14\x38\x0d\x6e\x8c\xe0\xd0\x54\x42\x31\xd9\xcf\xd2\xfa\xd5\x24\x9d\x8e\x1c\x49\xed\x39\x9c\x6a\xaf\xb8\x99\x81\x36\xa3\x84\x96\xd7\xed\x32\xe0\x6c\xcd\x0a\xcc\x02\x8c\x2d\xd3\x90\x8b\x76\x83\xab\x21\xa0\xe2\xb5\x16\xca\x81\xb0\xa0\xb4\x03\x8b\x6e\x1d\xc7\x26\xd3\x6e\x52\xad\x4a\x61\xaa\x80\x44\x8d\x69\x0b\x12\xb4\xa3\x0a\x05\x4a\xab\x77\x82\x36\x6e\x56\x38\x31\x41\xa8\x34\xc7\x03\xf0\x16\xe1\xdd\xbb\x52\x9b\x02\x69\x7c\xed\x83\xa8\x41\x24\x85\xed\x0b\x3e\x21\xde\x4b\x1e\xba\xc6\x20\xe3\x50\x1a\x5d\x81\x50\xb5\x77\x87\x90\xd4\x93\xb6\xe8\xa4\x38\xc6\x92\x79\x49\xcd\x47\xe4\x82\x2e\xc3\x5c\x63\x45\xa1\x7d\xce\xc0\x64\x9b\x77\x92\x9f\x48\x56\x5b\xe4\x87\x49\x70\x3a\x02\x04\xd7\x87\xdd\xda\x4f\xda\x49\x60\x5f\x1d\x86\x24\x5c\x7b\x47\x7a\x38\x73\x78\x00\xc2\xc1\x94\x59\x90\xcc\x3a\xf0\x35\xfd\x1f\x07\xe6\x68\x8f\xb8\x8b\x7f\x0d\x5c\x72\xa7\xd9\x3b\xcd\xb6\xce\x10\x24\x0d\x43\x49\x0b\x60\x7b\x91\xab\xe6\x09\xf2\x89\x30\x5a\x55\xa8\x1c\x4c\x98\x11\xec\x5e\x22\x75\xce\x05\xab\xb0\x69\x36\x4f\x83\x7c\xfb\x6e\xfa\xcf\xb5\xa0\x4d\x2b\xce\x1e\x83\xa5\x41\x3b\x06\xa7\x1f\x30\x2c\x2a\xaf\x1e\x94\x9e\xa6\x8e\xe1\x4c\xe3\x4e\xe2\xd3\xc1\xf0\xec\xe4\x38\x01\x7c\x74\x79\x0e\xa7\x83\xb3\x3f\x0c\xba\x45\x9f\x86\x23\x87\xd6\x30\xe3\x1c\x2a\xa4\xc0\xcf\x86\x3f\x8b\x02\xad\x85\x91\xd1\xbe\x0e\xb3\xe5\x3d\xfd\x1a\x1e\x53\x1c\x45\x9d\x72\x1e\x9b\x26\xe7\xdb\x1e\x80\x37\x08\x5e\x74\xd2\x70\x70\x1e\x7b\x39\x23\xc0\xc8\xb5\xce\xa4\xbe\x1b\x0c\xbe\x82\xba\xdb\xba\x93\x9a\x54\xe6\x1f\x34\xa9\xd6\xdd\xd0\x17\xa7\x97\xa9\xbd\x2b\xbe\xeb\x36\xa3\x1d\x3c\x6e\xcd\xd6\x71\xa1\x00\x3f\x53\xd0\x61\xc3\xe4\x97\xa2\x12\x61\x43\x99\xcf\xfb\x67\xf4\xbb\x69\xe0\x7e\xe6\xd0\xa6\x78\x76\x03\x4b\x08\x9b\x30\x29\x38\xb0\x95\x70\x65\xd9\x1d\x34\xe3\x16\xdb\x4c\xd3\xf4\x92\x82\xb6\x02\x59\x2b\xa4\xd0\x55\x45\xd1\x56\x6f\xb9\x95\xf4\x32\xa6\x4b\xae\xf5\x5a\x6a\xee\x4d\x54\x4e\xd6\xbf\x30\xe9\xb1\x69\x7a\x7d\xb8\xb3\xb8\xcc\xf2\x60\x2a\xdc\x18\x18\x78\x15\x47\xac\xa7\x6c\xef\x00\x7a\x3e\x3c\xab\xf0\x0c\x8f\x8a\x1e\xe3\x1e\x68\x03\x3d\xde\x3b\x00\xec\x8f\xfa\xd0\xfb\xf9\x87\xaa\xd7\xdf\xe0\xc1\xef\x24\x62\x6d\x47\x28\x56\x61\x08\xea\x76\x1c\x85\xcd\xf6\x6b\xe9\x3f\x79\xa6\x9c\x70\xb3\xcd\x5d\xa0\x40\x87\x8c\x81\xc9\xa7\xce\xf8\x20\xc8\xed\xf3\xf0\x7c\x1f\x9e\xb7\xe1\x79\x15\x9e\x0f\xf4\x38\xa7\xc7\x7b\x7a\xdc\xc6\x21\xba\x5a\xf6\xce\x4f\xef\xc5\xc6\x21\xfa\xdf\xeb\x5b\xdb\x7d\xd6\x31\x87\x20\x54\xd8\x5b\x56\x97\xe4\x22\xd9\xdd\xe0\x60\x0e\xc2\x5a\x09\x8e\x99\x11\xba\x2d\x66\x4c\x87\xc1\x7a\x82\x78\x8c\x24\x50\x6f\xe9\x2d\x08\x35\x79\xfc\xbb\xa4\x50\x32\x11\x07\x9f\x7b\xe9\x44\x2d\x29\x80\xb0\xda\x53\xec\x1f\x8e\x59\x1b\xe6\xef\xca\x1e\x02\x53\x34\x18\x83\xa9\x98\x2c\xb8\xf1\x4b\x2b\x18\x1e\x83\x50\xd6\x21\x4b\x85\x6b\xdf\x8c\x6e\xbd\x73\x16\xcd\x44\x14\x34\x9c\xd6\x31\x55\xe0\x26\x3e\x5b\x63\x21\xca\x59\x17\xa7\x36\x4b\x35\x47\xd7\x17\xb9\xee\x7e\x7b\x01\x9d\x1d\x40\xd0\x2b\x1c\x85\x56\x8e\x09\x65\x69\x62\x84\x49\x54\x8c\x99\x61\x05\x15\xf1\xa8\xd9\xd1\x98\x99\xb0\x8e\x2f\x95\x9c\x81\x44\xe7\xd0\xd8\x03\xe0\x62\x24\x9c\x0d\xb9\xf9\x78\x56\x8f\x51\x59\x60\x06\x81\x49\xa9\xa7\x98\xf2\xfd\xf7\xe1\xce\x73\xbb\xf2\xd6\xc1\x3d\x95\xf7\xa6\x68\x0a\x66\x31\x57\xf3\x6b\xc3\xed\x08\x2d\xd6\xcc\x50\x02\x04\xf7\x33\xb0\x42\x8d\x24\x42\x38\x15\xa2\x47\xa1\x59\x88\xb5\x1c\x33\x8e\x86\x16\x15\x6f\xf7\xcd\xb5\xc5\x87\x6f\x48\xb8\x85\x83\xa4\xbc\x1d\xd5\x96\x64\x2b\xb9\x1d\xe6\x5b\x92\x47\x2f\x5a\xf9\x71\x7a\x6c\xad\xa0\x0b\x23\x2d\x63\x69\x76\x8f\x80\x55\xed\x66\xeb\xf8\x5e\x37\xee\x06\xd6\xb0\x5a\x4c\xc2\x67\x69\xa5\xb0\xb4\x70\x4a\x31\xf2\x26\xbd\xd4\xf2\x01\x52\x02\xda\x1c\x2b\x66\x5b\xa1\x06\x32\x9f\xf7\x07\xf1\x27\xa5\x70\x6d\xa2\x65\x2d\x1b\xa5\x0b\xa3\xdb\xe3\xac\x91\x13\x8c\xe3\x99\xb8\xce\xf1\x57\x2d\x93\x90\x2b\x67\x78\xa1\xf9\x6e\xf1\xc1\x2e\x48\x29\x49\x1c\x25\x8e\xc2\x62\x7d\x91\xbe\xab\xc5\xc6\x1f\xec\x29\xc8\x1e\x0e\xce\xd3\x82\xb6\xc4\x49\xc8\x71\x74\xfb\x31\x0a\x25\xc2\x24\xd5\xf3\x36\x49\x98\x9a\x2a\xb6\xce\xb5\xb9\x7c\x9c\x10\xc5\x58\x48\x9e\x98\x13\x8b\x1a\x06\x52\xd1\xb0\x36\xc2\x62\xe6\x6c\xfb\x06\x54\x9d\x4e\x5d\x7e\x48\x48\xb8\xfc\xd0\xdd\x0b\x57\x1f\x8e\x4e\xe2\xc4\x98\xa0\x11\xa5\x40\x93\x79\xfa\x25\x78\x76\xc7\xcb\x95\xb7\x38\x3f\xfe\xef\x67\x2a\x75\xfc\xf8\xd3\xff\x3f\xe1\x59\x90\x5a\x8d\xf2\x95\x6d\x86\xea\x16\x25\x91\xd9\x76\x64\xa0\x37\xa3\xf0\x5f\xd1\x63\x86\x36\x26\x00\x4a\xaf\xc9\x4a\xc2\xfd\xe2\x2b\x2b\xdf\x5a\x6d\x26\x5c\x26\x2d\xf7\xe8\xa6\x88\x0a\x7e\x24\xf1\x14\x12\xd1\xd4\x69\x9a\x0d\xcc\x4f\x37\x9b\xe4\x80\x41\xf8\x11\x70\xc5\x3a\x47\x41\x1c\xc7\x52\xea\x78\xdb\x19\x05\xe5\x13\x97\xd2\x3b\xca\xca\x10\xda\x98\x7f\x1b\xd6\xf5\x64\xc7\x14\x84\xe1\x73\xb2\x2d\x28\x26\x94\x1d\x6e\x76\x63\xc2\xa4\x36\x49\x3c\x3f\x12\x6a\xe5\xfc\x16\x16\xee\xbd\x90\xed\xc9\x7d\x73\xfc\x81\xe6\xb2\xa5\x04\x8f\x12\xd2\xf8\xb3\x69\xe8\xa2\xb3\x18\x53\x45\x4b\x4b\x8e\x06\xdc\x98\x2d\xf6\x44\x2a\x93\xa0\xe2\xc8\x9f\x1b\x9e\x0b\xb5\xb4\xed\x43\xac\x91\x87\xf6\x75\x54\xd0\xde\x49\x49\xe6\xd0\xba\x85\x61\xca\xb7\xef\x5d\x75\x6e\x57\xb7\x97\x3b\x96\x34\x1e\x9d\x0d\xdb\xe2\xf6\xd1\xd9\x30\xa5\x81\x96\x2b\x91\x99\x03\xb8\xf7\x2e\xf4\x58\xb8\x91\x55\x4b\x72\xea\x88\xe7\x1e\xaf\xa8\x26\x64\x0a\x56\x9d\x99\x01\x1b\x31\xb1\x4d\x07\x7f\x07\x5a\xbb\xbb\xd5\x88\x09\xd9\x2c\x0b\x82\xba\x5c\x26\x85\xa4\xff\x26\xfe\x26\x17\x84\x5a\x5c\x2b\xd1\x8b\xeb\xf0\x33\xf7\x3a\x64\xef\x34\xdd\xce\xf8\x7b\x29\x8a\x6f\xee\xcb\x9e\x59\x3a\x5d\xb9\x3e\xf9\xe3\xdd\xc9\xcd\x6d\xaa\x9c\x7d\x73\x79\x36\x3c\x1a\xde\x0e\x1e\xff\xf6\xf8\xd7\x54\x5d\xfb\xfa\xe4\xe6\xea\xf2\xe2\xe6\x24\x85\x11\xde\xdf\xdc\x0e\x52\xe6\x4f\xca\x17\x93\xb8\x2d\xc0\x87\x9d\xb9\x0f\xbf\xd0\x3f\xad\x83\x21\xf9\x0d\x21\x4b\xec\xcb\xf4\x6d\xca\x57\xc3\x26\xc4\x56\xda\x51\x5a\x6b\x26\x68\xe2\xd7\x1a\x7d\xb8\x71\xcc\x79\x4a\x53\x78\x0c\xdc\xe2\xdf\xf1\x7b\x84\x83\xf6\x9b\x8c\xe5\xcb\x50\xfd\x5c\xbc\xab\x62\xdc\x95\x15\xee\x91\x21\x70\x1d\xb8\x05\xd7\x06\x0c\x56\xda\xe9\x3e\x1c\x3d\xfe\x9b\x8b\x11\x45\xc1\x94\xd5\x3a\x6f\x3b\x44\x14\x4f\x6d\x48\x4f\x97\x12\x45\xec\x55\x4e\x38\x78\xbd\x5a\x90\x79\xde\xc5\x39\xf3\x3a\xdb\xbc\x93\xfc\xe6\x45\x25\x69\x6b\xfa\x2d\x00\xba\x05\x8c\xf5\x94\xc2\x93\x1f\x68\x41\xce\xe7\xfd\x5b\xed\x98\x4c\x8e\x5a\xaa\xf5\x5a\xe8\x38\x7c\xc6\x35\xcd\x3b\x1a\x27\xc5\x9b\xe6\x85\xf9\x7a\xb2\xcd\xf6\x9d\xf4\xb7\x74\xb2\xeb\x82\x49\xfa\x2e\xa5\x78\xa0\xf5\xa2\xcb\x92\x0a\x29\xf3\x79\xff\xb2\x2c\x2d\xd2\x45\x52\xb8\xc0\x72\xe3\xe5\x22\x08\x6d\x0f\x16\x47\xb6\x0a\xab\x8b\xc2\x83\x58\x9f\xb5\x7d\xb8\x99\xa9\x62\x6c\xb4\x12\x5f\xe2\x91\x61\x67\xd6\x61\xd5\x72\x64\x9d\x73\xdf\x81\xb0\x64\x87\x2d\xb6\x64\x61\xc1\x61\x55\x6b\xc3\x8c\x90\x33\xf0\x8a\x4d\x98\x90\x74\x21\xbe\xce\xab\x1c\xeb\x34\x75\x28\xd1\xeb\xb2\x33\xf5\x0e\x5f\xdc\x65\x97\x6b\x76\x86\xeb\x16\x27\xa8\xb6\x4b\x5f\x48\x4c\x99\x08\xb1\x7c\xa9\x4d\x07\x6c\x9b\x9f\xdf\x1b\x3d\xb5\xc9\xaf\x27\x77\x04\xeb\x16\xb6\x18\x50\x3a\x32\xc3\x6e\xef\xcc\x6c\x50\x3a\x34\xe9\x0c\x67\xbd\xcd\x06\x9a\x10\x05\x6e\x46\x6e\x9b\xa5\xc0\xda\xef\xdb\xc2\xb5\x66\x72\xf1\xbf\x6e\xd7\x09\x77\xa7\x68\x56\x51\x48\xcc\x31\x7e\xbf\xd6\x5e\x2d\x68\xf9\x54\xc6\x89\x05\x83\xa7\x53\x22\x49\xba\x2b\xda\x06\x69\x54\xf2\x97\x93\xa5\x29\x54\x4c\xb1\x11\x86\x7a\xe0\x32\x1c\x0a\xcb\x7d\xe5\xde\x3e\xef\xa2\x7a\xdf\x2c\x99\xae\x2c\x6f\x31\xa8\x10\x62\xb4\x94\x68\x9e\x30\xf7\xe7\xcb\x57\xd2\x6c\x70\xc6\xb2\xc9\x32\xa9\x8a\x45\xd5\xe4\xfd\xdb\xc5\xe3\x6f\x1a\x1e\xff\x09\xb5\xb6\xf6\xf1\x5f\x13\x94\x60\x99\x9c\x30\xca\xb8\x17\xe5\xd8\xf8\x05\x2f\xc5\x34\x04\xf9\x4e\xa8\xd4\x25\xdd\x1d\x45\x23\x74\x02\x76\x5c\xed\x03\x8d\x17\x85\x6c\x50\x4a\x36\x0a\x0e\x9d\x4a\x36\xa2\x37\xed\xce\x1f\x23\x12\x8e\x85\x64\xe9\x12\xf2\x5e\x29\x3a\x9d\xf8\xd3\xe0\xfa\x62\x78\xf1\x3e\x15\x25\x2f\x5f\x77\x1a\xff\xaa\xbd\x69\x8b\x9a\x5c\xd3\xf5\x9d\x76\x30\xa6\xc1\xa0\x05\x16\x8a\x80\xd6\x2e\x36\xea\xf0\x99\x63\xdc\x23\xe9\xa0\xac\x31\x7e\x4c\x90\x15\x64\xee\x9f\x67\x93\x3b\x92\x15\x0f\xb6\xcd\x5b\x22\xe6\xb3\x34\x76\x1f\x7e\x7c\x2d\x41\xa7\x03\x41\x6e\x5c\x69\x4d\xb3\x32\x57\x68\x72\x4b\x51\x38\xdb\x5e\xa9\xd0\x97\x39\xc2\x86\x43\x50\xab\xbc\x48\x7f\x4f\xe0\x29\xe1\xb7\xb3\xfa\x25\x6e\x7b\xe8\xc7\x3b\x84\xac\x28\x7a\x7b\x9c\x37\x00\xcd\x9b\xbf\xfc\x77\x00\xa3\xb3\xba\x02\x44\x31\x00\x00")
 
 func i18nResourcesPt_brAllJsonBytes() ([]byte, error) {
 	return bindataRead(
@@ -237,7 +237,7 @@ func i18nResourcesPt_brAllJson() (*asset, error) {
 	return a, nil
 }
 
-var _i18nResourcesZh_hansAllJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x94\x4d\x4f\x22\x59\x14\x86\xf7\xfc\x8a\x13\x36\xb5\x21\x24\x6e\xd9\x19\xa7\x26\x31\xf3\xa1\x23\xe3\x6a\x98\x45\x49\x1d\xb0\x32\xc5\xbd\x4c\x7d\x60\x08\xa9\xa4\x20\x63\xc4\xaf\x98\x19\x45\x46\x06\x33\x9a\xd1\xc4\xc5\x08\x9a\xb6\x31\x0a\x36\xff\x05\xa9\x5b\xb0\xe2\x2f\x74\xca\x6a\x68\x63\x73\x5b\x70\x43\x8a\x3a\xf7\xbc\xef\x73\x72\xeb\xbc\xbf\x04\x00\x72\x01\x00\x80\xa0\x22\x07\x23\x10\x8c\x11\x91\x18\xa8\x81\x04\xc4\x4c\xad\xa0\x16\x0c\xf9\x55\x43\x93\x88\xae\x4a\x86\x42\x89\x7f\xac\x57\xbf\xed\x7d\xd8\x77\xd6\xcf\x59\xe9\xca\xb9\x2c\x07\x03\x00\x56\xe8\xa5\xda\x2c\x01\xd4\x34\xaa\x01\x8d\xc7\x4d\x4d\x43\x19\xd6\x56\x91\x40\x5c\x43\xc9\x50\x48\x12\x54\x9a\x84\x84\xa2\x22\x08\xb9\x5c\x78\x51\x32\x56\x2d\x4b\x88\xc4\x48\x2e\x17\x16\xbd\x36\xcb\x8a\x91\x18\xe1\x20\x38\xc5\x7f\x9c\xe6\x3d\x2b\x9f\x3b\xed\x32\x3b\xdc\xe8\x36\x1b\x1d\xbb\x3a\x92\xe9\xd8\xc7\xac\xdc\x70\xf6\xfe\x74\x0f\xfe\xed\x1f\x1c\xf5\xea\xf5\x41\xab\xf2\x85\xf2\xc4\xd0\x1e\xa3\x6c\xa6\xd2\x1e\xb4\x86\xbf\x9b\xa8\x1b\x2f\x38\x39\x94\xbd\x87\xff\x9d\xc2\x45\xaf\x7e\xcb\xae\x0b\xaf\x01\xbd\x15\x47\x4f\x53\xa2\xe3\x34\x3c\xce\xfe\xae\x73\x7f\xf0\x36\x9e\x39\x6a\xaa\x32\x10\x6a\x80\x86\x92\x0c\x09\x8d\xa6\x40\x21\x69\xd3\x88\x00\xc7\xf3\x6b\x1d\x63\x2d\x44\x55\x4a\xeb\x28\x47\x38\x7a\x6e\x73\xaf\xd7\xde\x60\xe5\x46\xbf\xd4\x1e\xb4\x2a\xe3\x35\xbe\x9d\x9d\xff\x5e\xfc\x86\xf7\xed\x9c\x5d\xf7\x6e\xce\xc7\x37\xce\x93\x8c\xa4\x2a\x32\x18\xf4\x37\x24\xdc\x99\xba\xcd\x33\x77\x73\x87\x95\x4f\x58\xa9\xc8\x65\x58\xf8\x8e\x37\xc1\x69\xcd\xa9\x71\x9a\x16\x55\x94\x74\x04\x7c\x5a\x43\x21\x2b\x84\x40\x20\xde\x4f\x16\x75\x01\xa8\x06\x02\xa1\x42\x98\x77\xbb\xc3\xa5\xec\xd8\xd5\x6c\xc7\x3e\x7e\xb4\xf3\x1d\xbb\x4a\x46\x4f\x59\xd4\xbd\xc5\x28\x1e\x7a\x6f\xe9\xd3\xeb\xc2\x04\x14\xc3\x30\x80\x15\x34\xd6\x10\x09\xcc\x80\x41\x21\x97\x0b\xcf\x51\x93\x18\x96\xf5\x2a\x0e\xcc\x80\x53\xbc\x7a\xd6\x01\xdd\xbb\xed\x7e\xf9\xc6\xad\xfc\xe1\xc7\xc7\xa4\x1c\xfe\xd5\x24\x54\xea\xe7\x87\x8f\xf5\xaa\x3d\xab\x6e\xb2\x52\xd1\x33\x7b\x5f\x73\x0b\x77\xac\x74\x35\x9d\xdf\xd4\x36\x53\xcc\x94\x91\x54\x13\x27\x97\x76\xec\x16\x57\x77\x49\xfc\x69\x59\x8c\xfe\xcc\x5b\x1b\x3f\x84\x78\x3b\xb7\x24\x46\x17\x17\x7e\x8c\x8a\xbc\x6e\x3f\x32\xb8\xdd\x98\xa2\x06\x82\x8e\x5a\x06\x35\x3f\xaf\xc2\x10\x35\x24\xc3\xd4\x21\x4e\x65\x8c\x78\x77\xef\xff\x9f\xa3\x32\x5a\x56\xe8\x53\xa8\x8d\x8a\x4f\xa9\x33\xac\xa5\x50\xd7\xa5\xa4\x5f\xf8\xc1\x7f\xb6\x2c\xde\x58\xed\xaa\x7b\xb1\xcd\xaa\xbb\xce\xd6\xa9\x73\x74\xe1\x67\xd9\xa3\x5d\x70\xb7\x1a\xcc\xce\xbb\x27\xf9\x41\xab\xf2\xc2\x7c\xd0\xda\xf1\x8f\x75\x9b\xff\x8d\x0e\x3c\x03\x18\xb4\x76\x58\xa3\xc8\xf2\x75\xbf\xf2\x99\x60\xec\xec\xcb\x44\x5a\x51\xd1\xdb\x08\x5d\xca\x20\xa4\x55\x33\xa9\x10\x88\x53\x92\x50\x92\xdc\xfc\xf0\x92\xe3\x5d\xa9\xdb\x3e\x76\x2e\xff\x66\x7b\x7f\x75\x9b\x8d\xfe\xfa\xae\xfb\x50\x1b\x66\x49\xe0\xd7\x8f\x01\x00\x00\xff\xff\xca\x52\xcd\x23\x9b\x07\x00\x00")
+var _i18nResourcesZh_hansAllJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x5a\x5b\x6f\x1b\xc7\xf5\x7f\xf7\xa7\x38\xf0\x0b\x5f\x64\x22\x97\xff\xc3\x1f\x7e\x13\x64\xd9\x10\x6c\xc9\xaa\x2e\x29\x82\xba\x0f\xc3\xdd\x43\x72\xa0\xdd\x19\x66\x66\x96\x0c\x43\x2c\x20\x1b\x0d\xa2\xdc\x10\xb4\x89\xa2\xc6\x55\xd0\x04\x4d\x80\x3c\x34\x76\x82\xa6\x0a\x12\x29\xf5\x77\x71\x44\x4a\x7e\xf2\x57\x28\xce\xcc\x92\x12\xa5\x1d\x72\x49\xcb\xa9\x5f\x56\x2b\xee\x9c\xf3\xfb\x9d\xb9\x9e\xcb\xfc\xe1\x12\x40\xe7\x12\x00\xc0\x65\x1e\x5e\xbe\x0a\x97\xef\x88\x79\x61\x50\x01\x03\x91\xc4\x15\x54\x97\x67\xdc\x57\xa3\x98\xd0\x11\x33\x5c\x0a\xd7\xec\xf8\xe1\x8f\xc7\xff\xf9\xb8\xfb\xf6\xd7\xbd\xed\xef\xba\xdf\xee\x5c\xbe\x04\x90\xce\x9c\xd5\x36\x2b\x00\x95\x92\x0a\x64\x10\x24\x4a\x61\x08\xad\x3a\x0a\x08\x14\x32\xc3\x45\x0d\x22\x59\x83\x2a\x8f\x10\x4a\x9d\x4e\x79\x99\x99\x7a\x9a\x96\xae\xde\x11\x9d\x4e\x79\x9e\xc4\xd2\xf4\x8e\xb8\x23\x3c\x14\xba\x5b\x7f\xeb\xee\xff\xdc\xdb\xf9\xba\xfb\x68\xa7\xf7\xe9\x3b\x87\xfb\x7b\x8f\x37\x77\x07\x6a\x1e\x6f\x7e\xde\xdb\xd9\xeb\x7e\xf4\xe7\xa3\x4f\xfe\xfe\xe4\x93\xcf\x8e\x1f\x3e\x7c\x7a\x70\xff\x9c\xe6\xc2\xa4\x89\x63\x98\xc4\x0d\x22\xad\xf0\x8d\x04\xb5\x39\xc3\xd3\xc3\xf2\xf8\x97\x7f\x76\xef\x7d\x73\xfc\xf0\xc7\xde\xf7\xf7\xc6\x11\x9a\x96\x8e\x6e\x48\xa1\x71\x12\x3e\xdd\x8f\x3f\xec\xfe\xfc\xc9\xd4\x7c\x12\x81\x6f\x36\x30\x30\x18\x9e\xa1\x76\x15\x4e\xe4\x3d\x04\x0a\x8b\xe7\x83\x27\xa6\x2e\x15\x7f\xcb\xaa\x83\x2a\xe3\x51\x26\x35\x27\x43\xf4\x63\x8e\x91\x9a\x06\xca\xa2\x5e\x43\x1d\x28\xde\xa0\x16\xd3\x82\xe7\xe8\x29\x40\x47\x27\x41\x80\x18\x62\x58\x86\xd7\x65\x02\x01\x13\x10\x44\x52\x23\x98\x3a\xd7\xd0\xe2\x22\x94\x2d\x60\x22\x04\x85\x26\x51\x02\x8c\x04\x53\x47\x30\xa8\x62\x2e\x58\x54\x2e\xc4\xf5\x99\x41\x72\x0d\x99\x8b\x64\x12\xc2\x75\x99\x88\x50\xb5\x41\xaa\x9a\x87\xcb\xf9\x76\x05\xd4\xe9\x06\x0b\xb0\x90\x42\xd7\xd2\xaf\xb2\xdf\x6e\x76\x79\x01\x50\x84\x0d\xc9\x85\x01\xae\x41\x48\x03\x1a\xcd\x28\x8c\x71\xa2\xf9\xa0\x52\x54\xb9\x8a\xad\x26\x6a\x4c\xbb\x0c\xa7\xd5\xce\x05\x08\x29\xae\x70\xda\x96\x59\x60\x78\x13\x21\x96\x21\xce\x40\xa2\x11\xae\x5c\xa9\x4a\x15\x20\x8d\xaf\xde\xe0\x0d\xe0\x5e\x62\x17\xa5\xde\x43\x3e\x89\x42\xdb\x35\x0a\x59\x08\x55\x25\x63\xe0\xa2\x91\x98\xab\xe0\xe5\xe3\x97\xc8\x85\xb8\x86\x55\x96\x44\xd4\xbc\x46\x26\xc8\xaa\x9d\x6b\x2c\x08\x64\x52\x64\x60\x0a\x8b\xe7\x82\xcf\x47\xac\xa1\x31\xbc\xea\x51\x7e\xb4\xff\xd1\xf1\xa3\x77\x7a\x3b\x7b\x4f\xb6\x1f\x3d\x3d\xb8\x9f\x6f\xc0\x7c\x36\x13\xf4\xb9\x13\x8f\xd8\xcb\xc4\x10\xa9\x90\x19\x9c\x01\x6e\xa0\xc5\x34\x44\x4c\x1b\x48\x1a\xf4\x5b\x08\xcc\xd0\x46\xb1\xee\xfe\x9b\x35\xde\xed\xe6\xc2\x61\x26\x35\x86\x54\xd2\x58\x54\x69\x15\x4c\x4e\x72\x58\xdc\x03\xde\xe4\x4a\x8a\x18\x85\x81\x26\x53\x9c\x55\x22\xa4\xce\x59\x62\x31\xa6\xe9\xf8\xb9\x50\x5c\x3e\x1f\xfe\xcd\x06\xa7\x9d\xcb\x4d\x21\x85\x55\x85\xba\x0e\x46\x6e\xa0\x5d\x59\x89\xd8\x10\xb2\xe5\x3b\x7f\x0b\x0a\xe7\x02\x5f\x9f\x5d\xb8\x35\x7f\xcd\xa3\xb8\xfb\xd5\xf7\xc7\x3f\x7c\x9d\xcf\xf8\xba\x3d\x74\x68\x15\xb3\x30\x84\x18\xe3\x0a\x2a\x6d\xff\x0d\x02\xd4\x1a\x6a\x4a\x26\x0d\x3b\x55\x6e\xd0\xdb\xc2\x35\x72\xc3\xa8\x47\x16\x5d\x53\xef\x64\xbb\x00\xc5\x63\x08\xf7\x7b\x68\x61\x76\xd1\x75\x71\x01\x17\xa3\xa8\x74\x41\xe8\xf5\xd9\xd9\x67\x80\xce\x97\xce\x85\x26\x96\xc5\x8f\x1a\x5f\xeb\x7c\xd5\x4b\xd7\x6f\xfb\x76\x2f\xf7\x2d\x5f\x8c\xf6\x70\xb7\x39\x6b\x13\x72\x01\xf8\x26\xb9\x1d\xda\xce\xfc\x88\xc7\xdc\xee\x26\x9d\x4e\xf9\x16\xbd\xa7\x29\x54\xda\x06\xb5\x0f\x67\x3a\x65\x1e\x62\x4d\x16\xf1\x10\xd8\x90\xc3\x32\xe8\x0e\x9a\x71\xfd\x3d\x26\x4d\x4b\x5e\x42\x13\x29\x19\x49\x24\x90\x71\x4c\xfe\x56\x69\xb0\x8f\x94\x0a\x4c\x97\xa2\xd2\x23\xa1\xc3\x44\x39\xe6\x24\xfd\x1a\x8b\x12\x4c\xd3\x52\x19\xd6\x35\x0e\xa2\x38\x68\x71\x53\x07\x06\x89\x70\x23\x56\x12\xba\x34\x03\xa5\xc4\x3e\x63\xfb\xb4\x8f\x98\x1e\xf5\x12\x48\x05\xa5\xb0\x34\x03\x58\xae\x95\xa1\xf4\xea\x4b\x71\xa9\x3c\xc6\x82\xdf\x88\xc4\xc8\x8e\x10\x2c\x46\xeb\xd6\x4d\x39\x0a\xe3\xe5\x47\xc2\xbf\x91\x30\x61\xb8\x69\x8f\xef\x02\x01\xd2\xc6\x0c\x2c\x3a\xe9\x8c\x9b\x9c\xcc\x5e\xb4\xcf\x1b\xf6\xb9\x66\x9f\xcb\xf6\xb9\x41\x8f\x45\x7a\xdc\xa0\xc7\x9a\x1b\xa2\xe5\x41\xef\xbc\x72\x83\x8f\x1d\xa2\xff\x3d\xbf\x91\xdd\xa7\x0d\x33\x08\x5c\xd8\xbd\x65\x78\x49\xf6\x43\xdc\x31\x06\x16\xd1\x30\x92\x82\x61\xaa\x86\x66\x82\x19\x93\x23\x30\x1a\xc0\x1d\x23\x1e\xad\x87\xfb\x5f\x1d\xbd\xfb\x41\x6f\xe7\x8b\xde\xf6\x96\xd7\x8d\x5c\x4c\x22\xc3\x1b\x11\xf9\x0e\x5a\x26\xe4\xfb\xdb\x43\x56\xdb\xd9\x3b\xb4\x83\x40\x0b\x15\x3a\x3f\xca\x05\x0b\xa6\x7e\x56\x0a\x16\xae\x01\x17\xda\x20\xf3\x79\x6a\xcf\x0d\x6e\xb4\x71\x1a\x55\x93\x07\x34\x98\xda\x30\x11\xe0\x38\x3c\xdd\xc0\x80\x57\xdb\x79\x98\x52\x0d\xd8\xcc\xad\x2c\x15\x35\xf7\xf9\x13\xc8\xed\x00\x52\x3d\x84\x11\x48\x61\x18\x17\x1a\x78\x36\x85\x82\x3a\x53\x2c\xa0\x14\x1d\x35\x9b\xab\x33\x65\x57\xf1\x6d\x11\xb5\x21\x42\x63\x50\xe9\x19\x08\x79\x8d\x1b\x6d\x63\xf3\x7a\xbb\x51\x47\xa1\x81\x29\x04\x16\x45\xb2\x85\x3e\xdb\x7f\x1b\xec\x62\x66\xc7\x89\x36\x50\x41\x20\x19\x15\x30\x8d\x45\x39\x9f\x17\x9c\x0c\x50\x63\x83\x29\x8a\x7d\xa0\xd2\x06\xcd\x45\x2d\x42\xb0\x67\x82\xb3\xc8\x36\xb3\x9e\x96\x61\xca\xd0\xd0\xa2\x08\xb3\x5d\x73\x64\xf2\xe1\x39\x02\x4e\x60\x20\x31\xcf\x46\x35\x03\x99\x88\x6e\x8e\xf8\x84\xe0\xce\x8a\x8c\xbe\x9b\x1e\x13\x33\xc8\xd3\xe1\xa7\x31\x10\xab\x20\x60\xdc\x30\xed\x51\x78\xe7\x1b\xe7\x2b\x96\x30\x9c\x4c\xc2\x53\x11\x25\xd7\xb4\x70\xaa\xbc\x96\x28\xff\x52\x2b\xae\xc0\x47\x20\x8b\xb0\x5c\xac\x65\x73\x20\x9d\x4e\x79\xd6\xbd\x52\x00\x97\x85\x59\x5a\xb3\x9a\x3f\x31\x3a\xb9\x9e\x11\x74\xac\xb0\x3b\x11\x47\x19\x7e\xae\xa5\x57\xe5\xd0\x09\x1e\xc8\x70\x3a\xef\x60\x1a\x4d\x3e\x4a\x21\x46\x58\xb3\x8b\xf5\x4c\xe4\x2e\xfa\x1b\xbf\x95\x27\x17\x7b\x61\x76\xd1\x4f\x68\x42\x3d\x1e\x3a\x86\xea\x27\x35\x9b\x22\xf4\x42\x9d\x6e\xe3\x55\xd3\xa0\x8c\xad\x31\x59\x24\xef\x26\x44\x50\xe7\x51\xe8\x99\x13\xfd\xf4\x05\x52\xd2\xb0\xa1\xb8\xc6\x82\xb3\xed\x39\x40\xe5\x1a\x75\xfb\xa6\x87\xc2\xd1\x97\x0f\xba\x0f\x3c\x9e\xd5\xf2\xcd\xb9\x79\x37\x39\x9a\xa8\x78\x95\xa3\x2a\x78\x02\x7a\xb0\xa6\xd7\x57\x94\x5e\xff\x0c\xf9\xbf\x57\x29\xd9\xf1\xf2\x2b\xff\x7f\xa2\x4f\x43\x24\x45\xad\x38\xb3\xf1\xaa\xf2\x49\x45\xc8\x74\x36\x3a\x50\x6a\x53\x00\x20\xe8\xd1\x46\xed\x42\x00\x21\xbd\x71\xc9\xa0\x82\xf8\x78\x73\xb7\xfd\x78\xf3\xf3\x5f\x37\xef\x3e\xde\xdc\x15\x83\xb7\x36\x6a\xaa\xe2\x6d\x7d\x4a\xbf\x4a\xfb\xf3\xbd\x02\x2c\x06\xb1\x4c\x05\x4d\x0b\x51\xc0\xcb\x64\x11\xf9\x4a\x34\xa7\xd2\x74\x2c\x1d\x78\x19\xba\x5b\xdf\x9d\x92\x80\xc3\x9f\xde\x7f\xb2\xf3\xc3\xd1\xfd\x3f\xb9\x5a\x67\x51\x1e\x6e\x88\xab\x91\x74\xc5\x4e\x47\x6b\x2c\x7c\x6f\xf7\xdd\xde\xf6\x16\x81\xfd\xfb\xc1\xd1\xbd\x9f\x7a\xdb\xdf\x4d\x86\x37\x31\xcc\x04\x36\x35\x29\x6a\x2c\xae\xba\xbb\x79\x30\x42\x6f\x52\xe3\x62\xe8\x84\xe7\x1a\x2a\x09\x8f\xb2\xb3\x7d\xf5\xda\x4d\x9a\xe9\x9a\x02\x40\x0a\x58\xdd\x6b\x9a\x52\x35\x36\xa8\x53\xc6\x4b\x46\x21\x2a\x30\x75\xd6\xdf\x35\x29\x8d\x82\x22\xc4\xf0\xb4\xe0\x22\x17\x03\xd9\x32\xb8\x04\xba\x6d\xdf\x70\x0c\xb2\xaa\x55\xc4\x0c\x6a\xd3\x17\xf4\xd9\xf8\xa2\xb3\x2e\xda\xd5\x59\xf9\x47\x13\xc7\xb9\x5b\x0b\x59\xe6\x7b\xee\xd6\x82\x8f\x03\x2d\x66\x02\x53\x33\x50\x49\x8c\xed\x31\x5b\xb3\x15\x03\x70\xea\x88\xd3\x16\x0f\xb1\x26\xcd\xe4\xce\x1a\xd5\x06\x56\x63\x7c\x92\x0e\x7e\x01\xb8\xe6\x77\xab\xe2\x4d\x92\x19\x24\x0c\x65\x75\x10\x36\x12\xff\x55\xf7\x4e\x26\x70\xd1\x2f\x3c\xd1\x87\x15\xfb\x5a\xb4\x56\x72\xe1\x30\xf9\xc6\x24\x95\x88\x07\xcf\xdd\x96\x0b\x46\xc9\x35\x65\x65\xfe\x77\xeb\xf3\xab\x6b\xbe\x74\xb7\xbb\x8b\xe1\x2b\x33\xae\xcc\xaf\x2e\xdf\x5e\x5a\x9d\xf7\x49\xbb\x9b\x13\x5e\xe9\x13\xca\xfd\xd9\x9b\x65\xe6\xed\xde\x5c\x86\xd7\xe8\x4f\x66\x99\x8d\x8b\xad\x37\xe3\x3a\xd1\x5f\x66\x79\x66\xb5\x1e\xb2\xb1\x34\x14\xf1\xaa\x26\x2a\x77\x91\xa3\x0c\xab\x86\x99\x84\x22\x98\xd0\xf9\x74\xee\x7f\x77\x55\x61\x26\xbb\xae\x31\xf8\x68\xd3\xa2\xfd\x6f\xb1\x73\xc9\x0a\x79\x82\xc7\x8f\x76\x8f\xbe\x79\xbf\xb7\xfb\x61\xf7\xbd\x2f\xbb\x9f\x7d\xe3\x2e\xe8\xfc\xba\x79\xef\xe8\xbd\xbd\xde\xe6\xdd\xa3\x2f\xee\x3e\x3d\xb8\x7f\x06\xfc\xe9\xc1\x07\xae\xd9\xe1\xfe\x3f\x06\x0d\x4e\x11\x78\x7a\xf0\x41\x6f\x6f\xab\x77\x97\xae\xb1\x8c\x77\x10\x57\x86\x53\x34\xa7\x7b\xb6\xc8\x3c\x2e\x2c\x9e\x0b\xbe\x7a\x26\xb7\x34\x31\xfc\x04\x0a\xf2\x09\xd4\x65\x8b\x3c\x92\x97\x68\x01\x76\x3a\xe5\x35\x69\x58\xe4\x1d\x2c\x5f\xeb\x91\xaa\xdd\xe8\x29\x93\xa6\x57\x68\x9c\x44\x98\xa6\x67\xc4\x47\x83\x8d\x97\xcf\x85\x5f\xa3\x93\x5c\x06\x2c\xa2\x9b\x2a\xc1\x06\x2d\x13\x59\xad\x52\x6a\xa5\xd3\x29\xdf\xae\x56\x35\x92\x3f\x67\x0b\x5a\xa6\x3e\x98\xfb\xb6\xed\x4c\xff\x88\x76\x89\x36\x72\x07\x5c\xbe\x56\x97\x61\xb5\x2d\x82\xba\x92\x82\xbf\xe5\x8e\x08\xdd\xd6\x06\xe3\x0c\xa3\xd0\xb9\xf6\x02\x10\xf3\x76\x58\x7f\x0b\xe6\x1a\x0c\xc6\x0d\xa9\x98\xe2\x51\x1b\x12\xc1\x9a\x8c\x47\x54\x1d\x1f\x65\x55\x11\x69\x3f\xb4\x4d\xd9\xcb\x6a\x6e\x30\x6e\xaf\xd9\x15\x4e\xe0\x4c\xad\x2e\x9f\x1c\xa7\x6c\x2f\x5d\x97\x68\x31\x6e\xdd\xf7\xaa\x54\x39\x6a\xb3\x88\xbd\xa2\x64\x4b\x7b\x6f\x4b\x4e\xa9\x2c\x9f\x58\x7f\x40\xe9\x88\xb4\x9b\xbc\x51\xed\xd9\xaa\x41\xe5\x0f\x6d\x46\xcb\x8c\x81\xb1\x5e\xdf\x78\xcd\x59\x33\x9f\xb2\xec\xc6\x9b\x2d\x73\x7a\x17\xff\xf9\x76\xb9\xea\xd6\x05\xcd\x2a\x72\x81\x43\x74\x37\xda\xb2\x62\x83\x8c\x4e\x12\x3b\x2e\x85\x30\x54\x9a\xc9\x07\x9d\x56\xdb\x18\x6a\x54\x04\x88\x9a\x03\x51\x88\x99\x60\x35\xb4\x19\xc2\x81\xfb\x63\x97\xfb\x50\x1d\xbf\x58\xe1\xfa\xa2\x51\x0a\x9a\x32\xa8\x6b\x50\x5a\x44\xc9\x28\x42\x75\xa2\xf3\xe2\x6c\x79\x46\x98\x31\xc6\x68\xd6\x1c\x04\x51\x2e\xcd\xea\xad\xc7\x51\x25\xee\x5f\xdb\x87\x8f\x3e\xef\x7e\xfb\xd7\xde\x47\x7f\x39\xdc\xdf\x7b\xf2\xf6\x87\x47\xbf\x3c\xf0\xd6\xe6\xd6\xc9\xe5\xa0\x63\x2e\xa7\x9e\x0f\x34\x28\xe4\x8e\x41\x35\x62\x35\xcb\xfa\x7a\xc4\x6a\xf4\x25\xdb\xde\x9d\xdb\x11\x62\x10\x31\x7f\xe6\xf8\x42\x21\x72\x8d\xf8\xfd\xec\xca\xd2\xc2\xd2\x0d\x9f\x03\x3c\xf8\x9c\x2b\xfc\xba\x4c\x54\x96\xcb\x0c\x25\x55\xed\xa4\x81\x3a\xf5\x38\xad\x22\x9b\xfb\xd3\xba\xbf\x1b\xdb\xdb\x8d\x6e\x23\xa4\xd3\xb0\x81\xee\x06\x41\x21\x07\xf2\xe2\x71\xc6\x99\x13\xb1\x60\x43\x67\xc1\x88\xd3\x79\x2a\x36\xbd\x08\x3b\x9e\x15\x20\xd7\x00\x4b\xd7\x2d\xa7\x34\x1d\x9a\x2b\x34\xf7\x23\x1e\x18\x9d\x55\x52\xe8\x3a\x0e\xd7\xf6\xa4\x93\xa2\x98\x17\x7f\x41\xca\x7d\xc4\xd7\xda\x8d\xb3\x7a\xb3\x93\xdd\x95\x0e\x0a\xb9\xca\x93\xeb\xb9\x04\x90\x5e\xfa\xe3\x7f\x07\x00\x36\x5c\xaf\x72\x19\x31\x00\x00")
 
 func i18nResourcesZh_hansAllJsonBytes() ([]byte, error) {
 	return bindataRead(
@@ -257,7 +257,7 @@ func i18nResourcesZh_hansAllJson() (*asset, error) {
 	return a, nil
 }
 
-var _i18nResourcesZh_hantAllJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x54\x4d\x4f\x1a\x4f\x18\xbf\xf3\x29\x9e\x70\xd9\x0b\x21\xf1\xca\xcd\xf8\xdf\x7f\x62\xfe\x2f\x5a\xa9\xa7\xd2\xc3\xca\x3e\xe0\xa6\xcb\x0c\xdd\x17\x8c\x21\x9b\x00\xc1\xa8\x05\xdb\x83\x82\x82\x46\x5b\xa3\x0d\xd1\xa8\xb5\x4d\x5b\x8b\xdb\x7e\x19\xdd\xd9\xe5\xc4\x57\x68\x60\xaa\x35\xd6\x11\xf1\x32\xd9\xcd\x33\xbf\x97\x99\x67\x9e\xdf\xb3\x10\x40\x3e\x04\x00\x10\xd6\xd4\x70\x0c\xc2\x09\x22\x13\x0b\x0d\x50\x80\xd8\x99\x19\x34\xc2\x11\x5e\xb5\x0c\x85\x98\xba\x62\x69\x94\xf0\x6d\xc1\x61\x25\x70\xcf\xbc\x85\x7d\x56\x3b\xf3\x8e\xd6\xc3\x21\x00\x27\x72\x9b\x6d\x94\x00\x1a\x06\x35\x80\x26\x93\xb6\x61\xa0\x0a\x73\xb3\x48\x20\x69\xa0\x62\x69\x24\x0d\x3a\x4d\x43\x4a\xd3\x11\xa4\x7c\x3e\x3a\xa9\x58\xb3\x8e\x23\xc5\x12\x24\x9f\x8f\xca\x3d\x98\xe3\x24\x48\x82\x08\x2c\x78\xe7\x6d\xff\xb0\xc2\xd6\xf7\x83\x83\x2a\x3b\x58\xbb\x49\x01\xac\x51\xf2\x1b\xae\xbf\xb6\xd3\xa9\x9e\x04\x07\x7b\x5d\xb7\xf9\x07\xe9\x83\xfd\xf6\xec\xa9\x76\x26\xdb\xf3\x6b\xe0\x4b\x1b\x4d\xeb\x96\x45\x91\xc1\xd2\x0f\x6f\xb1\x1d\xbc\x2f\xb2\xd3\xd2\x20\x43\x8f\xb5\x63\x66\x29\x31\x71\x18\x3f\xde\xe6\x36\x5b\x5c\x7e\x9c\x9f\x31\x6a\xeb\x2a\x10\x6a\x81\x81\x8a\x0a\x29\x83\x66\x40\x23\x59\xdb\x8a\x81\x40\xf3\x3e\xc4\x9d\x12\xb2\xae\x64\x4d\x54\x63\x02\x3e\xff\xcb\x2a\x3b\xfa\xca\x1a\xa5\x4e\x7d\xb5\xeb\x36\xef\xe6\xf8\x7b\x74\xfc\x5f\xf9\x2f\xd1\x2d\xec\x9d\xb2\x9a\xe0\xb9\x8e\x93\x9c\xa2\x6b\x2a\x58\xf4\x05\x12\xe1\x99\xfc\xf2\x3b\x56\x5b\xf2\x9b\xe5\xa0\xb5\x11\x34\x76\x84\x36\x26\xfe\x11\x11\xec\xb6\xbd\x63\x01\x68\x52\x47\xc5\x44\xc0\xfe\x10\x4a\xf3\x52\x04\x24\xd2\x5b\xe6\xd1\x94\x80\x1a\x20\x11\x2a\x45\x05\xbc\xd7\x23\xd9\x03\x5e\x14\x8a\x12\xe9\xaf\x7d\x28\x5b\xaa\xf7\xb1\x17\x85\xd2\x03\x84\xaf\xa6\x1f\x66\xd0\x9a\x43\x24\x30\x02\x16\x85\x7c\x3e\x3a\x46\x6d\x62\x39\xce\x60\x07\x23\xe0\x2d\x7d\xb8\x81\x80\xcb\x6f\x95\x4e\x7d\xd5\x6f\x96\x79\x5e\x3c\xd4\x07\x6f\x48\x4a\xa7\x3c\x30\xb8\xad\x81\xf2\x6c\x6b\x99\xb7\x88\x7d\x3e\xee\x9c\x6f\xb3\xda\xd9\x70\x7a\x43\xcb\x0c\x71\xa6\x9c\xa2\xdb\x38\x90\xda\x2b\xb8\x42\xba\x29\xf9\xc9\xb4\x1c\x7f\x2a\x9a\x11\x9e\x38\xc2\x67\x39\x25\xc7\x27\x27\xfe\x8f\xcb\x22\x38\x0f\x08\x31\x1c\x33\xd4\x42\x30\xd1\xc8\xa1\xc1\xe3\x29\x0a\x71\x4b\xb1\x6c\x13\x92\x54\xc5\x58\xaf\xe9\xfc\x7f\x8c\xaa\xe8\x38\x91\x5f\x19\x76\x5d\xec\x87\xcc\x55\x2d\x83\xa6\xa9\xa4\x79\xe1\x3f\xfe\xed\x38\x02\x67\x9d\xe2\x5b\xff\xf0\xe4\xd2\x6d\xb3\xad\x15\xaf\xd1\xe2\xd1\x75\x51\x28\xf9\x95\x02\x5b\xa8\xf8\xbb\x6e\xd7\x6d\xde\x12\xef\xba\x55\xbe\xed\xba\x7a\x43\xbd\xeb\x56\x83\xd6\x2b\x56\x3c\xe1\x95\xdf\xf2\x77\x1e\x7c\x9a\x28\x33\x3a\xf6\xe6\xc0\x54\x72\x08\x59\xdd\x4e\x6b\x04\x92\x94\xa4\xb4\xf4\xbd\x59\xf1\xa9\xe6\x95\x3f\x7a\x47\x1b\xde\x5e\x9d\xbd\xde\xf4\x5b\x15\xcf\x7d\xd3\x59\x58\xf1\xbf\x1f\x5f\x5d\x72\xe8\xf9\xcf\x00\x00\x00\xff\xff\x77\x13\xc4\x2e\x88\x07\x00\x00")
+var _i18nResourcesZh_hantAllJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x5a\x5d\x6f\x1b\xc7\xd5\xbe\xf7\xaf\x38\xf0\x0d\x6f\x64\x22\x4e\xde\x8b\x17\xbe\x13\x24\xd9\x10\x6c\xc9\xaa\x3e\x52\x04\x75\x2f\x46\xbb\x87\xe4\x40\xcb\x99\xcd\xcc\x2c\x69\x86\x58\xc0\x16\x6c\xc4\xa9\x9c\xf6\xc2\xb1\x13\x3b\x41\xd2\x06\x4e\x61\xd8\xb0\xdd\xb4\x68\xeb\x3a\x6a\xff\x8c\x43\x52\xba\xf2\x5f\x28\xce\xcc\x92\x12\xa5\x1d\x72\x49\xcb\xa9\x6f\xc6\x94\x77\xce\x79\x9e\x33\x9f\xe7\x63\x7e\x73\x0a\xa0\x7d\x0a\x00\xe0\x34\x0f\x4f\x9f\x83\xd3\x57\xc4\x82\x30\xa8\x80\x81\x48\xea\x9b\xa8\x4e\xcf\xb8\xaf\x46\x31\xa1\x23\x66\xb8\x14\xae\xdb\xde\x93\x9d\xbd\xdd\x17\x9d\x9b\x3f\x74\xef\xbe\xe8\x3c\xfd\xf2\xf4\x29\x80\x74\xe6\xa8\xb6\x59\x01\xa8\x94\x54\x20\x83\x20\x51\x0a\x43\x68\xd6\x50\x40\xa0\x90\x19\x2e\xaa\x10\xc9\x2a\x54\x78\x84\x50\x6a\xb7\xcb\x2b\xcc\xd4\xd2\xb4\x74\xee\x8a\x68\xb7\xcb\x0b\x24\x96\xa6\x57\xc4\x15\xe1\xa1\xd0\xf9\xe9\x65\xef\xc9\x4e\xf7\xcb\x1f\xf6\x1e\xdf\xee\x3e\xfe\xe2\xb0\x0a\xe8\xde\xdf\xee\xdd\xdf\xed\x7d\xf1\xdd\xfe\xed\xe7\x7b\x8f\x1f\xbe\xde\x7d\x70\x4c\x69\x61\xbe\x44\x2f\x4c\xea\x31\xf1\x55\xf8\x71\x82\xda\x1c\xa1\xe8\x23\xb8\xfd\x9f\xce\xa7\x2f\xf7\xfe\x7c\xbd\xfb\xe3\xf6\x38\x42\xd3\xd2\xd1\xb1\x14\x1a\x27\xe1\xd3\xf9\xfa\xdb\xee\xa7\x9f\x4d\xcd\x27\x11\x78\x35\xc6\xc0\x60\x78\x84\xda\x39\x38\x90\xf7\x10\x28\x2c\x9e\x0f\x9e\x98\x9a\x54\xfc\x13\xab\x0e\x2a\x8c\x47\x99\xd4\x9c\x0c\xd1\x8f\x39\x46\x6a\x1a\x28\x8b\x3a\x8f\x3a\x50\x3c\xa6\x1e\xd3\x82\xe7\xe8\x29\x40\x47\x27\x41\x80\x18\x62\x58\x86\x8f\x64\x02\x01\x13\x10\x44\x52\x23\x98\x1a\xd7\xd0\xe4\x22\x94\x4d\x60\x22\x04\x85\x26\x51\x02\x8c\x04\x53\x43\x30\xa8\xea\x5c\xb0\xa8\x5c\x88\xeb\x1b\x83\xe4\x1a\x32\x17\xc9\x24\x84\xf3\x32\x11\xa1\x6a\x81\x54\x55\x0f\x97\xe3\xfd\x0a\xa8\xd3\x31\x0b\xb0\x90\x42\xd7\xd3\xaf\xb2\xdf\x6f\x76\x65\x11\x50\x84\xb1\xe4\xc2\x00\xd7\x20\xa4\x01\x8d\x66\x14\xc6\x38\xd1\x7c\x50\x29\x2a\x5c\xd5\xad\x26\xea\x4c\xa7\x0c\xa7\xdd\xce\x05\x08\x29\xce\x70\x3a\x91\x59\x60\x78\x03\xa1\x2e\x43\x9c\x81\x44\x23\x9c\x39\x53\x91\x2a\x40\x9a\x5f\xbd\xc5\x63\xe0\x5e\x62\x27\xa5\xde\x43\x3e\x89\x42\x3b\x34\x0a\x59\x08\x15\x25\xeb\xc0\x45\x9c\x98\x73\xe0\xe5\xe3\x97\xc8\x85\x98\xc7\x0a\x4b\x22\xea\x5e\x25\x13\x64\xc5\xae\x35\x16\x04\x32\x29\x32\x31\x85\xc5\x73\xc1\x17\x22\x16\x6b\x0c\xcf\x79\x94\xf7\xfe\x71\xa7\xfb\xf4\x9f\xdd\xfb\xdb\xfb\xf7\xee\xbc\xde\x7d\x90\x6f\xc0\x42\xb6\x12\xf4\xb1\xcb\x8e\xd8\xcb\xc4\x10\xa9\x90\x19\x9c\x01\x6e\xa0\xc9\x34\x44\x4c\x1b\x48\x62\xfa\xbf\x10\x98\xa1\x83\x62\xc3\xfd\x35\x6b\xbc\xc7\xcd\x89\xc3\x4c\x6a\x0c\xa9\xa4\xb9\xa8\xd0\x2e\x98\x9c\xe4\xb0\xb8\x07\xbc\xc1\x95\x14\x75\x14\x06\x1a\x4c\x71\xb6\x19\x21\x0d\xce\x32\xab\x63\x9a\x8e\x5f\x0b\xc5\xe5\xf3\xe1\xaf\xc6\x9c\x4e\x2e\xb7\x84\x14\x56\x14\xea\x1a\x18\xb9\x85\x76\x67\x25\x62\x4b\xc8\xa6\xef\xfe\x2d\x28\x9c\x0b\x7c\x7e\x76\xf1\xd2\xc2\xbc\x47\x71\xe7\xe1\x8f\xdd\xbb\x1e\x0f\xec\xbc\xbd\x74\x68\x17\xb3\x30\x84\x3a\x92\x4f\xa7\xed\x9f\x41\x80\x5a\x43\x55\xc9\x24\xb6\x4b\xe5\x02\xfd\x5a\x9c\x27\x0f\x8c\x46\x64\xc9\x75\xf5\x2e\xb6\x13\x50\x3c\x86\x70\x7f\x84\x16\x67\x97\xdc\x10\x17\x70\x31\x8a\x4a\x17\x84\xde\x98\x9d\x7d\x03\xe8\x7c\xe9\x5c\x68\x62\x59\xfc\xaa\xf1\xf5\xce\x57\xbd\x7c\xfe\xb2\xef\xf4\x72\xdf\xf2\xc5\xe8\x0c\x77\x87\xb3\x36\x21\x17\x80\x57\xc9\xed\xd0\x76\xe5\x47\xbc\xce\xed\x69\xd2\x6e\x97\x2f\xd1\xef\x34\x85\xcd\x96\x41\xed\xc3\x99\x4e\x99\x87\x58\x83\x45\x3c\x04\x36\xe4\xb0\x0c\x86\x83\x56\x5c\xff\x8c\x49\xd3\x92\x97\xd0\x44\x4a\x46\x12\x09\x64\xbd\x4e\xfe\x56\x69\x70\x8e\x94\x0a\x2c\x97\xa2\xd2\x23\xa1\xc3\x44\x39\xe6\x24\xfd\x21\x8b\x12\x4c\xd3\x52\x19\x36\x34\x0e\x02\x38\x68\x72\x53\x03\x06\x89\x70\x33\x56\x12\xba\x34\x03\xa5\xc4\xb6\x75\xdb\xda\xa6\x4e\x4d\xad\x04\x52\x41\x29\x2c\xcd\x00\x96\xab\x65\x28\x7d\xf0\x5e\xbd\x54\x1e\x63\xc1\x2f\x44\x62\xe4\x40\x08\x56\x47\xeb\xd6\x4d\x39\x0b\xe3\xe5\x47\xc2\x7f\x9c\x30\x61\xb8\x69\x8d\x1f\x02\x01\xd2\xc6\x0c\x2c\x3a\x18\x8c\x8b\x9c\xcc\x5e\xb2\xed\x05\xdb\xae\xdb\x76\xc5\xb6\x5b\xd4\x2c\x51\x73\x81\x9a\x75\x37\x45\x2b\x83\xd1\x79\xff\x02\x1f\x3b\x45\xff\x7b\x7e\x23\x87\x4f\x1b\x66\x10\xb8\xb0\x67\xcb\xf0\x96\xec\x87\xb8\x63\x0c\x2c\xa2\x61\x24\x05\xc3\x54\x15\xcd\x04\x2b\x26\x47\x60\x34\x80\xbb\x46\x3c\x5a\x7b\x37\xfe\xd4\xbd\x7b\xab\xf7\xe0\xc6\xde\xa3\xaf\xf6\xee\x7f\xe7\xf5\x24\x97\x92\xc8\xf0\x38\x22\xf7\x41\xcb\x84\xdc\x7f\x7b\xcf\x6a\xbb\x80\x87\x0e\x11\x68\xa2\x42\xe7\x4a\xb9\x78\xc1\xd4\x8e\x4a\xc1\xe2\x3c\x70\xa1\x0d\x32\x9f\xb3\xf6\xd6\xe0\x46\x1b\xa7\x51\x35\x78\x40\xf3\xa9\x0d\x13\x01\x8e\xc3\xd3\x31\x06\xbc\xd2\xca\xc3\x94\x6a\xc0\x66\x6e\x75\xb9\xa8\xb9\x6f\x9f\x40\xee\x00\x90\xea\x21\x8c\x40\x0a\xc3\xb8\xd0\xc0\xb3\x55\x14\xd4\x98\x62\x01\x25\xe8\xa8\xdb\x5c\x8d\x29\xbb\x91\x2f\x8b\xa8\x05\x11\x1a\x83\x4a\xcf\x40\xc8\xab\xdc\x68\x1b\x9e\xd7\x5a\x71\x0d\x85\x06\xa6\x10\x58\x14\xc9\x26\xfa\x6c\xff\x65\xb0\x8b\x99\x5d\x4f\xb4\x81\x4d\x04\x92\x51\x01\xd3\x58\x94\xf3\x71\xc1\xc9\x00\x35\xc6\x4c\x51\xf8\x03\x9b\x2d\xd0\x5c\x54\x23\x04\x7b\x2d\x38\x8b\x6c\x37\xeb\x6c\x19\xa6\x0c\x4d\x2d\x8a\x30\x3b\x38\x47\xe6\x1f\xde\x22\xe0\x04\x06\x12\xf3\x6c\x56\x33\x90\x89\xe8\xe6\x88\x4f\x08\xee\xac\xc8\xe8\xbb\xe5\x31\x31\x83\x3c\x1d\x7e\x1a\x03\xb1\x4d\x04\xac\xc7\xa6\x35\x0a\xef\x78\xe7\x7c\xc5\x12\x86\xf3\x49\x78\x28\xa8\xe4\x9a\x36\x4e\x85\x57\x13\xe5\xdf\x6a\xc5\x15\xf8\x08\x64\x41\x96\x0b\xb7\x6c\x1a\xa4\xdd\x2e\xcf\xba\x9f\x14\xc3\x65\x91\x96\xd6\xac\xea\xcf\x8d\x4e\xae\x67\x04\x1d\x2b\xec\x2e\xc5\x51\x86\x1f\xeb\xe9\x55\x39\x74\x89\x07\x32\x9c\xce\x41\x98\x46\x93\x8f\x52\x88\x11\x56\xed\x66\x3d\x12\xbc\x8b\xfe\xc1\x6f\xe5\xc9\xcb\x5e\x9c\x5d\xf2\x13\x9a\x50\x8f\x87\x8e\xa1\xea\x49\xd5\x66\x09\xbd\x50\x87\xfb\x78\xd5\xc4\x94\xb4\x35\x26\x0b\xe6\xdd\x82\x08\x6a\x3c\x0a\x3d\x6b\xa2\x9f\xc1\x40\xca\x1b\xc6\x8a\x6b\x2c\xb8\xda\xde\x02\x54\xae\x51\x97\x2f\x7a\x28\xf4\xbe\x7f\xd9\x79\xe6\xf1\xac\x56\x2e\xce\x2d\xb8\xc5\xd1\x40\xc5\x2b\x1c\x55\xc1\x1b\xd0\x83\x35\xbd\xbe\xa2\xf4\xfa\x77\xc8\xff\x7d\x40\xf9\x8e\xb3\xef\xff\xff\x81\x3e\x0d\x91\x14\xd5\xe2\xcc\xc6\xab\xca\x27\x15\x21\xd3\xd9\xec\x40\xa9\x45\x31\x80\xa0\xa6\x85\xda\x45\x01\x42\x7a\x43\x93\x41\xfd\x90\x04\x5f\x5d\xbb\x5e\x12\xb6\xb5\xa2\xdd\x5b\xf7\xac\xec\xab\x6b\xdb\x05\x80\x07\x11\xcc\x26\x9a\x26\xa2\x80\xb3\x64\x04\xb9\x47\xb4\x8c\xd2\x74\x3c\x83\xb3\xd0\xb9\xf5\x97\x43\x12\xf0\xf3\xbf\x76\xf6\xef\xdd\xe9\x3d\xb8\xe1\x8a\x9b\x45\x79\xb8\x59\xad\x44\xd2\x55\x37\x1d\xad\xb1\xf0\xdd\x6f\x3e\x73\xbe\x7f\xf7\xef\xcf\xf6\x7f\xfa\xb6\x7b\xf7\xc5\x64\x78\x13\xc3\x4c\x60\x53\x83\x62\xc5\xb1\xaa\x3b\xd7\x76\x47\xa8\x4b\xaa\x5c\x0c\xdd\xe5\x5c\xc3\x66\xc2\xa3\xec\x16\x5f\x9b\xbf\x48\x6b\x5a\x53\xb4\x47\xd1\xa9\xfb\x99\xa6\x54\x7a\x0d\x6a\x94\xde\x92\x51\x88\x0a\x4c\x8d\xf5\xcf\x47\xca\x99\xa0\x08\x31\x3c\x2c\xb8\xc4\xc5\x40\xb6\x0c\x2e\x5b\x6e\xfb\xc7\x8e\x41\x56\xa2\x8a\x98\x41\x6d\xfa\x82\x3e\xd3\xde\x75\xd6\x45\x87\x3a\xab\xf5\x68\xe2\x38\x77\x69\x31\x4b\x73\xcf\x5d\x5a\xf4\x71\xa0\x6d\x4b\x60\x6a\x06\x36\x13\x63\x47\xcc\x16\x68\xc5\x00\x9c\x06\xe2\xb0\xc5\x43\xac\x49\x33\x39\xae\x46\xb5\x80\x55\x19\x9f\x64\x80\xdf\x01\xae\xf9\xc3\xaa\x78\x83\x64\x06\xd9\x41\x59\x19\x04\x88\xc4\x7f\xcd\xfd\x26\x13\xb8\xe8\x57\x99\xe8\xc3\xaa\xfd\x59\xb4\x30\x72\xe2\x30\xf9\xc6\x24\x9b\x11\x0f\xde\xba\x2d\x27\x8c\x92\x6b\xca\xea\xc2\xaf\x36\x16\xd6\xd6\x7d\xb9\x6d\xf7\xf0\xc2\x9b\x49\x59\x5d\x58\x5b\xb9\xbc\xbc\xb6\xe0\x13\x77\xef\x24\xfc\xe2\x07\xa4\xfb\xeb\x37\x4b\xc4\xdb\x43\xb9\x0c\x1f\xd2\x3f\x99\x6d\x36\x06\xb6\x9e\x8b\x1b\x46\x7f\x55\xe5\x8d\xd5\x7a\xc8\xd6\xa5\xa1\xe8\x56\x35\x50\xb9\x77\x1b\x65\x58\x33\xcc\x24\x14\xad\x84\xce\x7f\x73\x7f\xbb\x97\x09\x33\xd9\xeb\x8c\xc1\x47\x9b\x05\xed\x7f\xab\x3b\xf7\xab\x90\xd7\xb7\x7f\xfd\x8f\xbd\x27\xcf\x7f\xde\x7d\xd9\xfd\xe6\xf3\xce\xfd\x47\xee\x3d\xce\xab\x6b\xdb\xbd\x9d\x6b\xdd\x9b\x3b\xbd\xef\x77\x5f\xef\x3e\x38\x02\xfe\x7a\xf7\xb6\xeb\x36\xf8\x7a\x08\xfd\xf5\xee\xed\xbd\x47\xbf\xeb\x5e\x7f\xee\xe4\xc6\x78\x82\xab\xc3\xb9\x98\xc3\xc3\x5a\x64\x19\x17\x16\xcf\x05\x5f\x3b\x92\x44\x9a\x18\x7e\x02\x05\xf9\x04\x6a\xb2\x49\x7e\xc8\x7b\xb4\xff\xda\xed\xf2\xba\x34\x2c\xf2\xce\x94\xaf\xf7\x48\xd5\x6e\xea\x94\x49\xd3\x33\x34\x4f\x22\x4c\xd3\x23\xe2\xa3\xc1\xc6\xcb\xe7\xc2\xaf\xd3\x45\x2e\x03\x16\xd1\xab\x94\x60\x8b\xf6\x88\xac\x54\x28\x87\xd2\x6e\x97\x2f\x57\x2a\x1a\xc9\x8b\xb3\xc5\x2b\x53\x1b\x2c\x7c\xdb\x77\xa6\x7f\x43\xbb\x8c\x1a\x79\x03\x2e\x37\xab\xcb\xb0\xd6\x12\x41\x4d\x49\xc1\x3f\x71\x37\x84\x6e\x69\x83\xf5\x0c\xa3\xd0\xb5\xf6\x0e\x10\xf3\x0e\x58\xff\x04\xe6\x1a\x0c\xd6\x63\xa9\x98\xe2\x51\x0b\x12\xc1\x1a\x8c\x47\x54\x09\x1f\x65\x55\x11\x69\x3f\xb4\x4d\xcf\xcb\x4a\x6e\xd4\x6d\x9f\xd4\x15\xce\xd4\x4c\xad\x2e\x9f\x1c\xa7\xb4\x2e\x3d\x8d\x68\x32\x6e\x9d\xf6\x8a\x54\x39\x6a\xb3\xd0\x7c\x53\xc9\xa6\xf6\x3e\x8a\x9c\x52\x59\x3e\xb1\xfe\x84\xd2\x0d\x69\x4f\x78\xa3\x5a\xb3\x15\x83\xca\x1f\xd0\x8c\x96\x19\x03\x63\x9d\xbe\xf1\x9a\xb3\x6e\x3e\x65\xd9\xeb\x36\x5b\xd2\xf4\x6e\xfe\xe3\xfd\x72\xd5\x6d\x08\x5a\x55\xe4\x01\x87\xe8\x5e\xaf\x65\x55\x05\x19\x1d\x64\x70\x5c\xae\x60\xa8\x0c\x93\x0f\x3a\xad\xb6\x31\xd4\x28\xdb\x1f\x35\x06\xa2\x50\x67\x82\x55\xd1\xa6\x02\x07\xde\x8f\xdd\xee\x43\x35\xfb\x62\x45\xea\x93\x46\x29\x68\xca\xa0\x80\x41\xf9\x0f\x25\xa3\x08\xd5\x81\xce\x93\xb3\xe5\x0d\x61\xc6\x18\xa3\x59\x63\x10\x43\xb9\x7c\xea\xc8\xda\xdb\xdf\xee\x76\x6e\xfc\xb5\xf3\xf4\xab\xce\xc3\x7b\xdd\xdf\x7f\xdd\x7b\xb4\xd3\xd9\xfd\xc3\xfe\xcd\xcf\x7b\xff\x7e\xe6\xf5\x00\x37\xc8\xef\xa1\xcb\x2e\xa7\x82\x0f\x34\x35\xe4\x91\x41\x25\x62\x55\xcb\xfd\x7c\xc4\xaa\xf4\x25\x3b\xe4\x9d\xf3\x11\x62\x10\x31\x7f\xa2\xf8\x44\x21\x72\x8d\xf8\xf5\xec\xea\xf2\xe2\xf2\x05\x9f\x13\x3c\xf8\x9c\x2b\xfc\x91\x4c\x54\x96\xba\x0c\x25\x15\xe9\xa4\x81\x1a\x8d\x3b\xed\x25\x9b\xea\xd3\xba\x7f\x26\xdb\xf7\x8c\xee\x38\xa4\x3b\x31\x46\x65\xc7\xbf\x90\x0f\x79\xf2\x38\xe3\xcc\x89\x58\xb0\xa5\xb3\x88\xc4\xe9\x3c\x14\xa0\x9e\x84\x1d\x6f\x0a\x90\x6b\x80\xa5\xeb\x36\x55\x9a\x0e\xad\x15\xda\x01\x11\x0f\x8c\xce\x0a\x27\xf4\x00\x87\x6b\x7b\xdf\x49\x51\xcc\x91\x3f\x21\xe5\x3e\xe2\xeb\xad\xf8\xa8\xde\xec\x7e\x77\x95\x82\x42\x0e\xf3\xe4\x7a\x4e\x01\xa4\xa7\x7e\xfb\xdf\x01\x00\x6c\x1e\x7f\x96\x06\x31\x00\x00")
 
 func i18nResourcesZh_hantAllJsonBytes() ([]byte, error) {
 	return bindataRead(